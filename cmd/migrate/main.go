@@ -58,6 +58,30 @@ CREATE TABLE user_roles (
     UNIQUE(user_id, organization_id, team_id) -- Prevent duplicate roles
 );
 
+-- Business-hours calendars for SLA deadlines
+CREATE TABLE business_calendars (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    organization_id UUID NOT NULL REFERENCES organizations(id) ON DELETE CASCADE,
+    name VARCHAR(255) NOT NULL,
+    time_zone VARCHAR(64) NOT NULL DEFAULT 'UTC',
+    working_days INTEGER[] NOT NULL DEFAULT '{1,2,3,4,5}', -- 0 = Sunday
+    day_start INTEGER NOT NULL DEFAULT 540, -- minutes after midnight
+    day_end INTEGER NOT NULL DEFAULT 1020,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    created_by_id UUID REFERENCES users(id) ON DELETE SET NULL,
+    UNIQUE(organization_id, name),
+    CHECK (day_start >= 0 AND day_end <= 1440 AND day_start < day_end)
+);
+
+-- Holidays of business calendars
+CREATE TABLE calendar_holidays (
+    calendar_id UUID NOT NULL REFERENCES business_calendars(id) ON DELETE CASCADE,
+    date DATE NOT NULL,
+    name VARCHAR(255) NOT NULL DEFAULT '',
+    PRIMARY KEY (calendar_id, date)
+);
+
 -- Teams table with organization reference
 CREATE TABLE teams (
     id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
@@ -65,6 +89,8 @@ CREATE TABLE teams (
     name VARCHAR(255) NOT NULL,
     description TEXT,
     lead_id UUID REFERENCES users(id) ON DELETE SET NULL,
+    calendar_id UUID REFERENCES business_calendars(id) ON DELETE SET NULL,
+    on_call_id UUID REFERENCES users(id) ON DELETE SET NULL,
     created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
     updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
     UNIQUE(organization_id, name)
@@ -82,8 +108,11 @@ CREATE TABLE issues (
     assignee_id UUID REFERENCES users(id) ON DELETE SET NULL,
     priority VARCHAR(5) NOT NULL CHECK (priority IN ('P0', 'P1', 'P2', 'P3', 'P4')),
     severity VARCHAR(5) NOT NULL CHECK (severity IN ('S0', 'S1', 'S2', 'S3')),
-    status VARCHAR(20) NOT NULL CHECK (status IN ('NEW', 'ASSIGNED', 'IN_PROGRESS', 'FIXED', 'VERIFIED', 'CLOSED', 'DUPLICATE', 'WONT_FIX')),
-    due_date TIMESTAMP WITH TIME ZONE,
+    status VARCHAR(20) NOT NULL CHECK (status IN ('NEW', 'ASSIGNED', 'IN_PROGRESS', 'FIXED', 'VERIFIED', 'CLOSED', 'DUPLICATE', 'WONT_FIX', 'WAITING_ON_REPORTER', 'BLOCKED')),
+    due_date TIMESTAMP WITH TIME ZONE, -- resolution deadline
+    response_due_date TIMESTAMP WITH TIME ZONE, -- first-response deadline
+    first_response_at TIMESTAMP WITH TIME ZONE,
+    resolved_at TIMESTAMP WITH TIME ZONE, -- first move into FIXED, VERIFIED or CLOSED
     created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
     updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
 );
@@ -106,11 +135,31 @@ CREATE TABLE attachments (
     issue_id UUID NOT NULL REFERENCES issues(id) ON DELETE CASCADE,
     uploader_id UUID REFERENCES users(id) ON DELETE SET NULL,
     filename VARCHAR(255) NOT NULL,
-    file_url VARCHAR(255) NOT NULL,
+    file_url TEXT NOT NULL,
+    storage_key TEXT NOT NULL,
     file_size BIGINT NOT NULL,
+    content_type VARCHAR(255) NOT NULL DEFAULT 'application/octet-stream',
+    sha256 CHAR(64) NOT NULL,
+    scan_status VARCHAR(20) NOT NULL DEFAULT 'PENDING' CHECK (scan_status IN ('PENDING', 'CLEAN', 'INFECTED', 'ERROR')),
+    scan_result TEXT NOT NULL DEFAULT '',
+    thumbnail_key TEXT NOT NULL DEFAULT '',
+    scan_started_at TIMESTAMP WITH TIME ZONE,
     created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
 );
 
+-- In-progress chunked attachment uploads
+CREATE TABLE attachment_uploads (
+    id UUID PRIMARY KEY,
+    issue_id UUID NOT NULL REFERENCES issues(id) ON DELETE CASCADE,
+    uploader_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    filename VARCHAR(255) NOT NULL,
+    total_size BIGINT NOT NULL DEFAULT 0,
+    received_bytes BIGINT NOT NULL DEFAULT 0,
+    part_offsets BIGINT[] NOT NULL DEFAULT '{}',
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
 -- Components table with organization reference
 CREATE TABLE components (
     id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
@@ -150,16 +199,214 @@ CREATE TABLE sla_configs (
     high_response_time INTEGER NOT NULL DEFAULT 4,
     medium_response_time INTEGER NOT NULL DEFAULT 8,
     low_response_time INTEGER NOT NULL DEFAULT 24,
+    trivial_response_time INTEGER NOT NULL DEFAULT 48,
     critical_resolution_time INTEGER NOT NULL DEFAULT 8, -- hours
     high_resolution_time INTEGER NOT NULL DEFAULT 24,
     medium_resolution_time INTEGER NOT NULL DEFAULT 72,
     low_resolution_time INTEGER NOT NULL DEFAULT 168,
+    trivial_resolution_time INTEGER NOT NULL DEFAULT 336,
+    s0_factor DOUBLE PRECISION NOT NULL DEFAULT 0.5, -- severity multipliers
+    s1_factor DOUBLE PRECISION NOT NULL DEFAULT 0.75,
+    s2_factor DOUBLE PRECISION NOT NULL DEFAULT 1,
+    s3_factor DOUBLE PRECISION NOT NULL DEFAULT 1.5,
+    calendar_id UUID REFERENCES business_calendars(id) ON DELETE SET NULL,
+    recalculate_from VARCHAR(20) NOT NULL DEFAULT 'CREATED' CHECK (recalculate_from IN ('CREATED', 'CHANGED')),
     created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
     updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
     created_by_id UUID REFERENCES users(id) ON DELETE SET NULL,
     UNIQUE(organization_id, component_id)
 );
 
+-- Intervals in which an issue's SLA clock was paused
+CREATE TABLE sla_pauses (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    issue_id UUID NOT NULL REFERENCES issues(id) ON DELETE CASCADE,
+    started_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    ended_at TIMESTAMP WITH TIME ZONE, -- NULL while paused
+    reason VARCHAR(255) NOT NULL
+);
+
+-- SLA notifications already sent, so each threshold fires once per issue
+CREATE TABLE sla_notifications (
+    issue_id UUID NOT NULL REFERENCES issues(id) ON DELETE CASCADE,
+    target VARCHAR(20) NOT NULL CHECK (target IN ('RESPONSE', 'RESOLUTION')),
+    threshold INTEGER NOT NULL, -- percentage of the SLA budget used; 100 for a breach
+    sent_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (issue_id, target, threshold)
+);
+
+-- Escalation policies, run when an issue breaches its SLA
+CREATE TABLE escalation_policies (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    organization_id UUID NOT NULL REFERENCES organizations(id) ON DELETE CASCADE,
+    name VARCHAR(255) NOT NULL,
+    component_id UUID REFERENCES components(id) ON DELETE CASCADE,
+    team_id UUID REFERENCES teams(id) ON DELETE CASCADE,
+    priorities VARCHAR(10)[] NOT NULL DEFAULT '{}', -- empty for all priorities
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    created_by_id UUID REFERENCES users(id) ON DELETE SET NULL,
+    CHECK ((component_id IS NULL) <> (team_id IS NULL))
+);
+
+CREATE TABLE escalation_steps (
+    policy_id UUID NOT NULL REFERENCES escalation_policies(id) ON DELETE CASCADE,
+    position INTEGER NOT NULL,
+    action VARCHAR(20) NOT NULL CHECK (action IN ('NOTIFY_ASSIGNEE', 'NOTIFY_TEAM_LEAD', 'REASSIGN_ON_CALL', 'BUMP_PRIORITY', 'PAGE_WEBHOOK')),
+    delay_minutes INTEGER NOT NULL DEFAULT 0,
+    target VARCHAR(255) NOT NULL DEFAULT '',
+    PRIMARY KEY (policy_id, position)
+);
+
+-- Escalation steps scheduled for issues, run by the escalation scheduler
+CREATE TABLE escalations (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    issue_id UUID NOT NULL REFERENCES issues(id) ON DELETE CASCADE,
+    policy_id UUID REFERENCES escalation_policies(id) ON DELETE SET NULL,
+    position INTEGER NOT NULL,
+    action VARCHAR(20) NOT NULL,
+    target VARCHAR(255) NOT NULL DEFAULT '',
+    run_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'PENDING' CHECK (status IN ('PENDING', 'DONE', 'SKIPPED', 'FAILED', 'CANCELLED')),
+    attempts INTEGER NOT NULL DEFAULT 0,
+    last_error TEXT NOT NULL DEFAULT '',
+    locked_until TIMESTAMP WITH TIME ZONE, -- lease of the scheduler running the step
+    finished_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+-- On-call rotations, at most one per team
+CREATE TABLE oncall_rotations (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    organization_id UUID NOT NULL REFERENCES organizations(id) ON DELETE CASCADE,
+    team_id UUID NOT NULL UNIQUE REFERENCES teams(id) ON DELETE CASCADE,
+    name VARCHAR(255) NOT NULL,
+    shift_length_days INTEGER NOT NULL CHECK (shift_length_days > 0),
+    handoff_time INTEGER NOT NULL DEFAULT 540 CHECK (handoff_time >= 0 AND handoff_time < 1440), -- minutes after midnight
+    time_zone VARCHAR(64) NOT NULL DEFAULT 'UTC',
+    start_date DATE NOT NULL, -- day of the first handoff
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    created_by_id UUID REFERENCES users(id) ON DELETE SET NULL
+);
+
+CREATE TABLE oncall_rotation_members (
+    rotation_id UUID NOT NULL REFERENCES oncall_rotations(id) ON DELETE CASCADE,
+    position INTEGER NOT NULL,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    PRIMARY KEY (rotation_id, position)
+);
+
+-- Overrides of on-call rotations, for swaps and vacations
+CREATE TABLE oncall_overrides (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    rotation_id UUID NOT NULL REFERENCES oncall_rotations(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    start_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    end_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    reason TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    created_by_id UUID REFERENCES users(id) ON DELETE SET NULL,
+    CHECK (start_at < end_at)
+);
+
+-- Webhooks called on issue events
+CREATE TABLE webhooks (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    url TEXT NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    secret VARCHAR(255) NOT NULL DEFAULT '', -- signs payloads; never returned after creation
+    creator_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    event_types VARCHAR(50)[] NOT NULL DEFAULT '{}',
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    last_called_at TIMESTAMP WITH TIME ZONE,
+    last_success BOOLEAN,
+    payload_format VARCHAR(20) NOT NULL DEFAULT 'BUGANIZER'
+);
+
+-- Notifications waiting to be delivered, written with the change they describe
+CREATE TABLE notification_outbox (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    kind VARCHAR(50) NOT NULL, -- NOTIFICATION, SLACK, SLACK_DM, WEBHOOK, EMAIL, DIGEST or INBOX
+    issue_id UUID REFERENCES issues(id) ON DELETE CASCADE,
+    webhook_id UUID REFERENCES webhooks(id) ON DELETE CASCADE,
+    user_id UUID REFERENCES users(id) ON DELETE CASCADE, -- Whose digest a DIGEST message is held for
+    payload JSONB NOT NULL,
+    status VARCHAR(50) NOT NULL DEFAULT 'PENDING', -- PENDING, DELIVERED or DEAD
+    attempts INTEGER NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    locked_until TIMESTAMP WITH TIME ZONE,
+    last_error TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    delivered_at TIMESTAMP WITH TIME ZONE
+);
+
+-- Users' in-app notification inboxes, pruned after the retention period
+CREATE TABLE notifications (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    type VARCHAR(50) NOT NULL,
+    issue_id UUID REFERENCES issues(id) ON DELETE CASCADE,
+    title TEXT NOT NULL DEFAULT '',
+    message TEXT NOT NULL DEFAULT '',
+    actor_id UUID REFERENCES users(id) ON DELETE SET NULL,
+    read_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Attempts at calling webhooks
+CREATE TABLE webhook_deliveries (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    webhook_id UUID NOT NULL REFERENCES webhooks(id) ON DELETE CASCADE,
+    event_type VARCHAR(50) NOT NULL,
+    issue_id UUID REFERENCES issues(id) ON DELETE SET NULL,
+    request_headers JSONB NOT NULL DEFAULT '{}',
+    request_body TEXT NOT NULL,
+    response_status INTEGER NOT NULL DEFAULT 0, -- 0 when no response was received
+    response_headers JSONB NOT NULL DEFAULT '{}',
+    response_body TEXT NOT NULL DEFAULT '', -- truncated
+    response_truncated BOOLEAN NOT NULL DEFAULT FALSE,
+    latency_ms BIGINT NOT NULL DEFAULT 0,
+    error TEXT NOT NULL DEFAULT '',
+    success BOOLEAN NOT NULL,
+    redelivery_of_id UUID REFERENCES webhook_deliveries(id) ON DELETE SET NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Changes to issue fields
+CREATE TABLE issue_history (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    issue_id UUID NOT NULL REFERENCES issues(id) ON DELETE CASCADE,
+    actor_id UUID REFERENCES users(id) ON DELETE SET NULL, -- NULL for system changes
+    field VARCHAR(50) NOT NULL,
+    old_value TEXT NOT NULL DEFAULT '',
+    new_value TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Users' notification settings; users without a row get everything as it happens
+CREATE TABLE notification_preferences (
+    user_id UUID PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+    email_notifications BOOLEAN NOT NULL DEFAULT TRUE,
+    slack_notifications BOOLEAN NOT NULL DEFAULT TRUE,
+    subscribed_events TEXT[] NOT NULL DEFAULT '{}',
+    digest_frequencies JSONB NOT NULL DEFAULT '{}', -- IMMEDIATE, HOURLY or DAILY by notification type
+    time_zone VARCHAR(64) NOT NULL DEFAULT 'UTC',
+    quiet_hours_start INTEGER NOT NULL DEFAULT 0, -- Minutes after midnight
+    quiet_hours_end INTEGER NOT NULL DEFAULT 0,
+    daily_digest_time INTEGER NOT NULL DEFAULT 540,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Issue watchers (CC lists)
+CREATE TABLE issue_watchers (
+    issue_id UUID NOT NULL REFERENCES issues(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    reason VARCHAR(20) NOT NULL CHECK (reason IN ('REPORTER', 'ASSIGNEE', 'COMMENTER', 'CC')),
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (issue_id, user_id)
+);
+
 -- Add indexes for better query performance with tenant filtering
 CREATE INDEX idx_users_organization_id ON users(organization_id);
 CREATE INDEX idx_issues_organization_id ON issues(organization_id);
@@ -168,6 +415,27 @@ CREATE INDEX idx_components_organization_id ON components(organization_id);
 CREATE INDEX idx_assignments_organization_id ON assignments(organization_id);
 CREATE INDEX idx_comments_organization_id ON comments(organization_id);
 CREATE INDEX idx_attachments_organization_id ON attachments(organization_id);
+CREATE INDEX idx_attachments_pending_scan ON attachments(scan_started_at) WHERE scan_status = 'PENDING';
+CREATE INDEX idx_issue_watchers_user_id ON issue_watchers(user_id);
+CREATE UNIQUE INDEX idx_sla_configs_org_default ON sla_configs(organization_id) WHERE component_id IS NULL;
+CREATE INDEX idx_business_calendars_organization_id ON business_calendars(organization_id);
+CREATE INDEX idx_sla_pauses_issue_id ON sla_pauses(issue_id);
+CREATE UNIQUE INDEX idx_sla_pauses_open ON sla_pauses(issue_id) WHERE ended_at IS NULL;
+CREATE INDEX idx_issue_history_issue_id ON issue_history(issue_id, created_at);
+CREATE UNIQUE INDEX idx_escalation_policies_component ON escalation_policies(component_id) WHERE component_id IS NOT NULL;
+CREATE UNIQUE INDEX idx_escalation_policies_team ON escalation_policies(team_id) WHERE team_id IS NOT NULL;
+CREATE INDEX idx_escalations_issue_id ON escalations(issue_id);
+CREATE INDEX idx_escalations_due ON escalations(run_at) WHERE status = 'PENDING';
+CREATE INDEX idx_oncall_rotations_organization_id ON oncall_rotations(organization_id);
+CREATE INDEX idx_oncall_overrides_rotation ON oncall_overrides(rotation_id, end_at);
+CREATE INDEX idx_webhooks_creator_id ON webhooks(creator_id);
+CREATE INDEX idx_notification_outbox_due ON notification_outbox(next_attempt_at) WHERE status = 'PENDING';
+CREATE INDEX idx_notification_outbox_digest ON notification_outbox(user_id, next_attempt_at) WHERE kind = 'DIGEST' AND status = 'PENDING';
+CREATE INDEX idx_notification_outbox_dead ON notification_outbox(created_at) WHERE status = 'DEAD';
+CREATE INDEX idx_notifications_user_id ON notifications(user_id, created_at DESC);
+CREATE INDEX idx_notifications_unread ON notifications(user_id) WHERE read_at IS NULL;
+CREATE INDEX idx_notifications_created_at ON notifications(created_at);
+CREATE INDEX idx_webhook_deliveries_webhook_id ON webhook_deliveries(webhook_id, created_at);
 `
 
 func main() {
@@ -4,6 +4,7 @@ package main
 import (
 	"context"
 	"database/sql"
+	"expvar"
 	"fmt"
 	"log"
 	"net"
@@ -19,16 +20,24 @@ import (
 	"google.golang.org/grpc/reflection"
 
 	"github.com/matthewmc1/buganizer/config"
+	"github.com/matthewmc1/buganizer/gateway"
 	"github.com/matthewmc1/buganizer/middleware"
 	pb "github.com/matthewmc1/buganizer/proto"
 	"github.com/matthewmc1/buganizer/repositories/postgres"
+	"github.com/matthewmc1/buganizer/scanner"
 	"github.com/matthewmc1/buganizer/services/auth"
 	"github.com/matthewmc1/buganizer/services/issue"
 	"github.com/matthewmc1/buganizer/services/notification"
+	"github.com/matthewmc1/buganizer/services/oncall"
 	"github.com/matthewmc1/buganizer/services/search"
 	"github.com/matthewmc1/buganizer/services/sla"
+	"github.com/matthewmc1/buganizer/storage"
 )
 
+// slaMonitorLockKey is the advisory lock key electing the replica that runs
+// the SLA monitor
+const slaMonitorLockKey int64 = 0x534c41 // "SLA"
+
 func main() {
 	// Load configuration
 	cfg, err := config.Load()
@@ -47,14 +56,28 @@ func main() {
 	repos := initRepositories(db)
 
 	// Create gRPC server
-	grpcServer := setupGRPCServer(cfg, repos)
+	grpcServer, workers := setupGRPCServer(cfg, repos)
 
 	// Start gRPC server
 	go startGRPCServer(grpcServer, cfg.Server.GRPCPort)
 
+	// Start background workers
+	workersCtx, stopWorkers := context.WithCancel(context.Background())
+	defer stopWorkers()
+	go workers.SLAMonitor.Run(workersCtx)
+	go workers.EscalationScheduler.Run(workersCtx)
+	go workers.Outbox.Run(workersCtx)
+	go workers.InboxPruner.Run(workersCtx)
+	go workers.ScanSweeper.Run(workersCtx)
+
 	// Start HTTP gateway
 	go startHTTPGateway(cfg, repos)
 
+	// Start internal admin server
+	if cfg.Server.AdminAddr != "" {
+		go startAdminServer(cfg.Server.AdminAddr)
+	}
+
 	// Wait for termination signal
 	waitForTermination(grpcServer)
 }
@@ -99,6 +122,19 @@ type Repositories struct {
 	ViewRepo       *postgres.ViewRepository
 	WebhookRepo    *postgres.WebhookRepository
 	PreferenceRepo *postgres.NotificationPreferenceRepository
+	WatcherRepo    *postgres.WatcherRepository
+	UploadRepo     *postgres.AttachmentUploadRepository
+	SLAConfigRepo  *postgres.SLAConfigRepository
+	CalendarRepo   *postgres.CalendarRepository
+	SLAPauseRepo   *postgres.SLAPauseRepository
+	HistoryRepo    *postgres.IssueHistoryRepository
+	SLANotifRepo   *postgres.SLANotificationRepository
+	SLAMonitorLock *postgres.AdvisoryLock
+	EscalationRepo *postgres.EscalationRepository
+	OnCallRepo     *postgres.OnCallRepository
+	OutboxRepo     *postgres.OutboxRepository
+	DeliveryRepo   *postgres.WebhookDeliveryRepository
+	NotifRepo      *postgres.NotificationRepository
 }
 
 // initRepositories initializes all repositories
@@ -113,11 +149,34 @@ func initRepositories(db *sql.DB) *Repositories {
 		ViewRepo:       postgres.NewViewRepository(db),
 		WebhookRepo:    postgres.NewWebhookRepository(db),
 		PreferenceRepo: postgres.NewNotificationPreferenceRepository(db),
+		WatcherRepo:    postgres.NewWatcherRepository(db),
+		UploadRepo:     postgres.NewAttachmentUploadRepository(db),
+		SLAConfigRepo:  postgres.NewSLAConfigRepository(db),
+		CalendarRepo:   postgres.NewCalendarRepository(db),
+		SLAPauseRepo:   postgres.NewSLAPauseRepository(db),
+		HistoryRepo:    postgres.NewIssueHistoryRepository(db),
+		SLANotifRepo:   postgres.NewSLANotificationRepository(db),
+		SLAMonitorLock: postgres.NewAdvisoryLock(db, slaMonitorLockKey),
+		EscalationRepo: postgres.NewEscalationRepository(db),
+		OnCallRepo:     postgres.NewOnCallRepository(db),
+		OutboxRepo:     postgres.NewOutboxRepository(db),
+		DeliveryRepo:   postgres.NewWebhookDeliveryRepository(db),
+		NotifRepo:      postgres.NewNotificationRepository(db),
 	}
 }
 
-// setupGRPCServer sets up the gRPC server with all services
-func setupGRPCServer(cfg *config.Config, repos *Repositories) *grpc.Server {
+// Workers holds the background workers started with the server
+type Workers struct {
+	SLAMonitor          *sla.Monitor
+	EscalationScheduler *sla.EscalationScheduler
+	Outbox              *notification.OutboxWorker
+	InboxPruner         *notification.InboxPruner
+	ScanSweeper         *issue.ScanSweeper
+}
+
+// setupGRPCServer sets up the gRPC server with all services, and the
+// background workers that run alongside them
+func setupGRPCServer(cfg *config.Config, repos *Repositories) (*grpc.Server, *Workers) {
 	// Create auth interceptor
 	authInterceptor := middleware.NewAuthInterceptor(cfg)
 
@@ -127,6 +186,27 @@ func setupGRPCServer(cfg *config.Config, repos *Repositories) *grpc.Server {
 		grpc.StreamInterceptor(authInterceptor.Stream()),
 	)
 
+	// Create attachment storage backend
+	store, err := storage.New(cfg.Storage)
+	if err != nil {
+		log.Fatalf("Failed to initialize storage: %v", err)
+	}
+
+	attachmentScanner, err := scanner.New(cfg.Scanner)
+	if err != nil {
+		log.Fatalf("Failed to initialize scanner: %v", err)
+	}
+
+	// Services call each other through the gRPC server, acting as the caller
+	internalConn, err := grpc.Dial(
+		fmt.Sprintf("localhost:%d", cfg.Server.GRPCPort),
+		grpc.WithInsecure(),
+		grpc.WithUnaryInterceptor(middleware.ForwardAuth()),
+	)
+	if err != nil {
+		log.Fatalf("Failed to dial server: %v", err)
+	}
+
 	// Create services
 	authService := auth.NewService(repos.UserRepo, cfg)
 
@@ -136,30 +216,80 @@ func setupGRPCServer(cfg *config.Config, repos *Repositories) *grpc.Server {
 		repos.UserRepo,
 		repos.IssueRepo,
 		repos.PreferenceRepo,
+		repos.WatcherRepo,
+		repos.OutboxRepo,
+		repos.DeliveryRepo,
+		repos.ComponentRepo,
+		repos.TeamRepo,
+		repos.NotifRepo,
 		cfg,
 	)
 
+	// Create outbox worker, delivering the notifications saved with changes
+	outboxWorker := notification.NewOutboxWorker(notifService, cfg.Outbox)
+
+	// Create inbox pruner, deleting in-app notifications past their retention
+	inboxPruner := notification.NewInboxPruner(notifService, cfg.Inbox)
+
 	// Create SLA service (needed by issue service)
 	slaService := sla.NewService(
 		repos.IssueRepo,
 		repos.ComponentRepo,
-		nil, // Initialize with nil, will be set later
+		repos.SLAConfigRepo,
+		repos.CalendarRepo,
+		repos.TeamRepo,
+		repos.UserRepo,
+		repos.SLAPauseRepo,
+		repos.HistoryRepo,
+		repos.EscalationRepo,
+		cfg.SLA,
+		pb.NewNotificationServiceClient(internalConn),
 	)
 
+	// Create SLA monitor
+	slaMonitor := sla.NewMonitor(
+		slaService,
+		repos.SLANotifRepo,
+		repos.SLAMonitorLock,
+		notifService,
+		cfg.SLA,
+	)
+
+	// Create on-call service
+	onCallService := oncall.NewService(
+		repos.OnCallRepo,
+		repos.TeamRepo,
+		repos.UserRepo,
+	)
+
+	// Create escalation scheduler
+	escalationScheduler := sla.NewEscalationScheduler(slaService, notifService, onCallService, cfg.SLA)
+
 	// Create issue service
 	issueService := issue.NewService(
 		repos.IssueRepo,
 		repos.CommentRepo,
 		repos.AttachmentRepo,
-		nil, // Initialize with nil, will be set later
-		nil, // Initialize with nil, will be set later
+		repos.UploadRepo,
+		repos.WatcherRepo,
+		repos.HistoryRepo,
+		repos.UserRepo,
+		store,
+		cfg.Storage,
+		storage.NewURLSigner(cfg.Storage.URLSigningSecret, cfg.BaseURL, time.Duration(cfg.Storage.URLExpiryMinutes)*time.Minute),
+		attachmentScanner,
+		pb.NewSLAServiceClient(internalConn),
 	)
 
+	// Create scan sweeper, rescanning attachments whose scan was interrupted
+	scanSweeper := issue.NewScanSweeper(issueService, cfg.Scanner)
+
 	// Create search service
 	searchService := search.NewService(
 		repos.IssueRepo,
 		repos.ViewRepo,
 		repos.UserRepo,
+		pb.NewOnCallServiceClient(internalConn),
 	)
 
 	// Register services
@@ -168,11 +298,18 @@ func setupGRPCServer(cfg *config.Config, repos *Repositories) *grpc.Server {
 	pb.RegisterSLAServiceServer(grpcServer, slaService)
 	pb.RegisterIssueServiceServer(grpcServer, issueService)
 	pb.RegisterSearchServiceServer(grpcServer, searchService)
+	pb.RegisterOnCallServiceServer(grpcServer, onCallService)
 
 	// Enable reflection for development tools
 	reflection.Register(grpcServer)
 
-	return grpcServer
+	return grpcServer, &Workers{
+		SLAMonitor:          slaMonitor,
+		EscalationScheduler: escalationScheduler,
+		Outbox:              outboxWorker,
+		InboxPruner:         inboxPruner,
+		ScanSweeper:         scanSweeper,
+	}
 }
 
 // startGRPCServer starts the gRPC server
@@ -234,18 +371,52 @@ func startHTTPGateway(cfg *config.Config, repos *Repositories) {
 		log.Fatalf("Failed to register gateway: %v", err)
 	}
 
+	err = pb.RegisterOnCallServiceHandler(ctx, gwmux, conn)
+	if err != nil {
+		log.Fatalf("Failed to register gateway: %v", err)
+	}
+
+	// Attachment content handlers override the generated attachment routes
+	err = gateway.RegisterAttachmentHandlers(gwmux, pb.NewIssueServiceClient(conn), cfg.Storage.ChunkSize)
+	if err != nil {
+		log.Fatalf("Failed to register gateway: %v", err)
+	}
+
+	// The iCalendar export of on-call shifts is not JSON, so it is served by hand
+	err = gateway.RegisterOnCallHandlers(gwmux, pb.NewOnCallServiceClient(conn))
+	if err != nil {
+		log.Fatalf("Failed to register gateway: %v", err)
+	}
+
+	// Unsubscribe links in emails open a page rather than JSON
+	err = gateway.RegisterUnsubscribeHandlers(gwmux, pb.NewNotificationServiceClient(conn))
+	if err != nil {
+		log.Fatalf("Failed to register gateway: %v", err)
+	}
+
 	// Serve the gateway
 	addr := fmt.Sprintf(":%d", cfg.Server.HTTPPort)
 	log.Printf("Starting HTTP gateway on port %d", cfg.Server.HTTPPort)
 	log.Fatal(http.ListenAndServe(addr, cors(gwmux)))
 }
 
+// startAdminServer serves metrics, such as the notification outbox's queue
+// depth and delivery latency, on an internal address that is not exposed
+// through the public gateway
+func startAdminServer(addr string) {
+	mux := http.NewServeMux()
+	mux.Handle("GET /debug/vars", expvar.Handler())
+
+	log.Printf("Starting admin server on %s", addr)
+	log.Fatal(http.ListenAndServe(addr, mux))
+}
+
 // cors is a middleware that adds CORS headers to the response
 func cors(h http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("Access-Control-Allow-Origin", "*")
 		w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS")
-		w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization")
+		w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization, Range")
 
 		if r.Method == "OPTIONS" {
 			w.WriteHeader(http.StatusOK)
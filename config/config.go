@@ -4,7 +4,9 @@ package config
 import (
 	"fmt"
 	"os"
+	"sort"
 	"strconv"
+	"strings"
 
 	"github.com/joho/godotenv"
 )
@@ -16,13 +18,20 @@ type Config struct {
 	Auth     AuthConfig
 	Slack    SlackConfig
 	Storage  StorageConfig
+	Scanner  ScannerConfig
+	SLA      SLAConfig
+	Outbox   OutboxConfig
+	Webhook  WebhookConfig
+	Email    EmailConfig
+	Inbox    InboxConfig
 	BaseURL  string
 }
 
 // ServerConfig holds configuration for the server
 type ServerConfig struct {
-	GRPCPort int
-	HTTPPort int
+	GRPCPort  int
+	HTTPPort  int
+	AdminAddr string // internal listener for metrics, e.g. 127.0.0.1:9090; empty disables it
 }
 
 // DatabaseConfig holds database configuration
@@ -55,9 +64,79 @@ type SlackConfig struct {
 
 // StorageConfig holds configuration for file storage
 type StorageConfig struct {
-	Provider string // "local" or "gcs" or "s3"
-	BasePath string // used for local storage
-	Bucket   string // used for cloud storage
+	Provider         string // "local" or "gcs" or "s3"
+	BasePath         string // used for local storage
+	Bucket           string // used for cloud storage
+	Endpoint         string // S3-compatible endpoint, e.g. http://localhost:9000 for MinIO
+	Region           string // used for request signing
+	AccessKeyID      string
+	SecretAccessKey  string
+	UsePathStyle     bool     // address buckets as endpoint/bucket/key (required by MinIO)
+	MaxUploadBytes   int64    // largest attachment accepted
+	ChunkSize        int      // size of chunks sent by streaming downloads
+	UploadPartSize   int      // bytes buffered before a chunked upload part is persisted
+	URLSigningSecret string   // key for signing download URLs; defaults to the JWT secret
+	URLExpiryMinutes int      // how long a signed download URL stays valid
+	MaxIssueBytes    int64    // total attachment size allowed per issue; 0 for no limit
+	MaxOrgBytes      int64    // total attachment size allowed per organization; 0 for no limit
+	AllowedTypes     []string // content types accepted, e.g. "image/*"; empty allows all
+	DeniedTypes      []string // content types rejected even if allowed
+	ThumbnailSize    int      // largest width or height of image thumbnails
+}
+
+// ScannerConfig holds configuration for attachment malware scanning
+type ScannerConfig struct {
+	Provider       string // "none" or "clamav"
+	Address        string // clamd address, host:port or unix:/path/to/clamd.sock
+	TimeoutSeconds int
+
+	SweepIntervalMinutes int // how often attachments whose scan was lost are rescanned; 0 disables
+	RetryAfterMinutes    int // how long a scan may run before the attachment is rescanned
+}
+
+// SLAConfig holds configuration for SLA tracking
+type SLAConfig struct {
+	PauseStatuses []string // issue statuses that stop the SLA clock, e.g. "WAITING_ON_REPORTER"
+	PauseLabels   []string // issue labels that stop the SLA clock
+
+	MonitorIntervalSeconds int   // how often the SLA monitor scans open issues; 0 disables it
+	RiskThresholds         []int // percentages of an SLA budget at which at-risk notifications fire
+
+	EscalationPollSeconds int // how often the escalation scheduler runs due steps; 0 disables it
+}
+
+// OutboxConfig holds configuration for delivering notifications from the outbox
+type OutboxConfig struct {
+	Workers            int // how many messages are delivered at once
+	PollSeconds        int // how often the outbox is checked for due messages; 0 disables delivery
+	MaxAttempts        int // attempts before a message is dead-lettered
+	BaseBackoffSeconds int // delay before the first retry, doubled for each further one
+	MaxBackoffSeconds  int // longest delay between retries
+}
+
+// WebhookConfig holds configuration for calling webhooks
+type WebhookConfig struct {
+	// AllowedTargets lists hostnames, IPs and CIDR ranges webhooks may call
+	// even though they are private, loopback or link-local, e.g.
+	// "hooks.internal,10.1.0.0/16". Other such targets are rejected.
+	AllowedTargets []string
+}
+
+// InboxConfig holds configuration for the in-app notification inbox
+type InboxConfig struct {
+	RetentionDays        int // notifications older than this are deleted
+	PruneIntervalMinutes int // how often old notifications are deleted; 0 disables pruning
+}
+
+// EmailConfig holds configuration for email notifications sent over SMTP
+type EmailConfig struct {
+	SMTPHost          string // email is disabled when empty
+	SMTPPort          int
+	Username          string // SMTP AUTH credentials; no authentication when empty
+	Password          string
+	StartTLS          bool   // require STARTTLS; turn off only for a local SMTP sink
+	From              string // sender address, e.g. "Buganizer <buganizer@example.com>"
+	UnsubscribeSecret string // key for signing unsubscribe links; defaults to the JWT secret
 }
 
 // Load loads configuration from environment variables or .env file
@@ -108,10 +187,135 @@ func Load() (*Config, error) {
 		return nil, fmt.Errorf("invalid RESTRICT_DOMAIN: %v", err)
 	}
 
+	// Storage config
+	usePathStyle, err := strconv.ParseBool(getEnv("STORAGE_USE_PATH_STYLE", "false"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid STORAGE_USE_PATH_STYLE: %v", err)
+	}
+
+	maxUploadBytes, err := strconv.ParseInt(getEnv("STORAGE_MAX_UPLOAD_BYTES", "104857600"), 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid STORAGE_MAX_UPLOAD_BYTES: %v", err)
+	}
+
+	chunkSize, err := parsePositiveInt(getEnv("STORAGE_CHUNK_SIZE", "262144"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid STORAGE_CHUNK_SIZE: %v", err)
+	}
+
+	uploadPartSize, err := parsePositiveInt(getEnv("STORAGE_UPLOAD_PART_SIZE", "8388608"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid STORAGE_UPLOAD_PART_SIZE: %v", err)
+	}
+
+	urlExpiryMinutes, err := strconv.Atoi(getEnv("STORAGE_URL_EXPIRY_MINUTES", "15"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid STORAGE_URL_EXPIRY_MINUTES: %v", err)
+	}
+
+	maxIssueBytes, err := strconv.ParseInt(getEnv("STORAGE_MAX_ISSUE_BYTES", "524288000"), 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid STORAGE_MAX_ISSUE_BYTES: %v", err)
+	}
+
+	maxOrgBytes, err := strconv.ParseInt(getEnv("STORAGE_MAX_ORG_BYTES", "10737418240"), 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid STORAGE_MAX_ORG_BYTES: %v", err)
+	}
+
+	thumbnailSize, err := strconv.Atoi(getEnv("STORAGE_THUMBNAIL_SIZE", "256"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid STORAGE_THUMBNAIL_SIZE: %v", err)
+	}
+
+	// Scanner config
+	scannerTimeout, err := strconv.Atoi(getEnv("SCANNER_TIMEOUT_SECONDS", "60"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid SCANNER_TIMEOUT_SECONDS: %v", err)
+	}
+
+	scannerSweepInterval, err := strconv.Atoi(getEnv("SCANNER_SWEEP_INTERVAL_MINUTES", "5"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid SCANNER_SWEEP_INTERVAL_MINUTES: %v", err)
+	}
+
+	scannerRetryAfter, err := strconv.Atoi(getEnv("SCANNER_RETRY_AFTER_MINUTES", "15"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid SCANNER_RETRY_AFTER_MINUTES: %v", err)
+	}
+
+	// SLA config
+	slaMonitorInterval, err := strconv.Atoi(getEnv("SLA_MONITOR_INTERVAL_SECONDS", "60"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid SLA_MONITOR_INTERVAL_SECONDS: %v", err)
+	}
+
+	slaRiskThresholds, err := parsePercentages(getEnv("SLA_RISK_THRESHOLDS", "50,75,90"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid SLA_RISK_THRESHOLDS: %v", err)
+	}
+
+	slaEscalationPoll, err := strconv.Atoi(getEnv("SLA_ESCALATION_POLL_SECONDS", "30"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid SLA_ESCALATION_POLL_SECONDS: %v", err)
+	}
+
+	// Outbox config
+	outboxWorkers, err := strconv.Atoi(getEnv("OUTBOX_WORKERS", "4"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid OUTBOX_WORKERS: %v", err)
+	}
+
+	outboxPoll, err := strconv.Atoi(getEnv("OUTBOX_POLL_SECONDS", "2"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid OUTBOX_POLL_SECONDS: %v", err)
+	}
+
+	outboxMaxAttempts, err := strconv.Atoi(getEnv("OUTBOX_MAX_ATTEMPTS", "8"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid OUTBOX_MAX_ATTEMPTS: %v", err)
+	}
+
+	outboxBaseBackoff, err := strconv.Atoi(getEnv("OUTBOX_BASE_BACKOFF_SECONDS", "10"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid OUTBOX_BASE_BACKOFF_SECONDS: %v", err)
+	}
+
+	outboxMaxBackoff, err := strconv.Atoi(getEnv("OUTBOX_MAX_BACKOFF_SECONDS", "3600"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid OUTBOX_MAX_BACKOFF_SECONDS: %v", err)
+	}
+
+	// Email config
+	smtpPort, err := strconv.Atoi(getEnv("SMTP_PORT", "587"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid SMTP_PORT: %v", err)
+	}
+
+	smtpStartTLS, err := strconv.ParseBool(getEnv("SMTP_STARTTLS", "true"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid SMTP_STARTTLS: %v", err)
+	}
+
+	// Inbox config
+	inboxRetention, err := strconv.Atoi(getEnv("INBOX_RETENTION_DAYS", "90"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid INBOX_RETENTION_DAYS: %v", err)
+	}
+
+	inboxPruneInterval, err := strconv.Atoi(getEnv("INBOX_PRUNE_INTERVAL_MINUTES", "60"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid INBOX_PRUNE_INTERVAL_MINUTES: %v", err)
+	}
+
+	jwtSecret := getEnv("JWT_SECRET", "your-secret-key")
+
 	return &Config{
 		Server: ServerConfig{
 			GRPCPort: grpcPort,
 			HTTPPort: httpPort,
+
+			AdminAddr: getEnv("ADMIN_ADDR", "127.0.0.1:9090"),
 		},
 		Database: DatabaseConfig{
 			Host:                   getEnv("DB_HOST", "localhost"),
@@ -124,7 +328,7 @@ func Load() (*Config, error) {
 			ConnMaxLifetimeMinutes: connMaxLifetime,
 		},
 		Auth: AuthConfig{
-			JWTSecret:          getEnv("JWT_SECRET", "your-secret-key"),
+			JWTSecret:          jwtSecret,
 			TokenExpiryHours:   tokenExpiryHours,
 			GoogleClientID:     getEnv("GOOGLE_CLIENT_ID", ""),
 			GoogleClientSecret: getEnv("GOOGLE_CLIENT_SECRET", ""),
@@ -136,14 +340,113 @@ func Load() (*Config, error) {
 			DefaultChannel: getEnv("SLACK_DEFAULT_CHANNEL", "buganizer"),
 		},
 		Storage: StorageConfig{
-			Provider: getEnv("STORAGE_PROVIDER", "local"),
-			BasePath: getEnv("STORAGE_BASE_PATH", "./uploads"),
-			Bucket:   getEnv("STORAGE_BUCKET", "buganizer-files"),
+			Provider:         getEnv("STORAGE_PROVIDER", "local"),
+			BasePath:         getEnv("STORAGE_BASE_PATH", "./uploads"),
+			Bucket:           getEnv("STORAGE_BUCKET", "buganizer-files"),
+			Endpoint:         getEnv("STORAGE_ENDPOINT", ""),
+			Region:           getEnv("STORAGE_REGION", "us-east-1"),
+			AccessKeyID:      getEnv("STORAGE_ACCESS_KEY_ID", ""),
+			SecretAccessKey:  getEnv("STORAGE_SECRET_ACCESS_KEY", ""),
+			UsePathStyle:     usePathStyle,
+			MaxUploadBytes:   maxUploadBytes,
+			ChunkSize:        chunkSize,
+			UploadPartSize:   uploadPartSize,
+			URLSigningSecret: getEnv("STORAGE_URL_SIGNING_SECRET", jwtSecret),
+			URLExpiryMinutes: urlExpiryMinutes,
+			MaxIssueBytes:    maxIssueBytes,
+			MaxOrgBytes:      maxOrgBytes,
+			AllowedTypes:     splitList(getEnv("STORAGE_ALLOWED_TYPES", "")),
+			DeniedTypes: splitList(getEnv("STORAGE_DENIED_TYPES",
+				"application/x-executable,application/x-sharedlib,application/x-object,application/x-coredump,"+
+					"application/x-mach-binary,application/vnd.microsoft.portable-executable,application/x-msdownload")),
+			ThumbnailSize: thumbnailSize,
+		},
+		Scanner: ScannerConfig{
+			Provider:       getEnv("SCANNER_PROVIDER", "none"),
+			Address:        getEnv("SCANNER_ADDRESS", "localhost:3310"),
+			TimeoutSeconds: scannerTimeout,
+
+			SweepIntervalMinutes: scannerSweepInterval,
+			RetryAfterMinutes:    scannerRetryAfter,
+		},
+		SLA: SLAConfig{
+			PauseStatuses: splitList(getEnv("SLA_PAUSE_STATUSES", "WAITING_ON_REPORTER,BLOCKED")),
+			PauseLabels:   splitList(getEnv("SLA_PAUSE_LABELS", "")),
+
+			MonitorIntervalSeconds: slaMonitorInterval,
+			RiskThresholds:         slaRiskThresholds,
+
+			EscalationPollSeconds: slaEscalationPoll,
+		},
+		Outbox: OutboxConfig{
+			Workers:            outboxWorkers,
+			PollSeconds:        outboxPoll,
+			MaxAttempts:        outboxMaxAttempts,
+			BaseBackoffSeconds: outboxBaseBackoff,
+			MaxBackoffSeconds:  outboxMaxBackoff,
+		},
+		Webhook: WebhookConfig{
+			AllowedTargets: splitList(getEnv("WEBHOOK_ALLOWED_TARGETS", "")),
+		},
+		Email: EmailConfig{
+			SMTPHost:          getEnv("SMTP_HOST", ""),
+			SMTPPort:          smtpPort,
+			Username:          getEnv("SMTP_USERNAME", ""),
+			Password:          getEnv("SMTP_PASSWORD", ""),
+			StartTLS:          smtpStartTLS,
+			From:              getEnv("EMAIL_FROM", "Buganizer <buganizer@localhost>"),
+			UnsubscribeSecret: getEnv("EMAIL_UNSUBSCRIBE_SECRET", jwtSecret),
+		},
+		Inbox: InboxConfig{
+			RetentionDays:        inboxRetention,
+			PruneIntervalMinutes: inboxPruneInterval,
 		},
 		BaseURL: getEnv("BASE_URL", "http://localhost:8080"),
 	}, nil
 }
 
+// splitList splits a comma-separated list, dropping empty entries
+func splitList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// parsePositiveInt parses an integer that must be greater than zero, such as
+// a buffer size
+func parsePositiveInt(value string) (int, error) {
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, err
+	}
+	if n <= 0 {
+		return 0, fmt.Errorf("%d is not greater than 0", n)
+	}
+	return n, nil
+}
+
+// parsePercentages parses a comma-separated list of percentages between 1 and
+// 99, returning them sorted
+func parsePercentages(value string) ([]int, error) {
+	var percentages []int
+	for _, item := range splitList(value) {
+		percentage, err := strconv.Atoi(item)
+		if err != nil {
+			return nil, err
+		}
+		if percentage < 1 || percentage > 99 {
+			return nil, fmt.Errorf("%d is not between 1 and 99", percentage)
+		}
+		percentages = append(percentages, percentage)
+	}
+	sort.Ints(percentages)
+	return percentages, nil
+}
+
 // getEnv gets an environment variable or returns a default value
 func getEnv(key, defaultValue string) string {
 	value := os.Getenv(key)
@@ -0,0 +1,50 @@
+// config/config_test.go
+package config
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestLoadRejectsNonPositiveSizes(t *testing.T) {
+	tests := []struct {
+		env   string
+		value string
+	}{
+		{"STORAGE_CHUNK_SIZE", "0"},
+		{"STORAGE_CHUNK_SIZE", "-1"},
+		{"STORAGE_UPLOAD_PART_SIZE", "0"},
+		{"STORAGE_UPLOAD_PART_SIZE", "-8388608"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.env+"="+tt.value, func(t *testing.T) {
+			t.Setenv(tt.env, tt.value)
+
+			_, err := Load()
+			if err == nil || !strings.Contains(err.Error(), "invalid "+tt.env) {
+				t.Errorf("Load() error = %v, want invalid %s", err, tt.env)
+			}
+		})
+	}
+}
+
+func TestParsePositiveInt(t *testing.T) {
+	tests := []struct {
+		value string
+		want  int
+		err   bool
+	}{
+		{"262144", 262144, false},
+		{"1", 1, false},
+		{"0", 0, true},
+		{"-4", 0, true},
+		{"4k", 0, true},
+	}
+	for _, tt := range tests {
+		got, err := parsePositiveInt(tt.value)
+		if (err != nil) != tt.err || got != tt.want {
+			t.Errorf("parsePositiveInt(%q) = %d, %v", tt.value, got, err)
+		}
+	}
+}
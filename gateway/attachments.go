@@ -0,0 +1,287 @@
+// gateway/attachments.go
+package gateway
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/matthewmc1/buganizer/proto"
+)
+
+// RegisterAttachmentHandlers adds the HTTP endpoints for attachment content that
+// the generated gateway cannot express: multipart uploads streamed to
+// UploadAttachment and ranged downloads of content and thumbnails from
+// DownloadAttachment. They must be registered after the generated handlers so
+// they take precedence.
+func RegisterAttachmentHandlers(mux *runtime.ServeMux, client pb.IssueServiceClient, chunkSize int) error {
+	h := &attachmentHandler{mux: mux, client: client, chunkSize: chunkSize}
+
+	if err := mux.HandlePath(http.MethodPost, "/api/v1/issues/{issue_id}/attachments", h.upload); err != nil {
+		return err
+	}
+	if err := mux.HandlePath(http.MethodGet, "/api/v1/attachments/{attachment_id}/download", h.download); err != nil {
+		return err
+	}
+	return mux.HandlePath(http.MethodGet, "/api/v1/attachments/{attachment_id}/thumbnail", h.thumbnail)
+}
+
+type attachmentHandler struct {
+	mux       *runtime.ServeMux
+	client    pb.IssueServiceClient
+	chunkSize int
+}
+
+// upload streams the "file" part of a multipart/form-data request to
+// UploadAttachment. Other requests are treated as a JSON AddAttachment call.
+func (h *attachmentHandler) upload(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+	inbound, outbound := runtime.MarshalerForRequest(h.mux, r)
+
+	ctx, err := runtime.AnnotateContext(r.Context(), h.mux, r, "/buganizer.IssueService/UploadAttachment")
+	if err != nil {
+		runtime.HTTPError(ctx, h.mux, outbound, w, r, err)
+		return
+	}
+
+	issueID := pathParams["issue_id"]
+
+	mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if mediaType != "multipart/form-data" {
+		var req pb.AddAttachmentRequest
+		if err := inbound.NewDecoder(r.Body).Decode(&req); err != nil && err != io.EOF {
+			runtime.HTTPError(ctx, h.mux, outbound, w, r, status.Errorf(codes.InvalidArgument, "%v", err))
+			return
+		}
+		req.IssueId = issueID
+
+		attachment, err := h.client.AddAttachment(ctx, &req)
+		if err != nil {
+			runtime.HTTPError(ctx, h.mux, outbound, w, r, err)
+			return
+		}
+		h.writeMessage(ctx, w, r, outbound, attachment)
+		return
+	}
+
+	reader, err := r.MultipartReader()
+	if err != nil {
+		runtime.HTTPError(ctx, h.mux, outbound, w, r, status.Errorf(codes.InvalidArgument, "%v", err))
+		return
+	}
+
+	// Skip to the file part
+	for {
+		part, err := reader.NextPart()
+		if err == io.EOF {
+			runtime.HTTPError(ctx, h.mux, outbound, w, r, status.Error(codes.InvalidArgument, "a \"file\" part is required"))
+			return
+		}
+		if err != nil {
+			runtime.HTTPError(ctx, h.mux, outbound, w, r, status.Errorf(codes.InvalidArgument, "%v", err))
+			return
+		}
+		if part.FormName() != "file" {
+			part.Close()
+			continue
+		}
+
+		attachment, err := h.streamUpload(ctx, issueID, part.FileName(), part)
+		part.Close()
+		if err != nil {
+			runtime.HTTPError(ctx, h.mux, outbound, w, r, err)
+			return
+		}
+		h.writeMessage(ctx, w, r, outbound, attachment)
+		return
+	}
+}
+
+// streamUpload sends the content of body to UploadAttachment in chunks
+func (h *attachmentHandler) streamUpload(ctx context.Context, issueID, filename string, body io.Reader) (*pb.Attachment, error) {
+	stream, err := h.client.UploadAttachment(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = stream.Send(&pb.UploadAttachmentRequest{
+		Data: &pb.UploadAttachmentRequest_Metadata{
+			Metadata: &pb.UploadMetadata{
+				UploadId: uuid.New().String(),
+				IssueId:  issueID,
+				Filename: filename,
+			},
+		},
+	})
+	if err != nil {
+		// The server's status is reported by CloseAndRecv
+		return stream.CloseAndRecv()
+	}
+
+	buf := make([]byte, h.chunkSize)
+	for {
+		n, readErr := io.ReadFull(body, buf)
+		if n > 0 {
+			chunk := make([]byte, n)
+			copy(chunk, buf[:n])
+			err := stream.Send(&pb.UploadAttachmentRequest{
+				Data: &pb.UploadAttachmentRequest_Chunk{Chunk: chunk},
+			})
+			if err != nil {
+				return stream.CloseAndRecv()
+			}
+		}
+		if readErr == io.EOF || readErr == io.ErrUnexpectedEOF {
+			break
+		}
+		if readErr != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "failed to read upload: %v", readErr)
+		}
+	}
+
+	return stream.CloseAndRecv()
+}
+
+// download writes an attachment's content
+func (h *attachmentHandler) download(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+	h.serve(w, r, pathParams["attachment_id"], false)
+}
+
+// thumbnail writes an attachment's thumbnail
+func (h *attachmentHandler) thumbnail(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+	h.serve(w, r, pathParams["attachment_id"], true)
+}
+
+// serve writes an attachment's content or thumbnail, honouring a single-range Range header
+func (h *attachmentHandler) serve(w http.ResponseWriter, r *http.Request, attachmentID string, thumbnail bool) {
+	_, outbound := runtime.MarshalerForRequest(h.mux, r)
+
+	ctx, err := runtime.AnnotateContext(r.Context(), h.mux, r, "/buganizer.IssueService/DownloadAttachment")
+	if err != nil {
+		runtime.HTTPError(ctx, h.mux, outbound, w, r, err)
+		return
+	}
+
+	req := &pb.DownloadAttachmentRequest{
+		AttachmentId: attachmentID,
+		Signature:    r.URL.Query().Get("signature"),
+		Thumbnail:    thumbnail,
+	}
+	if expires := r.URL.Query().Get("expires"); expires != "" {
+		req.Expires, _ = strconv.ParseInt(expires, 10, 64)
+	}
+	ranged := false
+	if start, end, ok := parseRange(r.Header.Get("Range")); ok {
+		ranged = true
+		req.Offset = start
+		if end >= 0 {
+			req.Length = end - start + 1
+		}
+	}
+
+	stream, err := h.client.DownloadAttachment(ctx, req)
+	if err != nil {
+		runtime.HTTPError(ctx, h.mux, outbound, w, r, err)
+		return
+	}
+
+	// The first chunk carries the metadata needed for the headers
+	first, err := stream.Recv()
+	if err != nil {
+		if ranged && status.Code(err) == codes.OutOfRange {
+			w.Header().Set("Content-Range", "bytes */*")
+			w.WriteHeader(http.StatusRequestedRangeNotSatisfiable)
+			return
+		}
+		runtime.HTTPError(ctx, h.mux, outbound, w, r, err)
+		return
+	}
+
+	total := first.TotalSize
+	length := total - req.Offset
+	if req.Length > 0 && req.Length < length {
+		length = req.Length
+	}
+
+	if ranged && req.Offset >= total {
+		w.Header().Set("Content-Range", fmt.Sprintf("bytes */%d", total))
+		w.WriteHeader(http.StatusRequestedRangeNotSatisfiable)
+		return
+	}
+
+	w.Header().Set("Content-Type", first.ContentType)
+	w.Header().Set("Content-Length", strconv.FormatInt(length, 10))
+	w.Header().Set("Accept-Ranges", "bytes")
+	w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": first.Filename}))
+	if ranged {
+		w.Header().Set("Content-Range", fmt.Sprintf("bytes %d-%d/%d", req.Offset, req.Offset+length-1, total))
+		w.WriteHeader(http.StatusPartialContent)
+	} else {
+		w.WriteHeader(http.StatusOK)
+	}
+
+	if _, err := w.Write(first.Data); err != nil {
+		return
+	}
+	for {
+		chunk, err := stream.Recv()
+		if err != nil {
+			// Headers are already sent; a failure can only cut the body short
+			return
+		}
+		if _, err := w.Write(chunk.Data); err != nil {
+			return
+		}
+	}
+}
+
+// writeMessage marshals a response message with the gateway's marshaler
+func (h *attachmentHandler) writeMessage(ctx context.Context, w http.ResponseWriter, r *http.Request, marshaler runtime.Marshaler, msg *pb.Attachment) {
+	body, err := marshaler.Marshal(msg)
+	if err != nil {
+		runtime.HTTPError(ctx, h.mux, marshaler, w, r, err)
+		return
+	}
+	w.Header().Set("Content-Type", marshaler.ContentType(msg))
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(body)
+}
+
+// parseRange parses a "bytes=start-end" or "bytes=start-" header. end is -1
+// when open-ended. Multiple ranges and suffix ranges are not supported and
+// are reported as not ok, in which case the whole content is served.
+func parseRange(header string) (start, end int64, ok bool) {
+	spec, found := strings.CutPrefix(header, "bytes=")
+	if !found || strings.Contains(spec, ",") {
+		return 0, 0, false
+	}
+
+	from, to, found := strings.Cut(spec, "-")
+	if !found || from == "" {
+		return 0, 0, false
+	}
+
+	start, err := strconv.ParseInt(strings.TrimSpace(from), 10, 64)
+	if err != nil || start < 0 {
+		return 0, 0, false
+	}
+
+	if strings.TrimSpace(to) == "" {
+		return start, -1, true
+	}
+
+	end, err = strconv.ParseInt(strings.TrimSpace(to), 10, 64)
+	if err != nil || end < start {
+		return 0, 0, false
+	}
+
+	return start, end, true
+}
@@ -0,0 +1,79 @@
+// gateway/oncall.go
+package gateway
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/matthewmc1/buganizer/ical"
+	pb "github.com/matthewmc1/buganizer/proto"
+)
+
+// calendarRange is how far ahead the iCalendar export of shifts looks
+const calendarRange = 90 * 24 * time.Hour
+
+// RegisterOnCallHandlers adds the iCalendar export of a user's upcoming
+// on-call shifts, which calendar apps can subscribe to. "me" exports the
+// caller's shifts.
+func RegisterOnCallHandlers(mux *runtime.ServeMux, client pb.OnCallServiceClient) error {
+	h := &onCallHandler{mux: mux, client: client}
+
+	return mux.HandlePath(http.MethodGet, "/api/v1/oncall/users/{user_id}/shifts.ics", h.calendar)
+}
+
+type onCallHandler struct {
+	mux    *runtime.ServeMux
+	client pb.OnCallServiceClient
+}
+
+// calendar writes the user's shifts over the next 90 days as text/calendar
+func (h *onCallHandler) calendar(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+	_, outbound := runtime.MarshalerForRequest(h.mux, r)
+
+	ctx, err := runtime.AnnotateContext(r.Context(), h.mux, r, "/buganizer.OnCallService/ListShifts")
+	if err != nil {
+		runtime.HTTPError(ctx, h.mux, outbound, w, r, err)
+		return
+	}
+
+	now := time.Now()
+	resp, err := h.client.ListShifts(ctx, &pb.ListShiftsRequest{
+		UserId:    pathParams["user_id"],
+		StartDate: timestamppb.New(now),
+		EndDate:   timestamppb.New(now.Add(calendarRange)),
+	})
+	if err != nil {
+		runtime.HTTPError(ctx, h.mux, outbound, w, r, err)
+		return
+	}
+
+	events := make([]ical.Event, 0, len(resp.Shifts))
+	for _, shift := range resp.Shifts {
+		description := ""
+		if shift.OverrideId != "" {
+			description = "Override of the regular rotation"
+		}
+		events = append(events, ical.Event{
+			UID:         fmt.Sprintf("%s-%s-%d@buganizer", shift.RotationId, shift.UserId, shift.StartAt.AsTime().Unix()),
+			Start:       shift.StartAt.AsTime(),
+			End:         shift.EndAt.AsTime(),
+			Summary:     "On call: " + shift.RotationName,
+			Description: description,
+		})
+	}
+
+	var buf bytes.Buffer
+	if err := ical.WriteEvents(&buf, "On-call shifts", events, now); err != nil {
+		runtime.HTTPError(ctx, h.mux, outbound, w, r, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/calendar; charset=utf-8")
+	w.Header().Set("Content-Disposition", `inline; filename="shifts.ics"`)
+	w.Write(buf.Bytes())
+}
@@ -0,0 +1,87 @@
+// gateway/unsubscribe.go
+package gateway
+
+import (
+	"html/template"
+	"net/http"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/matthewmc1/buganizer/proto"
+)
+
+// unsubscribePage asks to confirm turning off email, or reports the outcome.
+// Turning it off takes a POST so link scanners that follow every link in an
+// email do not unsubscribe the recipient.
+var unsubscribePage = template.Must(template.New("unsubscribe").Parse(`<!DOCTYPE html>
+<html>
+<head><title>Unsubscribe from Buganizer email</title></head>
+<body style="font-family: Arial, sans-serif; max-width: 480px; margin: 48px auto;">
+{{- if .Error}}
+<p>{{.Error}}</p>
+{{- else if .Email}}
+<p>{{.Email}} will no longer get email notifications from Buganizer. You can turn them back on in your settings.</p>
+{{- else}}
+<p>Stop getting email notifications from Buganizer?</p>
+<form method="post">
+  <input type="hidden" name="List-Unsubscribe" value="One-Click">
+  <button type="submit">Unsubscribe</button>
+</form>
+{{- end}}
+</body>
+</html>
+`))
+
+// RegisterUnsubscribeHandlers adds the page behind the unsubscribe links of
+// notification emails. POST also serves one-click unsubscribe from mail
+// clients (RFC 8058).
+func RegisterUnsubscribeHandlers(mux *runtime.ServeMux, client pb.NotificationServiceClient) error {
+	h := &unsubscribeHandler{mux: mux, client: client}
+
+	if err := mux.HandlePath(http.MethodGet, "/api/v1/notifications/unsubscribe", h.confirm); err != nil {
+		return err
+	}
+	return mux.HandlePath(http.MethodPost, "/api/v1/notifications/unsubscribe", h.unsubscribe)
+}
+
+type unsubscribeHandler struct {
+	mux    *runtime.ServeMux
+	client pb.NotificationServiceClient
+}
+
+// confirm asks the user to confirm unsubscribing
+func (h *unsubscribeHandler) confirm(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	writeUnsubscribePage(w, http.StatusOK, "", "")
+}
+
+// unsubscribe turns off email notifications for the user of the link
+func (h *unsubscribeHandler) unsubscribe(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	ctx, err := runtime.AnnotateContext(r.Context(), h.mux, r, "/buganizer.NotificationService/Unsubscribe")
+	if err != nil {
+		writeUnsubscribePage(w, http.StatusInternalServerError, "", "Something went wrong, please try again.")
+		return
+	}
+
+	query := r.URL.Query()
+	resp, err := h.client.Unsubscribe(ctx, &pb.UnsubscribeRequest{
+		UserId:    query.Get("user_id"),
+		Signature: query.Get("signature"),
+	})
+	if err != nil {
+		writeUnsubscribePage(w, runtime.HTTPStatusFromCode(status.Code(err)), "", "This unsubscribe link is not valid.")
+		return
+	}
+
+	writeUnsubscribePage(w, http.StatusOK, resp.Email, "")
+}
+
+// writeUnsubscribePage renders the unsubscribe page
+func writeUnsubscribePage(w http.ResponseWriter, code int, email, message string) {
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.WriteHeader(code)
+	unsubscribePage.Execute(w, struct {
+		Email string
+		Error string
+	}{email, message})
+}
@@ -0,0 +1,88 @@
+// ical/export.go
+package ical
+
+import (
+	"bufio"
+	"io"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// maxLineOctets is the longest a content line may be before it is folded
+const maxLineOctets = 75
+
+// Event is a timed VEVENT to export
+type Event struct {
+	UID         string
+	Start       time.Time
+	End         time.Time
+	Summary     string
+	Description string
+}
+
+// WriteEvents writes events as an iCalendar (RFC 5545) file named
+// calendarName. stamp is the DTSTAMP of every event.
+func WriteEvents(w io.Writer, calendarName string, events []Event, stamp time.Time) error {
+	bw := bufio.NewWriter(w)
+
+	writeLine(bw, "BEGIN:VCALENDAR")
+	writeLine(bw, "VERSION:2.0")
+	writeLine(bw, "PRODID:-//Buganizer//On-call//EN")
+	writeLine(bw, "CALSCALE:GREGORIAN")
+	writeLine(bw, "METHOD:PUBLISH")
+	writeLine(bw, "X-WR-CALNAME:"+escape(calendarName))
+
+	for _, event := range events {
+		writeLine(bw, "BEGIN:VEVENT")
+		writeLine(bw, "UID:"+escape(event.UID))
+		writeLine(bw, "DTSTAMP:"+formatTime(stamp))
+		writeLine(bw, "DTSTART:"+formatTime(event.Start))
+		writeLine(bw, "DTEND:"+formatTime(event.End))
+		writeLine(bw, "SUMMARY:"+escape(event.Summary))
+		if event.Description != "" {
+			writeLine(bw, "DESCRIPTION:"+escape(event.Description))
+		}
+		writeLine(bw, "TRANSP:TRANSPARENT")
+		writeLine(bw, "END:VEVENT")
+	}
+
+	writeLine(bw, "END:VCALENDAR")
+	return bw.Flush()
+}
+
+// writeLine writes a content line ending in CRLF, folding it so no line is
+// longer than 75 octets without splitting a UTF-8 character
+func writeLine(w *bufio.Writer, line string) {
+	limit := maxLineOctets
+	for len(line) > limit {
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(line[cut]) {
+			cut--
+		}
+		w.WriteString(line[:cut])
+		w.WriteString("\r\n ")
+		line = line[cut:]
+
+		// Continuation lines start with a space
+		limit = maxLineOctets - 1
+	}
+	w.WriteString(line)
+	w.WriteString("\r\n")
+}
+
+// formatTime formats a time as a UTC DATE-TIME value
+func formatTime(t time.Time) string {
+	return t.UTC().Format("20060102T150405Z")
+}
+
+// escape escapes a TEXT value, the reverse of unescape
+func escape(value string) string {
+	return strings.NewReplacer(
+		`\`, `\\`,
+		";", `\;`,
+		",", `\,`,
+		"\r\n", `\n`,
+		"\n", `\n`,
+	).Replace(value)
+}
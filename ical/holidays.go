@@ -0,0 +1,219 @@
+// ical/holidays.go
+package ical
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/matthewmc1/buganizer/models"
+)
+
+// maxEventDays bounds how many days a single multi-day event can cover
+const maxEventDays = 31
+
+// event holds the properties of a VEVENT that matter for holidays
+type event struct {
+	start     time.Time
+	end       time.Time
+	summary   string
+	rrule     string
+	cancelled bool
+}
+
+// ParseHolidays reads the all-day events of an iCalendar (RFC 5545) file as
+// holidays. Multi-day events produce one holiday per day. Yearly recurrence
+// rules are expanded up to their COUNT or UNTIL, or up to until when they are
+// unbounded; the first occurrence is used for other rules. The result is
+// sorted by date with one holiday per day.
+func ParseHolidays(r io.Reader, until time.Time) ([]models.Holiday, error) {
+	lines, err := unfold(r)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(lines) == 0 || !strings.EqualFold(lines[0], "BEGIN:VCALENDAR") {
+		return nil, fmt.Errorf("not an iCalendar file")
+	}
+
+	byDate := make(map[string]models.Holiday)
+	var current *event
+	for i, line := range lines {
+		name, params, value := splitProperty(line)
+
+		switch {
+		case name == "BEGIN" && strings.EqualFold(value, "VEVENT"):
+			current = &event{}
+		case name == "END" && strings.EqualFold(value, "VEVENT"):
+			if current == nil {
+				return nil, fmt.Errorf("line %d: END:VEVENT without BEGIN:VEVENT", i+1)
+			}
+			if current.start.IsZero() {
+				return nil, fmt.Errorf("line %d: event without DTSTART", i+1)
+			}
+			if !current.cancelled {
+				for _, day := range current.days(until) {
+					key := day.Format("2006-01-02")
+					if _, ok := byDate[key]; !ok {
+						byDate[key] = models.Holiday{Date: day, Name: current.summary}
+					}
+				}
+			}
+			current = nil
+		case current == nil:
+			// Properties outside events, e.g. of the calendar or a VTIMEZONE
+		case name == "DTSTART":
+			if current.start, err = parseDate(value); err != nil {
+				return nil, fmt.Errorf("line %d: invalid DTSTART: %v", i+1, err)
+			}
+		case name == "DTEND":
+			if current.end, err = parseDate(value); err != nil {
+				return nil, fmt.Errorf("line %d: invalid DTEND: %v", i+1, err)
+			}
+			// An end time after midnight falls on its day, unlike an exclusive date
+			if !strings.Contains(params, "VALUE=DATE") && len(value) >= 15 && value[8:15] != "T000000" {
+				current.end = current.end.AddDate(0, 0, 1)
+			}
+		case name == "SUMMARY":
+			current.summary = unescape(value)
+		case name == "RRULE":
+			current.rrule = value
+		case name == "STATUS":
+			current.cancelled = strings.EqualFold(value, "CANCELLED")
+		}
+	}
+
+	holidays := make([]models.Holiday, 0, len(byDate))
+	for _, holiday := range byDate {
+		holidays = append(holidays, holiday)
+	}
+	sort.Slice(holidays, func(i, j int) bool {
+		return holidays[i].Date.Before(holidays[j].Date)
+	})
+
+	return holidays, nil
+}
+
+// days lists every day the event covers, including its recurrences
+func (e *event) days(until time.Time) []time.Time {
+	length := 1
+	if !e.end.IsZero() && e.end.After(e.start) {
+		length = int(e.end.Sub(e.start).Hours()/24 + 0.5)
+	}
+	if length > maxEventDays {
+		length = maxEventDays
+	}
+
+	var days []time.Time
+	for _, start := range e.occurrences(until) {
+		for d := 0; d < length; d++ {
+			days = append(days, start.AddDate(0, 0, d))
+		}
+	}
+	return days
+}
+
+// occurrences lists the start of each occurrence of the event
+func (e *event) occurrences(until time.Time) []time.Time {
+	rule := parseRule(e.rrule)
+	if rule["FREQ"] != "YEARLY" {
+		return []time.Time{e.start}
+	}
+
+	interval := 1
+	if n, err := strconv.Atoi(rule["INTERVAL"]); err == nil && n > 0 {
+		interval = n
+	}
+
+	count := -1
+	if n, err := strconv.Atoi(rule["COUNT"]); err == nil && n > 0 {
+		count = n
+	}
+
+	if value, ok := rule["UNTIL"]; ok {
+		if t, err := parseDate(value); err == nil {
+			until = t
+		}
+	}
+
+	var starts []time.Time
+	for year := 0; count < 0 || len(starts) < count; year += interval {
+		start := e.start.AddDate(year, 0, 0)
+		if start.After(until) {
+			break
+		}
+		starts = append(starts, start)
+	}
+	return starts
+}
+
+// unfold reads content lines, joining continuation lines
+func unfold(r io.Reader) ([]string, error) {
+	var lines []string
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if (strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t")) && len(lines) > 0 {
+			lines[len(lines)-1] += line[1:]
+			continue
+		}
+		if line != "" {
+			lines = append(lines, line)
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return lines, nil
+}
+
+// splitProperty splits a content line into its upper-cased name, its
+// parameters and its value
+func splitProperty(line string) (name, params, value string) {
+	quoted := false
+	for i, c := range line {
+		switch c {
+		case '"':
+			quoted = !quoted
+		case ':':
+			if quoted {
+				continue
+			}
+			name, params, _ = strings.Cut(line[:i], ";")
+			return strings.ToUpper(name), strings.ToUpper(params), line[i+1:]
+		}
+	}
+	return strings.ToUpper(line), "", ""
+}
+
+// parseDate parses the date part of a DATE or DATE-TIME value as midnight UTC
+func parseDate(value string) (time.Time, error) {
+	if len(value) < 8 {
+		return time.Time{}, fmt.Errorf("%q is not a date", value)
+	}
+	return time.Parse("20060102", value[:8])
+}
+
+// parseRule parses an RRULE value into its parts
+func parseRule(value string) map[string]string {
+	rule := make(map[string]string)
+	for _, part := range strings.Split(value, ";") {
+		if key, val, ok := strings.Cut(part, "="); ok {
+			rule[strings.ToUpper(key)] = strings.ToUpper(val)
+		}
+	}
+	return rule
+}
+
+// unescape decodes the escapes of a TEXT value
+func unescape(value string) string {
+	return strings.NewReplacer(`\n`, " ", `\N`, " ", `\,`, ",", `\;`, ";", `\\`, `\`).Replace(value)
+}
@@ -17,8 +17,9 @@ import (
 
 // AuthInterceptor is a server interceptor for authentication and authorization
 type AuthInterceptor struct {
-	jwtSecret   string
-	publicPaths map[string]bool
+	jwtSecret         string
+	publicPaths       map[string]bool
+	optionalAuthPaths map[string]bool
 }
 
 // NewAuthInterceptor creates a new auth interceptor
@@ -30,9 +31,17 @@ func NewAuthInterceptor(config *config.Config) *AuthInterceptor {
 		"/health.HealthService/Check":                   true,
 	}
 
+	// Define paths that may be called without a token; the handler then
+	// authorizes the request itself (e.g. with a signed download URL)
+	optionalAuthPaths := map[string]bool{
+		"/buganizer.IssueService/DownloadAttachment": true,
+		"/buganizer.NotificationService/Unsubscribe": true,
+	}
+
 	return &AuthInterceptor{
-		jwtSecret:   config.Auth.JWTSecret,
-		publicPaths: publicPaths,
+		jwtSecret:         config.Auth.JWTSecret,
+		publicPaths:       publicPaths,
+		optionalAuthPaths: optionalAuthPaths,
 	}
 }
 
@@ -49,6 +58,11 @@ func (interceptor *AuthInterceptor) Unary() grpc.UnaryServerInterceptor {
 			return handler(ctx, req)
 		}
 
+		// Allow anonymous calls to paths with optional authentication
+		if interceptor.optionalAuthPaths[info.FullMethod] && !hasToken(ctx) {
+			return handler(ctx, req)
+		}
+
 		// Extract token from metadata
 		userID, err := interceptor.authenticate(ctx)
 		if err != nil {
@@ -76,6 +90,11 @@ func (interceptor *AuthInterceptor) Stream() grpc.StreamServerInterceptor {
 			return handler(srv, stream)
 		}
 
+		// Allow anonymous calls to paths with optional authentication
+		if interceptor.optionalAuthPaths[info.FullMethod] && !hasToken(stream.Context()) {
+			return handler(srv, stream)
+		}
+
 		// Extract token from metadata
 		userID, err := interceptor.authenticate(stream.Context())
 		if err != nil {
@@ -122,6 +141,12 @@ func (interceptor *AuthInterceptor) authenticate(ctx context.Context) (string, e
 	return claims.UserID, nil
 }
 
+// hasToken reports whether the request carries an authorization header
+func hasToken(ctx context.Context) bool {
+	md, ok := metadata.FromIncomingContext(ctx)
+	return ok && len(md["authorization"]) > 0
+}
+
 // verifyToken verifies the JWT token
 func (interceptor *AuthInterceptor) VerifyToken(tokenString string) (*auth.JWTClaims, error) {
 	token, err := jwt.ParseWithClaims(
@@ -0,0 +1,30 @@
+// middleware/forward.go
+package middleware
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// ForwardAuth returns a client interceptor for calls services make to each
+// other while handling a request. It forwards the caller's authorization
+// token so the called service acts on behalf of the same user.
+func ForwardAuth() grpc.UnaryClientInterceptor {
+	return func(
+		ctx context.Context,
+		method string,
+		req, reply interface{},
+		cc *grpc.ClientConn,
+		invoker grpc.UnaryInvoker,
+		opts ...grpc.CallOption,
+	) error {
+		if md, ok := metadata.FromIncomingContext(ctx); ok {
+			if values := md["authorization"]; len(values) > 0 {
+				ctx = metadata.AppendToOutgoingContext(ctx, "authorization", values[0])
+			}
+		}
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
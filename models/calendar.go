@@ -0,0 +1,140 @@
+// models/calendar.go
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// maxCalendarDays bounds the day-by-day walks of business time so a calendar
+// with no working time left cannot loop forever
+const maxCalendarDays = 3660
+
+// BusinessCalendar describes the working hours SLA clocks run in. It can be
+// attached to a team or an SLA policy.
+type BusinessCalendar struct {
+	ID             uuid.UUID      `json:"id" db:"id"`
+	OrganizationID uuid.UUID      `json:"organization_id" db:"organization_id"`
+	Name           string         `json:"name" db:"name"`
+	TimeZone       string         `json:"time_zone" db:"time_zone"` // IANA name, e.g. "Europe/London"
+	WorkingDays    []time.Weekday `json:"working_days" db:"working_days"`
+	DayStart       int            `json:"day_start" db:"day_start"` // Minutes after midnight
+	DayEnd         int            `json:"day_end" db:"day_end"`     // Minutes after midnight
+	Holidays       []Holiday      `json:"holidays" db:"-"`
+	CreatedAt      time.Time      `json:"created_at" db:"created_at"`
+	UpdatedAt      time.Time      `json:"updated_at" db:"updated_at"`
+	CreatedByID    *uuid.UUID     `json:"created_by_id" db:"created_by_id"`
+}
+
+// Holiday is a non-working day of a calendar
+type Holiday struct {
+	Date time.Time `json:"date" db:"date"` // Midnight UTC of the day
+	Name string    `json:"name" db:"name"`
+}
+
+// Location returns the calendar's time zone, falling back to UTC
+func (c *BusinessCalendar) Location() *time.Location {
+	loc, err := time.LoadLocation(c.TimeZone)
+	if err != nil {
+		return time.UTC
+	}
+	return loc
+}
+
+// AddBusinessTime returns the time at which d of working time has passed
+// after start
+func (c *BusinessCalendar) AddBusinessTime(start time.Time, d time.Duration) time.Time {
+	loc := c.Location()
+	holidays := c.holidaySet()
+
+	t := start.In(loc)
+	for i := 0; i < maxCalendarDays; i++ {
+		if open, close, ok := c.window(t, holidays); ok {
+			if t.Before(open) {
+				t = open
+			}
+			if t.Before(close) {
+				available := close.Sub(t)
+				if d <= available {
+					return t.Add(d)
+				}
+				d -= available
+			}
+		}
+		t = nextDay(t)
+	}
+
+	return t
+}
+
+// BusinessTimeBetween returns the working time from start to end. It is
+// negative when end is before start.
+func (c *BusinessCalendar) BusinessTimeBetween(start, end time.Time) time.Duration {
+	if end.Before(start) {
+		return -c.BusinessTimeBetween(end, start)
+	}
+
+	loc := c.Location()
+	holidays := c.holidaySet()
+	end = end.In(loc)
+
+	var total time.Duration
+	t := start.In(loc)
+	for i := 0; i < maxCalendarDays && t.Before(end); i++ {
+		if open, close, ok := c.window(t, holidays); ok {
+			from, to := open, close
+			if t.After(from) {
+				from = t
+			}
+			if end.Before(to) {
+				to = end
+			}
+			if to.After(from) {
+				total += to.Sub(from)
+			}
+		}
+		t = nextDay(t)
+	}
+
+	return total
+}
+
+// window returns the working hours of the day containing t, if it is a
+// working day
+func (c *BusinessCalendar) window(t time.Time, holidays map[string]bool) (open, close time.Time, ok bool) {
+	if !c.isWorkingDay(t.Weekday()) || holidays[t.Format("2006-01-02")] {
+		return time.Time{}, time.Time{}, false
+	}
+
+	year, month, day := t.Date()
+	loc := t.Location()
+	open = time.Date(year, month, day, c.DayStart/60, c.DayStart%60, 0, 0, loc)
+	close = time.Date(year, month, day, c.DayEnd/60, c.DayEnd%60, 0, 0, loc)
+	return open, close, close.After(open)
+}
+
+// isWorkingDay checks if a weekday is one of the calendar's working days
+func (c *BusinessCalendar) isWorkingDay(weekday time.Weekday) bool {
+	for _, d := range c.WorkingDays {
+		if d == weekday {
+			return true
+		}
+	}
+	return false
+}
+
+// holidaySet indexes the holidays by date
+func (c *BusinessCalendar) holidaySet() map[string]bool {
+	set := make(map[string]bool, len(c.Holidays))
+	for _, h := range c.Holidays {
+		set[h.Date.Format("2006-01-02")] = true
+	}
+	return set
+}
+
+// nextDay returns midnight of the day after t, in t's location
+func nextDay(t time.Time) time.Time {
+	year, month, day := t.Date()
+	return time.Date(year, month, day+1, 0, 0, 0, 0, t.Location())
+}
@@ -0,0 +1,83 @@
+// models/escalation.go
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// EscalationAction is what an escalation step does
+type EscalationAction string
+
+const (
+	EscalationNotifyAssignee EscalationAction = "NOTIFY_ASSIGNEE"  // Message the assignee
+	EscalationNotifyTeamLead EscalationAction = "NOTIFY_TEAM_LEAD" // Message the lead of the component's team
+	EscalationReassignOnCall EscalationAction = "REASSIGN_ON_CALL" // Assign to the target user, else the team's on-call
+	EscalationBumpPriority   EscalationAction = "BUMP_PRIORITY"    // Raise to the target priority, else one level
+	EscalationPageWebhook    EscalationAction = "PAGE_WEBHOOK"     // Call the registered webhook in the target
+)
+
+// EscalationStatus is the state of a scheduled escalation step
+type EscalationStatus string
+
+const (
+	EscalationPending   EscalationStatus = "PENDING"   // Waiting to run, or to be retried
+	EscalationDone      EscalationStatus = "DONE"      // Ran
+	EscalationSkipped   EscalationStatus = "SKIPPED"   // Did not apply, e.g. no assignee to notify
+	EscalationFailed    EscalationStatus = "FAILED"    // Gave up after retrying
+	EscalationCancelled EscalationStatus = "CANCELLED" // The issue was resolved first
+)
+
+// EscalationPolicy lists the steps taken when an issue of a component or team
+// breaches its SLA
+type EscalationPolicy struct {
+	ID             uuid.UUID        `json:"id" db:"id"`
+	OrganizationID uuid.UUID        `json:"organization_id" db:"organization_id"`
+	Name           string           `json:"name" db:"name"`
+	ComponentID    *uuid.UUID       `json:"component_id" db:"component_id"` // Exactly one of ComponentID and TeamID is set
+	TeamID         *uuid.UUID       `json:"team_id" db:"team_id"`
+	Priorities     []Priority       `json:"priorities" db:"priorities"` // Priorities that escalate; empty for all
+	Steps          []EscalationStep `json:"steps" db:"-"`
+	CreatedAt      time.Time        `json:"created_at" db:"created_at"`
+	UpdatedAt      time.Time        `json:"updated_at" db:"updated_at"`
+	CreatedByID    *uuid.UUID       `json:"created_by_id" db:"created_by_id"`
+}
+
+// EscalationStep is one step of an escalation policy
+type EscalationStep struct {
+	Action       EscalationAction `json:"action" db:"action"`
+	DelayMinutes int              `json:"delay_minutes" db:"delay_minutes"` // After the previous step
+	Target       string           `json:"target" db:"target"`
+}
+
+// Applies checks if the policy escalates issues of a priority
+func (p *EscalationPolicy) Applies(priority Priority) bool {
+	if len(p.Priorities) == 0 {
+		return true
+	}
+	for _, pr := range p.Priorities {
+		if pr == priority {
+			return true
+		}
+	}
+	return false
+}
+
+// Escalation is a step of an escalation policy scheduled for an issue. The
+// step is copied so later policy changes don't affect running escalations.
+type Escalation struct {
+	ID          uuid.UUID        `json:"id" db:"id"`
+	IssueID     uuid.UUID        `json:"issue_id" db:"issue_id"`
+	PolicyID    *uuid.UUID       `json:"policy_id" db:"policy_id"` // Nil once the policy is deleted
+	Position    int              `json:"position" db:"position"`
+	Action      EscalationAction `json:"action" db:"action"`
+	Target      string           `json:"target" db:"target"`
+	RunAt       time.Time        `json:"run_at" db:"run_at"`
+	Status      EscalationStatus `json:"status" db:"status"`
+	Attempts    int              `json:"attempts" db:"attempts"`
+	LastError   string           `json:"last_error" db:"last_error"`
+	FinishedAt  *time.Time       `json:"finished_at" db:"finished_at"`
+	CreatedAt   time.Time        `json:"created_at" db:"created_at"`
+	LockedUntil *time.Time       `json:"-" db:"locked_until"` // Lease of the scheduler running it
+}
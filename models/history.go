@@ -0,0 +1,19 @@
+// models/history.go
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// IssueHistory records a change to one field of an issue
+type IssueHistory struct {
+	ID        uuid.UUID  `json:"id" db:"id"`
+	IssueID   uuid.UUID  `json:"issue_id" db:"issue_id"`
+	ActorID   *uuid.UUID `json:"actor_id" db:"actor_id"` // Nil for changes made by the system
+	Field     string     `json:"field" db:"field"`
+	OldValue  string     `json:"old_value" db:"old_value"`
+	NewValue  string     `json:"new_value" db:"new_value"`
+	CreatedAt time.Time  `json:"created_at" db:"created_at"`
+}
@@ -2,6 +2,7 @@
 package models
 
 import (
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -32,14 +33,52 @@ const (
 type Status string
 
 const (
-	StatusNew        Status = "New"
-	StatusAssigned   Status = "Assigned"
-	StatusInProgress Status = "In Progress"
-	StatusFixed      Status = "Fixed"
-	StatusVerified   Status = "Verified"
-	StatusClosed     Status = "Closed"
-	StatusDuplicate  Status = "Duplicate"
-	StatusWontFix    Status = "Won't Fix"
+	StatusNew               Status = "New"
+	StatusAssigned          Status = "Assigned"
+	StatusInProgress        Status = "In Progress"
+	StatusFixed             Status = "Fixed"
+	StatusVerified          Status = "Verified"
+	StatusClosed            Status = "Closed"
+	StatusDuplicate         Status = "Duplicate"
+	StatusWontFix           Status = "Won't Fix"
+	StatusWaitingOnReporter Status = "Waiting on Reporter"
+	StatusBlocked           Status = "Blocked"
+)
+
+// IsResolved checks if a status ends the issue's SLA for good. Both the model
+// values ("Won't Fix") and the enum names stored by updates ("WONT_FIX") are
+// recognized.
+func (s Status) IsResolved() bool {
+	switch s.enumName() {
+	case "FIXED", "VERIFIED", "CLOSED", "DUPLICATE", "WONT_FIX":
+		return true
+	}
+	return false
+}
+
+// IsFixed checks if a status means the issue was resolved by fixing it, which
+// meets its resolution SLA
+func (s Status) IsFixed() bool {
+	switch s.enumName() {
+	case "FIXED", "VERIFIED", "CLOSED":
+		return true
+	}
+	return false
+}
+
+// enumName converts a status to its enum name, e.g. "Won't Fix" to "WONT_FIX"
+func (s Status) enumName() string {
+	return strings.NewReplacer(" ", "_", "'", "").Replace(strings.ToUpper(string(s)))
+}
+
+// ScanStatus is the outcome of scanning an attachment for malware
+type ScanStatus string
+
+const (
+	ScanStatusPending  ScanStatus = "PENDING"  // Not yet scanned; not downloadable
+	ScanStatusClean    ScanStatus = "CLEAN"    // Scanned and downloadable
+	ScanStatusInfected ScanStatus = "INFECTED" // Quarantined
+	ScanStatusError    ScanStatus = "ERROR"    // Scan failed; not downloadable
 )
 
 // Component represents a specific part of the system
@@ -55,20 +94,23 @@ type Component struct {
 
 // Issue represents a bug or feature request
 type Issue struct {
-	ID             uuid.UUID  `json:"id" db:"id"`
-	Title          string     `json:"title" db:"title"`
-	Description    string     `json:"description" db:"description"`
-	ReproduceSteps string     `json:"reproduce_steps" db:"reproduce_steps"`
-	ComponentID    uuid.UUID  `json:"component_id" db:"component_id"`
-	ReporterID     uuid.UUID  `json:"reporter_id" db:"reporter_id"`
-	AssigneeID     *uuid.UUID `json:"assignee_id" db:"assignee_id"`
-	Priority       Priority   `json:"priority" db:"priority"`
-	Severity       Severity   `json:"severity" db:"severity"`
-	Status         Status     `json:"status" db:"status"`
-	DueDate        *time.Time `json:"due_date" db:"due_date"` // Based on SLA
-	CreatedAt      time.Time  `json:"created_at" db:"created_at"`
-	UpdatedAt      time.Time  `json:"updated_at" db:"updated_at"`
-	Labels         []string   `json:"labels" db:"labels"`
+	ID              uuid.UUID  `json:"id" db:"id"`
+	Title           string     `json:"title" db:"title"`
+	Description     string     `json:"description" db:"description"`
+	ReproduceSteps  string     `json:"reproduce_steps" db:"reproduce_steps"`
+	ComponentID     uuid.UUID  `json:"component_id" db:"component_id"`
+	ReporterID      uuid.UUID  `json:"reporter_id" db:"reporter_id"`
+	AssigneeID      *uuid.UUID `json:"assignee_id" db:"assignee_id"`
+	Priority        Priority   `json:"priority" db:"priority"`
+	Severity        Severity   `json:"severity" db:"severity"`
+	Status          Status     `json:"status" db:"status"`
+	DueDate         *time.Time `json:"due_date" db:"due_date"`                   // Resolution deadline, based on SLA
+	ResponseDueDate *time.Time `json:"response_due_date" db:"response_due_date"` // First-response deadline, based on SLA
+	FirstResponseAt *time.Time `json:"first_response_at" db:"first_response_at"`
+	ResolvedAt      *time.Time `json:"resolved_at" db:"resolved_at"` // First move into Fixed, Verified or Closed
+	CreatedAt       time.Time  `json:"created_at" db:"created_at"`
+	UpdatedAt       time.Time  `json:"updated_at" db:"updated_at"`
+	Labels          []string   `json:"labels" db:"labels"`
 }
 
 // Comment represents a comment on an issue
@@ -83,13 +125,32 @@ type Comment struct {
 
 // Attachment represents a file attached to an issue
 type Attachment struct {
-	ID         uuid.UUID `json:"id" db:"id"`
-	IssueID    uuid.UUID `json:"issue_id" db:"issue_id"`
-	UploaderID uuid.UUID `json:"uploader_id" db:"uploader_id"`
-	Filename   string    `json:"filename" db:"filename"`
-	FileURL    string    `json:"file_url" db:"file_url"`
-	FileSize   int64     `json:"file_size" db:"file_size"`
-	CreatedAt  time.Time `json:"created_at" db:"created_at"`
+	ID           uuid.UUID  `json:"id" db:"id"`
+	IssueID      uuid.UUID  `json:"issue_id" db:"issue_id"`
+	UploaderID   uuid.UUID  `json:"uploader_id" db:"uploader_id"`
+	Filename     string     `json:"filename" db:"filename"`
+	FileURL      string     `json:"file_url" db:"file_url"`       // Location of the content in storage
+	StorageKey   string     `json:"storage_key" db:"storage_key"` // Key of the content in the storage backend
+	FileSize     int64      `json:"file_size" db:"file_size"`
+	ContentType  string     `json:"content_type" db:"content_type"`
+	SHA256       string     `json:"sha256" db:"sha256"` // Hex-encoded digest of the content
+	ScanStatus   ScanStatus `json:"scan_status" db:"scan_status"`
+	ScanResult   string     `json:"scan_result" db:"scan_result"`     // Detected threat or scan error
+	ThumbnailKey string     `json:"thumbnail_key" db:"thumbnail_key"` // Empty if there is no thumbnail
+	CreatedAt    time.Time  `json:"created_at" db:"created_at"`
+}
+
+// AttachmentUpload tracks a chunked upload that has not completed yet
+type AttachmentUpload struct {
+	ID            uuid.UUID `json:"id" db:"id"`
+	IssueID       uuid.UUID `json:"issue_id" db:"issue_id"`
+	UploaderID    uuid.UUID `json:"uploader_id" db:"uploader_id"`
+	Filename      string    `json:"filename" db:"filename"`
+	TotalSize     int64     `json:"total_size" db:"total_size"`         // 0 if unknown
+	ReceivedBytes int64     `json:"received_bytes" db:"received_bytes"` // Bytes persisted so far
+	PartOffsets   []int64   `json:"part_offsets" db:"part_offsets"`     // Offsets of the staged parts, in order
+	CreatedAt     time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt     time.Time `json:"updated_at" db:"updated_at"`
 }
 
 // User represents a system user
@@ -0,0 +1,21 @@
+// models/notification.go
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Notification is an entry in a user's in-app inbox
+type Notification struct {
+	ID        uuid.UUID  `json:"id" db:"id"`
+	UserID    uuid.UUID  `json:"user_id" db:"user_id"`
+	Type      string     `json:"type" db:"type"` // Notification type, e.g. ISSUE_UPDATED
+	IssueID   *uuid.UUID `json:"issue_id" db:"issue_id"`
+	Title     string     `json:"title" db:"title"` // Title of the issue when the event happened
+	Message   string     `json:"message" db:"message"`
+	ActorID   *uuid.UUID `json:"actor_id" db:"actor_id"` // Missing for system events
+	ReadAt    *time.Time `json:"read_at" db:"read_at"`
+	CreatedAt time.Time  `json:"created_at" db:"created_at"`
+}
@@ -7,11 +7,86 @@ import (
 	"github.com/google/uuid"
 )
 
+// DigestFrequency is how often a user gets notifications of a type
+type DigestFrequency string
+
+const (
+	DigestImmediate DigestFrequency = "IMMEDIATE" // As they happen
+	DigestHourly    DigestFrequency = "HOURLY"    // At the top of every hour
+	DigestDaily     DigestFrequency = "DAILY"     // Every day at the daily digest time
+)
+
+// DefaultDailyDigestTime is when daily digests are sent unless the user
+// chooses otherwise, in minutes after midnight
+const DefaultDailyDigestTime = 9 * 60
+
 // NotificationPreference represents a user's notification settings
 type NotificationPreference struct {
-	UserID             uuid.UUID `json:"user_id" db:"user_id"`
-	EmailNotifications bool      `json:"email_notifications" db:"email_notifications"`
-	SlackNotifications bool      `json:"slack_notifications" db:"slack_notifications"`
-	SubscribedEvents   []string  `json:"subscribed_events" db:"subscribed_events"` // Types of events to be notified about
-	UpdatedAt          time.Time `json:"updated_at" db:"updated_at"`
+	UserID             uuid.UUID                  `json:"user_id" db:"user_id"`
+	EmailNotifications bool                       `json:"email_notifications" db:"email_notifications"`
+	SlackNotifications bool                       `json:"slack_notifications" db:"slack_notifications"`
+	SubscribedEvents   []string                   `json:"subscribed_events" db:"subscribed_events"`   // Types of events to be notified about
+	DigestFrequencies  map[string]DigestFrequency `json:"digest_frequencies" db:"digest_frequencies"` // By notification type; missing types are immediate
+	TimeZone           string                     `json:"time_zone" db:"time_zone"`                   // IANA name, e.g. "Europe/London"
+	QuietHoursStart    int                        `json:"quiet_hours_start" db:"quiet_hours_start"`   // Minutes after midnight
+	QuietHoursEnd      int                        `json:"quiet_hours_end" db:"quiet_hours_end"`       // Minutes after midnight; no quiet hours when equal to the start
+	DailyDigestTime    int                        `json:"daily_digest_time" db:"daily_digest_time"`   // Minutes after midnight
+	UpdatedAt          time.Time                  `json:"updated_at" db:"updated_at"`
+}
+
+// Location returns the user's time zone, falling back to UTC
+func (p *NotificationPreference) Location() *time.Location {
+	loc, err := time.LoadLocation(p.TimeZone)
+	if err != nil {
+		return time.UTC
+	}
+	return loc
+}
+
+// DeliveryTime returns when a notification of a type sent at now reaches the
+// user: now, or when their next digest of the type is sent. Anything that
+// would arrive during quiet hours waits until they end, unless it is urgent.
+func (p *NotificationPreference) DeliveryTime(notificationType string, urgent bool, now time.Time) time.Time {
+	loc := p.Location()
+	t := now.In(loc)
+
+	switch p.DigestFrequencies[notificationType] {
+	case DigestHourly:
+		year, month, day := t.Date()
+		t = time.Date(year, month, day, t.Hour()+1, 0, 0, 0, loc)
+	case DigestDaily:
+		t = nextClock(t, p.DailyDigestTime)
+	}
+
+	if !urgent && p.InQuietHours(t) {
+		t = nextClock(t, p.QuietHoursEnd)
+	}
+
+	return t
+}
+
+// InQuietHours checks whether t is within the user's quiet hours, which may
+// span midnight
+func (p *NotificationPreference) InQuietHours(t time.Time) bool {
+	if p.QuietHoursStart == p.QuietHoursEnd {
+		return false
+	}
+
+	t = t.In(p.Location())
+	minute := t.Hour()*60 + t.Minute()
+	if p.QuietHoursStart < p.QuietHoursEnd {
+		return minute >= p.QuietHoursStart && minute < p.QuietHoursEnd
+	}
+	return minute >= p.QuietHoursStart || minute < p.QuietHoursEnd
+}
+
+// nextClock returns the first time after t at which the clock in t's location
+// shows the given minutes after midnight
+func nextClock(t time.Time, minutes int) time.Time {
+	year, month, day := t.Date()
+	next := time.Date(year, month, day, minutes/60, minutes%60, 0, 0, t.Location())
+	if !next.After(t) {
+		next = time.Date(year, month, day+1, minutes/60, minutes%60, 0, 0, t.Location())
+	}
+	return next
 }
@@ -0,0 +1,219 @@
+// models/oncall.go
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// OnCallRotation hands a team's on-call duty from member to member. Shifts
+// last a whole number of days and hand off at the same local time, so they
+// follow daylight saving changes.
+type OnCallRotation struct {
+	ID              uuid.UUID   `json:"id" db:"id"`
+	OrganizationID  uuid.UUID   `json:"organization_id" db:"organization_id"`
+	TeamID          uuid.UUID   `json:"team_id" db:"team_id"`
+	Name            string      `json:"name" db:"name"`
+	MemberIDs       []uuid.UUID `json:"member_ids" db:"-"` // In turn order
+	ShiftLengthDays int         `json:"shift_length_days" db:"shift_length_days"`
+	HandoffTime     int         `json:"handoff_time" db:"handoff_time"` // Minutes after midnight, local time
+	TimeZone        string      `json:"time_zone" db:"time_zone"`       // IANA name, e.g. "Europe/London"
+	StartDate       time.Time   `json:"start_date" db:"start_date"`     // Midnight UTC of the day of the first handoff
+	CreatedAt       time.Time   `json:"created_at" db:"created_at"`
+	UpdatedAt       time.Time   `json:"updated_at" db:"updated_at"`
+	CreatedByID     *uuid.UUID  `json:"created_by_id" db:"created_by_id"`
+}
+
+// OnCallOverride puts a user on call instead of the rotation for a while, to
+// swap shifts or cover a vacation
+type OnCallOverride struct {
+	ID          uuid.UUID  `json:"id" db:"id"`
+	RotationID  uuid.UUID  `json:"rotation_id" db:"rotation_id"`
+	UserID      uuid.UUID  `json:"user_id" db:"user_id"`
+	StartAt     time.Time  `json:"start_at" db:"start_at"`
+	EndAt       time.Time  `json:"end_at" db:"end_at"`
+	Reason      string     `json:"reason" db:"reason"`
+	CreatedAt   time.Time  `json:"created_at" db:"created_at"`
+	CreatedByID *uuid.UUID `json:"created_by_id" db:"created_by_id"`
+}
+
+// OnCallShift is a period in which a user is on call for a team
+type OnCallShift struct {
+	RotationID   *uuid.UUID `json:"rotation_id"` // Nil when set by hand on the team
+	RotationName string     `json:"rotation_name"`
+	TeamID       uuid.UUID  `json:"team_id"`
+	UserID       uuid.UUID  `json:"user_id"`
+	StartAt      time.Time  `json:"start_at"` // Zero when set by hand
+	EndAt        time.Time  `json:"end_at"`   // Zero when set by hand
+	OverrideID   *uuid.UUID `json:"override_id"`
+}
+
+// Location returns the rotation's time zone, falling back to UTC
+func (r *OnCallRotation) Location() *time.Location {
+	loc, err := time.LoadLocation(r.TimeZone)
+	if err != nil {
+		return time.UTC
+	}
+	return loc
+}
+
+// OnCallAt finds the shift covering t, if anyone is on call then. Overrides
+// must be ordered by creation; later ones win where they overlap.
+func (r *OnCallRotation) OnCallAt(t time.Time, overrides []*OnCallOverride) (OnCallShift, bool) {
+	for _, shift := range r.Shifts(t, t.Add(time.Nanosecond), overrides) {
+		if !t.Before(shift.StartAt) && t.Before(shift.EndAt) {
+			return shift, true
+		}
+	}
+	return OnCallShift{}, false
+}
+
+// Shifts lists the shifts overlapping [from, to), oldest first. Shifts are
+// not clipped to the range, so overrides should cover Span(from, to).
+// Overrides must be ordered by creation; later ones win where they overlap.
+func (r *OnCallRotation) Shifts(from, to time.Time, overrides []*OnCallOverride) []OnCallShift {
+	if len(r.MemberIDs) == 0 || r.ShiftLengthDays <= 0 || !from.Before(to) {
+		return nil
+	}
+
+	// The rotation's own shifts, starting no earlier than its first
+	n := r.shiftIndex(from)
+	if n < 0 {
+		n = 0
+	}
+
+	var shifts []OnCallShift
+	for ; ; n++ {
+		start, end := r.handoff(n), r.handoff(n+1)
+		if !start.Before(to) {
+			break
+		}
+		shifts = append(shifts, OnCallShift{
+			RotationID:   &r.ID,
+			RotationName: r.Name,
+			TeamID:       r.TeamID,
+			UserID:       r.MemberIDs[n%len(r.MemberIDs)],
+			StartAt:      start,
+			EndAt:        end,
+		})
+	}
+	if len(shifts) == 0 {
+		return nil
+	}
+
+	// Overrides replace the parts of shifts they cover
+	for _, o := range overrides {
+		shifts = r.override(shifts, o)
+	}
+
+	return shifts
+}
+
+// Span returns the start of the first and the end of the last of the
+// rotation's own shifts overlapping [from, to)
+func (r *OnCallRotation) Span(from, to time.Time) (time.Time, time.Time) {
+	if r.ShiftLengthDays <= 0 {
+		return from, to
+	}
+
+	first := r.shiftIndex(from)
+	if first < 0 {
+		first = 0
+	}
+	last := r.shiftIndex(to.Add(-time.Nanosecond))
+	if last < first {
+		last = first
+	}
+	return r.handoff(first), r.handoff(last + 1)
+}
+
+// handoff returns the start of the nth shift
+func (r *OnCallRotation) handoff(n int) time.Time {
+	day := r.StartDate.AddDate(0, 0, n*r.ShiftLengthDays)
+	return time.Date(day.Year(), day.Month(), day.Day(), 0, r.HandoffTime, 0, 0, r.Location())
+}
+
+// shiftIndex finds the shift covering t, counting from 0 for the first shift.
+// Times before the first shift give negative indexes.
+func (r *OnCallRotation) shiftIndex(t time.Time) int {
+	local := t.In(r.Location())
+	day := time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, time.UTC)
+	days := int(day.Sub(r.StartDate).Hours() / 24)
+
+	n := days / r.ShiftLengthDays
+	if days < 0 && days%r.ShiftLengthDays != 0 {
+		n--
+	}
+
+	// Step over the handoff on the day itself
+	for t.Before(r.handoff(n)) {
+		n--
+	}
+	for !t.Before(r.handoff(n + 1)) {
+		n++
+	}
+	return n
+}
+
+// override splits shifts around an override, giving the covered parts to its
+// user. Parts of one override spanning several shifts are merged.
+func (r *OnCallRotation) override(shifts []OnCallShift, o *OnCallOverride) []OnCallShift {
+	if !o.StartAt.Before(o.EndAt) {
+		return shifts
+	}
+
+	result := make([]OnCallShift, 0, len(shifts)+2)
+	for _, shift := range shifts {
+		if !o.StartAt.Before(shift.EndAt) || !shift.StartAt.Before(o.EndAt) {
+			result = append(result, shift)
+			continue
+		}
+
+		if shift.StartAt.Before(o.StartAt) {
+			before := shift
+			before.EndAt = o.StartAt
+			result = append(result, before)
+		}
+
+		covered := OnCallShift{
+			RotationID:   &r.ID,
+			RotationName: r.Name,
+			TeamID:       r.TeamID,
+			UserID:       o.UserID,
+			StartAt:      maxTime(shift.StartAt, o.StartAt),
+			EndAt:        minTime(shift.EndAt, o.EndAt),
+			OverrideID:   &o.ID,
+		}
+		if last := len(result) - 1; last >= 0 && result[last].OverrideID != nil &&
+			*result[last].OverrideID == o.ID && result[last].EndAt.Equal(covered.StartAt) {
+			result[last].EndAt = covered.EndAt
+		} else {
+			result = append(result, covered)
+		}
+
+		if o.EndAt.Before(shift.EndAt) {
+			after := shift
+			after.StartAt = o.EndAt
+			result = append(result, after)
+		}
+	}
+
+	return result
+}
+
+// maxTime returns the later of two times
+func maxTime(a, b time.Time) time.Time {
+	if a.After(b) {
+		return a
+	}
+	return b
+}
+
+// minTime returns the earlier of two times
+func minTime(a, b time.Time) time.Time {
+	if a.Before(b) {
+		return a
+	}
+	return b
+}
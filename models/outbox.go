@@ -0,0 +1,70 @@
+// models/outbox.go
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// OutboxKind is what an outbox message delivers
+type OutboxKind string
+
+const (
+	OutboxNotification OutboxKind = "NOTIFICATION" // A NotificationRequest in protojson, fanned out into the messages below
+	OutboxSlack        OutboxKind = "SLACK"        // A Slack message to a channel
+	OutboxWebhook      OutboxKind = "WEBHOOK"      // A payload posted to one webhook
+	OutboxSlackDM      OutboxKind = "SLACK_DM"     // A Slack direct message to one user
+	OutboxEmail        OutboxKind = "EMAIL"        // An email to one user
+	OutboxDigest       OutboxKind = "DIGEST"       // An event held for a user's next digest, due when it is sent
+	OutboxInbox        OutboxKind = "INBOX"        // A Notification for a user's in-app inbox
+)
+
+// OutboxStatus is the state of an outbox message
+type OutboxStatus string
+
+const (
+	OutboxPending   OutboxStatus = "PENDING"   // Waiting to be delivered, or to be retried
+	OutboxDelivered OutboxStatus = "DELIVERED" // Delivered
+	OutboxDead      OutboxStatus = "DEAD"      // Gave up after retrying
+)
+
+// OutboxMessage is a notification waiting to be delivered. Messages are
+// written in the same transaction as the change they describe, so they are
+// sent exactly when the change is committed.
+type OutboxMessage struct {
+	ID            uuid.UUID    `json:"id" db:"id"`
+	Kind          OutboxKind   `json:"kind" db:"kind"`
+	IssueID       *uuid.UUID   `json:"issue_id" db:"issue_id"`
+	WebhookID     *uuid.UUID   `json:"webhook_id" db:"webhook_id"` // Set for WEBHOOK messages
+	UserID        *uuid.UUID   `json:"user_id" db:"user_id"`       // Set for DIGEST messages
+	Payload       []byte       `json:"payload" db:"payload"`       // JSON
+	Status        OutboxStatus `json:"status" db:"status"`
+	Attempts      int          `json:"attempts" db:"attempts"`
+	NextAttemptAt time.Time    `json:"next_attempt_at" db:"next_attempt_at"`
+	LockedUntil   *time.Time   `json:"locked_until" db:"locked_until"` // Lease held by the worker delivering it
+	LastError     string       `json:"last_error" db:"last_error"`
+	CreatedAt     time.Time    `json:"created_at" db:"created_at"`
+	DeliveredAt   *time.Time   `json:"delivered_at" db:"delivered_at"`
+}
+
+// NewOutboxMessage creates a message due for delivery now
+func NewOutboxMessage(kind OutboxKind, issueID *uuid.UUID, payload []byte) *OutboxMessage {
+	now := time.Now()
+	return &OutboxMessage{
+		ID:            uuid.New(),
+		Kind:          kind,
+		IssueID:       issueID,
+		Payload:       payload,
+		Status:        OutboxPending,
+		NextAttemptAt: now,
+		CreatedAt:     now,
+	}
+}
+
+// OutboxStats summarizes the outbox queue
+type OutboxStats struct {
+	Pending       int        `json:"pending"`
+	Dead          int        `json:"dead"`
+	OldestPending *time.Time `json:"oldest_pending"` // Creation of the oldest pending message
+}
@@ -0,0 +1,200 @@
+// models/sla.go
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// SLARecalculation sets what an issue's deadlines count from when they are
+// recalculated after a priority, severity or component change
+type SLARecalculation string
+
+const (
+	RecalculateFromCreated SLARecalculation = "CREATED" // The issue's creation
+	RecalculateFromChanged SLARecalculation = "CHANGED" // The time of the change
+)
+
+// SLATarget names the deadline an SLA notification is about
+type SLATarget string
+
+const (
+	SLATargetResponse   SLATarget = "RESPONSE"   // The first response
+	SLATargetResolution SLATarget = "RESOLUTION" // The resolution
+)
+
+// SLABreachThreshold is the threshold recorded for a breach notification
+const SLABreachThreshold = 100
+
+// SLAConfig is an SLA policy for a component, or the default policy of an
+// organization when ComponentID is nil. Target hours are set per priority
+// tier and scaled by a per-severity factor.
+type SLAConfig struct {
+	ID                     uuid.UUID        `json:"id" db:"id"`
+	OrganizationID         uuid.UUID        `json:"organization_id" db:"organization_id"`
+	ComponentID            *uuid.UUID       `json:"component_id" db:"component_id"`
+	CriticalResponseTime   int              `json:"critical_response_time" db:"critical_response_time"` // Hours, for P0
+	HighResponseTime       int              `json:"high_response_time" db:"high_response_time"`         // Hours, for P1
+	MediumResponseTime     int              `json:"medium_response_time" db:"medium_response_time"`     // Hours, for P2
+	LowResponseTime        int              `json:"low_response_time" db:"low_response_time"`           // Hours, for P3
+	TrivialResponseTime    int              `json:"trivial_response_time" db:"trivial_response_time"`   // Hours, for P4
+	CriticalResolutionTime int              `json:"critical_resolution_time" db:"critical_resolution_time"`
+	HighResolutionTime     int              `json:"high_resolution_time" db:"high_resolution_time"`
+	MediumResolutionTime   int              `json:"medium_resolution_time" db:"medium_resolution_time"`
+	LowResolutionTime      int              `json:"low_resolution_time" db:"low_resolution_time"`
+	TrivialResolutionTime  int              `json:"trivial_resolution_time" db:"trivial_resolution_time"`
+	S0Factor               float64          `json:"s0_factor" db:"s0_factor"` // Multiplier applied to the hours for S0 issues
+	S1Factor               float64          `json:"s1_factor" db:"s1_factor"`
+	S2Factor               float64          `json:"s2_factor" db:"s2_factor"`
+	S3Factor               float64          `json:"s3_factor" db:"s3_factor"`
+	CalendarID             *uuid.UUID       `json:"calendar_id" db:"calendar_id"` // Business hours the targets are counted in
+	RecalculateFrom        SLARecalculation `json:"recalculate_from" db:"recalculate_from"`
+	CreatedAt              time.Time        `json:"created_at" db:"created_at"`
+	UpdatedAt              time.Time        `json:"updated_at" db:"updated_at"`
+	CreatedByID            *uuid.UUID       `json:"created_by_id" db:"created_by_id"`
+}
+
+// DefaultSLAConfig returns the policy used when an organization has not
+// configured one
+func DefaultSLAConfig() *SLAConfig {
+	return &SLAConfig{
+		CriticalResponseTime:   1,
+		HighResponseTime:       4,
+		MediumResponseTime:     8,
+		LowResponseTime:        24,
+		TrivialResponseTime:    48,
+		CriticalResolutionTime: 4,
+		HighResolutionTime:     24,
+		MediumResolutionTime:   72,
+		LowResolutionTime:      168,
+		TrivialResolutionTime:  336,
+		S0Factor:               0.5,
+		S1Factor:               0.75,
+		S2Factor:               1,
+		S3Factor:               1.5,
+		RecalculateFrom:        RecalculateFromCreated,
+	}
+}
+
+// ResponseTime returns the time allowed before an issue gets a first response
+func (c *SLAConfig) ResponseTime(priority Priority, severity Severity) time.Duration {
+	hours := map[Priority]int{
+		PriorityP0: c.CriticalResponseTime,
+		PriorityP1: c.HighResponseTime,
+		PriorityP2: c.MediumResponseTime,
+		PriorityP3: c.LowResponseTime,
+		PriorityP4: c.TrivialResponseTime,
+	}
+	return c.scale(hours[priority], severity)
+}
+
+// ResolutionTime returns the time allowed to resolve an issue
+func (c *SLAConfig) ResolutionTime(priority Priority, severity Severity) time.Duration {
+	hours := map[Priority]int{
+		PriorityP0: c.CriticalResolutionTime,
+		PriorityP1: c.HighResolutionTime,
+		PriorityP2: c.MediumResolutionTime,
+		PriorityP3: c.LowResolutionTime,
+		PriorityP4: c.TrivialResolutionTime,
+	}
+	return c.scale(hours[priority], severity)
+}
+
+// scale applies the severity factor to a number of hours
+func (c *SLAConfig) scale(hours int, severity Severity) time.Duration {
+	factor := 1.0
+	switch severity {
+	case SeverityS0:
+		factor = c.S0Factor
+	case SeverityS1:
+		factor = c.S1Factor
+	case SeverityS2:
+		factor = c.S2Factor
+	case SeverityS3:
+		factor = c.S3Factor
+	}
+	return time.Duration(float64(hours) * factor * float64(time.Hour))
+}
+
+// SLAPause is an interval in which an issue's SLA clock was stopped, e.g.
+// while waiting on the reporter
+type SLAPause struct {
+	ID        uuid.UUID  `json:"id" db:"id"`
+	IssueID   uuid.UUID  `json:"issue_id" db:"issue_id"`
+	StartedAt time.Time  `json:"started_at" db:"started_at"`
+	EndedAt   *time.Time `json:"ended_at" db:"ended_at"` // Nil while paused
+	Reason    string     `json:"reason" db:"reason"`     // The status or label that paused the clock
+}
+
+// SLAStatsDimension is what SLA statistics are grouped by
+type SLAStatsDimension string
+
+const (
+	SLAStatsOverall     SLAStatsDimension = ""          // All issues
+	SLAStatsByPriority  SLAStatsDimension = "priority"  // Keyed by priority
+	SLAStatsBySeverity  SLAStatsDimension = "severity"  // Keyed by severity
+	SLAStatsByComponent SLAStatsDimension = "component" // Keyed by component ID
+	SLAStatsByTeam      SLAStatsDimension = "team"      // Keyed by the ID of the team owning the component
+	SLAStatsByAssignee  SLAStatsDimension = "assignee"  // Keyed by assignee ID, empty when unassigned
+)
+
+// SLAStatsFilter selects the issues SLA statistics cover
+type SLAStatsFilter struct {
+	ComponentID *uuid.UUID
+	TeamID      *uuid.UUID
+	CreatedFrom time.Time
+	CreatedTo   time.Time
+	Now         time.Time // Deadlines passed by now count as missed...
+
+	// ...unless the issue is in one of these statuses, e.g. a duplicate
+	// closed without a fix
+	ResolvedStatuses []string
+}
+
+// SLACounts counts the SLAs met and missed by one group of issues
+type SLACounts struct {
+	Dimension      SLAStatsDimension
+	Key            string
+	Issues         int
+	Met            int
+	Missed         int
+	ResponseMet    int
+	ResponseMissed int
+}
+
+// SLATrendInterval is the size of the buckets of an SLA trend, as understood
+// by PostgreSQL's date_trunc
+type SLATrendInterval string
+
+const (
+	SLATrendDay   SLATrendInterval = "day"
+	SLATrendWeek  SLATrendInterval = "week"
+	SLATrendMonth SLATrendInterval = "month"
+)
+
+// SLATrendFilter selects the issues and buckets of an SLA trend
+type SLATrendFilter struct {
+	ComponentID *uuid.UUID
+	TeamID      *uuid.UUID
+	Priorities  []string // Empty for all
+	Severities  []string // Empty for all
+	From        time.Time
+	To          time.Time
+	Interval    SLATrendInterval
+	Now         time.Time // Open issues past their deadline by now count as breached...
+
+	// ...unless they are in one of these statuses
+	ResolvedStatuses []string
+}
+
+// SLATrendBucket counts the resolutions and breaches of one bucket of an SLA
+// trend
+type SLATrendBucket struct {
+	Start         time.Time
+	Resolved      int
+	Met           int
+	Missed        int
+	MedianResolve *time.Duration // Nil when nothing was resolved
+	Breached      int
+}
@@ -0,0 +1,39 @@
+// models/watcher.go
+package models
+
+import (
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// WatchReason records why a user is on an issue's CC list
+type WatchReason string
+
+const (
+	WatchReasonReporter  WatchReason = "REPORTER"  // Filed the issue
+	WatchReasonAssignee  WatchReason = "ASSIGNEE"  // Was assigned the issue
+	WatchReasonCommenter WatchReason = "COMMENTER" // Commented on the issue
+	WatchReasonCC        WatchReason = "CC"        // Added explicitly
+)
+
+// IssueWatcher represents a user following an issue
+type IssueWatcher struct {
+	IssueID   uuid.UUID   `json:"issue_id" db:"issue_id"`
+	UserID    uuid.UUID   `json:"user_id" db:"user_id"`
+	Reason    WatchReason `json:"reason" db:"reason"`
+	CreatedAt time.Time   `json:"created_at" db:"created_at"`
+}
+
+// ResolveCCMe replaces "cc:me" in a search query or view filter with the
+// given user's ID, so it matches the issues they watch
+func ResolveCCMe(query, userID string) string {
+	tokens := strings.Fields(query)
+	for i, token := range tokens {
+		if token == "cc:me" {
+			tokens[i] = "cc:" + userID
+		}
+	}
+	return strings.Join(tokens, " ")
+}
@@ -9,13 +9,43 @@ import (
 
 // Webhook represents a webhook endpoint for notifications
 type Webhook struct {
-	ID           uuid.UUID  `json:"id" db:"id"`
-	URL          string     `json:"url" db:"url"`
-	Description  string     `json:"description" db:"description"`
-	Secret       string     `json:"secret" db:"secret"` // For signing payloads
-	CreatorID    uuid.UUID  `json:"creator_id" db:"creator_id"`
-	EventTypes   []string   `json:"event_types" db:"event_types"` // Types of events to notify about
-	CreatedAt    time.Time  `json:"created_at" db:"created_at"`
-	LastCalledAt *time.Time `json:"last_called_at,omitempty" db:"last_called_at"`
-	LastSuccess  *bool      `json:"last_success,omitempty" db:"last_success"`
+	ID            uuid.UUID     `json:"id" db:"id"`
+	URL           string        `json:"url" db:"url"`
+	Description   string        `json:"description" db:"description"`
+	Secret        string        `json:"secret" db:"secret"` // For signing payloads
+	CreatorID     uuid.UUID     `json:"creator_id" db:"creator_id"`
+	EventTypes    []string      `json:"event_types" db:"event_types"` // Types of events to notify about
+	CreatedAt     time.Time     `json:"created_at" db:"created_at"`
+	LastCalledAt  *time.Time    `json:"last_called_at,omitempty" db:"last_called_at"`
+	LastSuccess   *bool         `json:"last_success,omitempty" db:"last_success"`
+	PayloadFormat PayloadFormat `json:"payload_format" db:"payload_format"`
+}
+
+// PayloadFormat is how a webhook's deliveries are encoded
+type PayloadFormat string
+
+// Payload formats
+const (
+	PayloadFormatBuganizer   PayloadFormat = "BUGANIZER"   // The Buganizer payload as is
+	PayloadFormatCloudEvents PayloadFormat = "CLOUDEVENTS" // A CloudEvents 1.0 JSON event wrapping the payload
+)
+
+// WebhookDelivery records one attempt at calling a webhook, so integrators can
+// see what was sent and what came back
+type WebhookDelivery struct {
+	ID                uuid.UUID         `json:"id" db:"id"`
+	WebhookID         uuid.UUID         `json:"webhook_id" db:"webhook_id"`
+	EventType         string            `json:"event_type" db:"event_type"`
+	IssueID           *uuid.UUID        `json:"issue_id" db:"issue_id"`
+	RequestHeaders    map[string]string `json:"request_headers" db:"request_headers"`
+	RequestBody       string            `json:"request_body" db:"request_body"`
+	ResponseStatus    int               `json:"response_status" db:"response_status"` // 0 when no response was received
+	ResponseHeaders   map[string]string `json:"response_headers" db:"response_headers"`
+	ResponseBody      string            `json:"response_body" db:"response_body"`
+	ResponseTruncated bool              `json:"response_truncated" db:"response_truncated"`
+	LatencyMs         int64             `json:"latency_ms" db:"latency_ms"`
+	Error             string            `json:"error" db:"error"`
+	Success           bool              `json:"success" db:"success"`
+	RedeliveryOfID    *uuid.UUID        `json:"redelivery_of_id" db:"redelivery_of_id"` // The delivery replayed, for manual redeliveries
+	CreatedAt         time.Time         `json:"created_at" db:"created_at"`
 }
@@ -0,0 +1,113 @@
+// preview/text.go
+package preview
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"strings"
+	"unicode/utf8"
+)
+
+// MaxLineLength is the number of bytes of a line included in a preview
+const MaxLineLength = 1000
+
+// Line is a numbered line of a text preview
+type Line struct {
+	Number int64
+	Text   string
+}
+
+// Text is a preview of the start and end of a text file
+type Text struct {
+	Head       []Line
+	Tail       []Line // Empty when the head already covers the whole file
+	TotalLines int64
+	Truncated  bool // Lines between Head and Tail were left out
+}
+
+// IsText reports whether a content type can be previewed as text
+func IsText(contentType string) bool {
+	mediaType, _, _ := strings.Cut(contentType, ";")
+	mediaType = strings.TrimSpace(mediaType)
+	if strings.HasPrefix(mediaType, "text/") {
+		return true
+	}
+	switch mediaType {
+	case "application/json", "application/x-ndjson", "application/xml",
+		"application/yaml", "application/x-yaml", "application/javascript":
+		return true
+	}
+	return false
+}
+
+// TextPreview reads r to the end and returns its first headLines and last
+// tailLines lines. Long lines are cut at MaxLineLength bytes and invalid
+// UTF-8 is replaced.
+func TextPreview(r io.Reader, headLines, tailLines int) (*Text, error) {
+	preview := &Text{}
+	// ring holds the most recent lines past the head
+	ring := make([]Line, 0, tailLines)
+	next := 0
+
+	reader := bufio.NewReader(r)
+	for {
+		line, err := readLine(reader)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		preview.TotalLines++
+		l := Line{Number: preview.TotalLines, Text: line}
+
+		switch {
+		case len(preview.Head) < headLines:
+			preview.Head = append(preview.Head, l)
+		case tailLines == 0:
+		case len(ring) < tailLines:
+			ring = append(ring, l)
+		default:
+			ring[next] = l
+			next = (next + 1) % tailLines
+		}
+	}
+
+	// Unroll the ring buffer in line order
+	preview.Tail = append(ring[next:len(ring):len(ring)], ring[:next]...)
+	preview.Truncated = preview.TotalLines > int64(len(preview.Head)+len(preview.Tail))
+
+	return preview, nil
+}
+
+// readLine reads a line without its terminator, keeping at most MaxLineLength
+// bytes of it. It returns io.EOF only when no more lines remain.
+func readLine(reader *bufio.Reader) (string, error) {
+	var line []byte
+	for {
+		chunk, isPrefix, err := reader.ReadLine()
+		if err != nil {
+			if err == io.EOF && line != nil {
+				break
+			}
+			return "", err
+		}
+		if line == nil {
+			line = []byte{}
+		}
+		if room := MaxLineLength - len(line); room > 0 {
+			line = append(line, chunk[:min(room, len(chunk))]...)
+		}
+		if !isPrefix {
+			break
+		}
+	}
+
+	line = bytes.TrimSuffix(line, []byte("\r"))
+	if !utf8.Valid(line) {
+		line = bytes.ToValidUTF8(line, []byte("�"))
+	}
+	return string(line), nil
+}
@@ -0,0 +1,111 @@
+// preview/thumbnail.go
+package preview
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	_ "image/gif"  // register GIF decoding
+	_ "image/jpeg" // register JPEG decoding
+	"image/png"
+	"io"
+)
+
+// MaxImagePixels bounds the size of images that are decoded for thumbnails,
+// so a small, highly compressed file cannot exhaust memory
+const MaxImagePixels = 40_000_000
+
+// ThumbnailContentType is the content type of generated thumbnails
+const ThumbnailContentType = "image/png"
+
+// ErrImageTooLarge is returned for images with more than MaxImagePixels pixels
+var ErrImageTooLarge = errors.New("preview: image is too large to thumbnail")
+
+// CanThumbnail reports whether thumbnails can be generated for a content type
+func CanThumbnail(contentType string) bool {
+	switch contentType {
+	case "image/png", "image/jpeg", "image/gif":
+		return true
+	}
+	return false
+}
+
+// Thumbnail decodes a PNG, JPEG or GIF image and returns a PNG scaled to fit
+// within maxSize x maxSize pixels. Images that already fit are re-encoded at
+// their original size.
+func Thumbnail(r io.Reader, maxSize int) ([]byte, error) {
+	// Check the dimensions before decoding the pixels
+	var head bytes.Buffer
+	cfg, _, err := image.DecodeConfig(io.TeeReader(r, &head))
+	if err != nil {
+		return nil, fmt.Errorf("preview: failed to decode image: %w", err)
+	}
+	if cfg.Width*cfg.Height > MaxImagePixels {
+		return nil, ErrImageTooLarge
+	}
+
+	src, _, err := image.Decode(io.MultiReader(&head, r))
+	if err != nil {
+		return nil, fmt.Errorf("preview: failed to decode image: %w", err)
+	}
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, scale(src, maxSize)); err != nil {
+		return nil, fmt.Errorf("preview: failed to encode thumbnail: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// scale shrinks src to fit within maxSize x maxSize, averaging the source
+// pixels that fall into each destination pixel
+func scale(src image.Image, maxSize int) image.Image {
+	bounds := src.Bounds()
+	w, h := bounds.Dx(), bounds.Dy()
+	if w <= maxSize && h <= maxSize {
+		return src
+	}
+
+	dw, dh := maxSize, maxSize
+	if w > h {
+		dh = max(1, h*maxSize/w)
+	} else {
+		dw = max(1, w*maxSize/h)
+	}
+
+	// Work on RGBA so pixel access does not go through the color interfaces
+	rgba := image.NewRGBA(image.Rect(0, 0, w, h))
+	draw.Draw(rgba, rgba.Bounds(), src, bounds.Min, draw.Src)
+
+	dst := image.NewRGBA(image.Rect(0, 0, dw, dh))
+	for y := 0; y < dh; y++ {
+		y0, y1 := y*h/dh, max((y+1)*h/dh, y*h/dh+1)
+		for x := 0; x < dw; x++ {
+			x0, x1 := x*w/dw, max((x+1)*w/dw, x*w/dw+1)
+
+			var r, g, b, a, n uint64
+			for sy := y0; sy < y1; sy++ {
+				row := rgba.Pix[sy*rgba.Stride:]
+				for sx := x0; sx < x1; sx++ {
+					p := row[sx*4 : sx*4+4]
+					r += uint64(p[0])
+					g += uint64(p[1])
+					b += uint64(p[2])
+					a += uint64(p[3])
+					n++
+				}
+			}
+
+			dst.SetRGBA(x, y, color.RGBA{
+				R: uint8(r / n),
+				G: uint8(g / n),
+				B: uint8(b / n),
+				A: uint8(a / n),
+			})
+		}
+	}
+
+	return dst
+}
@@ -20,12 +20,22 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	IssueService_CreateIssue_FullMethodName   = "/buganizer.IssueService/CreateIssue"
-	IssueService_GetIssue_FullMethodName      = "/buganizer.IssueService/GetIssue"
-	IssueService_UpdateIssue_FullMethodName   = "/buganizer.IssueService/UpdateIssue"
-	IssueService_ListIssues_FullMethodName    = "/buganizer.IssueService/ListIssues"
-	IssueService_AddComment_FullMethodName    = "/buganizer.IssueService/AddComment"
-	IssueService_AddAttachment_FullMethodName = "/buganizer.IssueService/AddAttachment"
+	IssueService_CreateIssue_FullMethodName          = "/buganizer.IssueService/CreateIssue"
+	IssueService_GetIssue_FullMethodName             = "/buganizer.IssueService/GetIssue"
+	IssueService_UpdateIssue_FullMethodName          = "/buganizer.IssueService/UpdateIssue"
+	IssueService_ListIssues_FullMethodName           = "/buganizer.IssueService/ListIssues"
+	IssueService_AddComment_FullMethodName           = "/buganizer.IssueService/AddComment"
+	IssueService_AddAttachment_FullMethodName        = "/buganizer.IssueService/AddAttachment"
+	IssueService_ListAttachments_FullMethodName      = "/buganizer.IssueService/ListAttachments"
+	IssueService_DeleteAttachment_FullMethodName     = "/buganizer.IssueService/DeleteAttachment"
+	IssueService_GetAttachmentPreview_FullMethodName = "/buganizer.IssueService/GetAttachmentPreview"
+	IssueService_UploadAttachment_FullMethodName     = "/buganizer.IssueService/UploadAttachment"
+	IssueService_GetUploadStatus_FullMethodName      = "/buganizer.IssueService/GetUploadStatus"
+	IssueService_DownloadAttachment_FullMethodName   = "/buganizer.IssueService/DownloadAttachment"
+	IssueService_WatchIssue_FullMethodName           = "/buganizer.IssueService/WatchIssue"
+	IssueService_UnwatchIssue_FullMethodName         = "/buganizer.IssueService/UnwatchIssue"
+	IssueService_ListWatchers_FullMethodName         = "/buganizer.IssueService/ListWatchers"
+	IssueService_ListIssueHistory_FullMethodName     = "/buganizer.IssueService/ListIssueHistory"
 )
 
 // IssueServiceClient is the client API for IssueService service.
@@ -46,6 +56,27 @@ type IssueServiceClient interface {
 	AddComment(ctx context.Context, in *AddCommentRequest, opts ...grpc.CallOption) (*Comment, error)
 	// Add attachment to an issue
 	AddAttachment(ctx context.Context, in *AddAttachmentRequest, opts ...grpc.CallOption) (*Attachment, error)
+	// List the attachments of an issue
+	ListAttachments(ctx context.Context, in *ListAttachmentsRequest, opts ...grpc.CallOption) (*ListAttachmentsResponse, error)
+	// Delete an attachment and its stored content
+	DeleteAttachment(ctx context.Context, in *DeleteAttachmentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Preview the start and end of a text attachment
+	GetAttachmentPreview(ctx context.Context, in *GetAttachmentPreviewRequest, opts ...grpc.CallOption) (*AttachmentPreview, error)
+	// Upload an attachment in chunks. The first message carries the metadata;
+	// an interrupted upload is resumed by re-sending the same upload_id.
+	UploadAttachment(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[UploadAttachmentRequest, Attachment], error)
+	// Get the progress of a chunked upload
+	GetUploadStatus(ctx context.Context, in *GetUploadStatusRequest, opts ...grpc.CallOption) (*UploadStatus, error)
+	// Download an attachment's content in chunks
+	DownloadAttachment(ctx context.Context, in *DownloadAttachmentRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[AttachmentChunk], error)
+	// Watch an issue (add a user to its CC list)
+	WatchIssue(ctx context.Context, in *WatchIssueRequest, opts ...grpc.CallOption) (*Watcher, error)
+	// Stop watching an issue
+	UnwatchIssue(ctx context.Context, in *UnwatchIssueRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// List the watchers of an issue
+	ListWatchers(ctx context.Context, in *ListWatchersRequest, opts ...grpc.CallOption) (*ListWatchersResponse, error)
+	// List the changes made to an issue, newest first
+	ListIssueHistory(ctx context.Context, in *ListIssueHistoryRequest, opts ...grpc.CallOption) (*ListIssueHistoryResponse, error)
 }
 
 type issueServiceClient struct {
@@ -116,6 +147,118 @@ func (c *issueServiceClient) AddAttachment(ctx context.Context, in *AddAttachmen
 	return out, nil
 }
 
+func (c *issueServiceClient) ListAttachments(ctx context.Context, in *ListAttachmentsRequest, opts ...grpc.CallOption) (*ListAttachmentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAttachmentsResponse)
+	err := c.cc.Invoke(ctx, IssueService_ListAttachments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *issueServiceClient) DeleteAttachment(ctx context.Context, in *DeleteAttachmentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, IssueService_DeleteAttachment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *issueServiceClient) GetAttachmentPreview(ctx context.Context, in *GetAttachmentPreviewRequest, opts ...grpc.CallOption) (*AttachmentPreview, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AttachmentPreview)
+	err := c.cc.Invoke(ctx, IssueService_GetAttachmentPreview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *issueServiceClient) UploadAttachment(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[UploadAttachmentRequest, Attachment], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &IssueService_ServiceDesc.Streams[0], IssueService_UploadAttachment_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[UploadAttachmentRequest, Attachment]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type IssueService_UploadAttachmentClient = grpc.ClientStreamingClient[UploadAttachmentRequest, Attachment]
+
+func (c *issueServiceClient) GetUploadStatus(ctx context.Context, in *GetUploadStatusRequest, opts ...grpc.CallOption) (*UploadStatus, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UploadStatus)
+	err := c.cc.Invoke(ctx, IssueService_GetUploadStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *issueServiceClient) DownloadAttachment(ctx context.Context, in *DownloadAttachmentRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[AttachmentChunk], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &IssueService_ServiceDesc.Streams[1], IssueService_DownloadAttachment_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[DownloadAttachmentRequest, AttachmentChunk]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type IssueService_DownloadAttachmentClient = grpc.ServerStreamingClient[AttachmentChunk]
+
+func (c *issueServiceClient) WatchIssue(ctx context.Context, in *WatchIssueRequest, opts ...grpc.CallOption) (*Watcher, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Watcher)
+	err := c.cc.Invoke(ctx, IssueService_WatchIssue_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *issueServiceClient) UnwatchIssue(ctx context.Context, in *UnwatchIssueRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, IssueService_UnwatchIssue_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *issueServiceClient) ListWatchers(ctx context.Context, in *ListWatchersRequest, opts ...grpc.CallOption) (*ListWatchersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListWatchersResponse)
+	err := c.cc.Invoke(ctx, IssueService_ListWatchers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *issueServiceClient) ListIssueHistory(ctx context.Context, in *ListIssueHistoryRequest, opts ...grpc.CallOption) (*ListIssueHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListIssueHistoryResponse)
+	err := c.cc.Invoke(ctx, IssueService_ListIssueHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // IssueServiceServer is the server API for IssueService service.
 // All implementations must embed UnimplementedIssueServiceServer
 // for forward compatibility.
@@ -134,6 +277,27 @@ type IssueServiceServer interface {
 	AddComment(context.Context, *AddCommentRequest) (*Comment, error)
 	// Add attachment to an issue
 	AddAttachment(context.Context, *AddAttachmentRequest) (*Attachment, error)
+	// List the attachments of an issue
+	ListAttachments(context.Context, *ListAttachmentsRequest) (*ListAttachmentsResponse, error)
+	// Delete an attachment and its stored content
+	DeleteAttachment(context.Context, *DeleteAttachmentRequest) (*emptypb.Empty, error)
+	// Preview the start and end of a text attachment
+	GetAttachmentPreview(context.Context, *GetAttachmentPreviewRequest) (*AttachmentPreview, error)
+	// Upload an attachment in chunks. The first message carries the metadata;
+	// an interrupted upload is resumed by re-sending the same upload_id.
+	UploadAttachment(grpc.ClientStreamingServer[UploadAttachmentRequest, Attachment]) error
+	// Get the progress of a chunked upload
+	GetUploadStatus(context.Context, *GetUploadStatusRequest) (*UploadStatus, error)
+	// Download an attachment's content in chunks
+	DownloadAttachment(*DownloadAttachmentRequest, grpc.ServerStreamingServer[AttachmentChunk]) error
+	// Watch an issue (add a user to its CC list)
+	WatchIssue(context.Context, *WatchIssueRequest) (*Watcher, error)
+	// Stop watching an issue
+	UnwatchIssue(context.Context, *UnwatchIssueRequest) (*emptypb.Empty, error)
+	// List the watchers of an issue
+	ListWatchers(context.Context, *ListWatchersRequest) (*ListWatchersResponse, error)
+	// List the changes made to an issue, newest first
+	ListIssueHistory(context.Context, *ListIssueHistoryRequest) (*ListIssueHistoryResponse, error)
 	mustEmbedUnimplementedIssueServiceServer()
 }
 
@@ -162,6 +326,36 @@ func (UnimplementedIssueServiceServer) AddComment(context.Context, *AddCommentRe
 func (UnimplementedIssueServiceServer) AddAttachment(context.Context, *AddAttachmentRequest) (*Attachment, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddAttachment not implemented")
 }
+func (UnimplementedIssueServiceServer) ListAttachments(context.Context, *ListAttachmentsRequest) (*ListAttachmentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAttachments not implemented")
+}
+func (UnimplementedIssueServiceServer) DeleteAttachment(context.Context, *DeleteAttachmentRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAttachment not implemented")
+}
+func (UnimplementedIssueServiceServer) GetAttachmentPreview(context.Context, *GetAttachmentPreviewRequest) (*AttachmentPreview, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAttachmentPreview not implemented")
+}
+func (UnimplementedIssueServiceServer) UploadAttachment(grpc.ClientStreamingServer[UploadAttachmentRequest, Attachment]) error {
+	return status.Errorf(codes.Unimplemented, "method UploadAttachment not implemented")
+}
+func (UnimplementedIssueServiceServer) GetUploadStatus(context.Context, *GetUploadStatusRequest) (*UploadStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUploadStatus not implemented")
+}
+func (UnimplementedIssueServiceServer) DownloadAttachment(*DownloadAttachmentRequest, grpc.ServerStreamingServer[AttachmentChunk]) error {
+	return status.Errorf(codes.Unimplemented, "method DownloadAttachment not implemented")
+}
+func (UnimplementedIssueServiceServer) WatchIssue(context.Context, *WatchIssueRequest) (*Watcher, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WatchIssue not implemented")
+}
+func (UnimplementedIssueServiceServer) UnwatchIssue(context.Context, *UnwatchIssueRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnwatchIssue not implemented")
+}
+func (UnimplementedIssueServiceServer) ListWatchers(context.Context, *ListWatchersRequest) (*ListWatchersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWatchers not implemented")
+}
+func (UnimplementedIssueServiceServer) ListIssueHistory(context.Context, *ListIssueHistoryRequest) (*ListIssueHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListIssueHistory not implemented")
+}
 func (UnimplementedIssueServiceServer) mustEmbedUnimplementedIssueServiceServer() {}
 func (UnimplementedIssueServiceServer) testEmbeddedByValue()                      {}
 
@@ -291,6 +485,168 @@ func _IssueService_AddAttachment_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _IssueService_ListAttachments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAttachmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IssueServiceServer).ListAttachments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IssueService_ListAttachments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IssueServiceServer).ListAttachments(ctx, req.(*ListAttachmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IssueService_DeleteAttachment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAttachmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IssueServiceServer).DeleteAttachment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IssueService_DeleteAttachment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IssueServiceServer).DeleteAttachment(ctx, req.(*DeleteAttachmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IssueService_GetAttachmentPreview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAttachmentPreviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IssueServiceServer).GetAttachmentPreview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IssueService_GetAttachmentPreview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IssueServiceServer).GetAttachmentPreview(ctx, req.(*GetAttachmentPreviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IssueService_UploadAttachment_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(IssueServiceServer).UploadAttachment(&grpc.GenericServerStream[UploadAttachmentRequest, Attachment]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type IssueService_UploadAttachmentServer = grpc.ClientStreamingServer[UploadAttachmentRequest, Attachment]
+
+func _IssueService_GetUploadStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUploadStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IssueServiceServer).GetUploadStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IssueService_GetUploadStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IssueServiceServer).GetUploadStatus(ctx, req.(*GetUploadStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IssueService_DownloadAttachment_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DownloadAttachmentRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(IssueServiceServer).DownloadAttachment(m, &grpc.GenericServerStream[DownloadAttachmentRequest, AttachmentChunk]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type IssueService_DownloadAttachmentServer = grpc.ServerStreamingServer[AttachmentChunk]
+
+func _IssueService_WatchIssue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WatchIssueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IssueServiceServer).WatchIssue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IssueService_WatchIssue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IssueServiceServer).WatchIssue(ctx, req.(*WatchIssueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IssueService_UnwatchIssue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnwatchIssueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IssueServiceServer).UnwatchIssue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IssueService_UnwatchIssue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IssueServiceServer).UnwatchIssue(ctx, req.(*UnwatchIssueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IssueService_ListWatchers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWatchersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IssueServiceServer).ListWatchers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IssueService_ListWatchers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IssueServiceServer).ListWatchers(ctx, req.(*ListWatchersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IssueService_ListIssueHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListIssueHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IssueServiceServer).ListIssueHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IssueService_ListIssueHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IssueServiceServer).ListIssueHistory(ctx, req.(*ListIssueHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // IssueService_ServiceDesc is the grpc.ServiceDesc for IssueService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -322,15 +678,83 @@ var IssueService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AddAttachment",
 			Handler:    _IssueService_AddAttachment_Handler,
 		},
+		{
+			MethodName: "ListAttachments",
+			Handler:    _IssueService_ListAttachments_Handler,
+		},
+		{
+			MethodName: "DeleteAttachment",
+			Handler:    _IssueService_DeleteAttachment_Handler,
+		},
+		{
+			MethodName: "GetAttachmentPreview",
+			Handler:    _IssueService_GetAttachmentPreview_Handler,
+		},
+		{
+			MethodName: "GetUploadStatus",
+			Handler:    _IssueService_GetUploadStatus_Handler,
+		},
+		{
+			MethodName: "WatchIssue",
+			Handler:    _IssueService_WatchIssue_Handler,
+		},
+		{
+			MethodName: "UnwatchIssue",
+			Handler:    _IssueService_UnwatchIssue_Handler,
+		},
+		{
+			MethodName: "ListWatchers",
+			Handler:    _IssueService_ListWatchers_Handler,
+		},
+		{
+			MethodName: "ListIssueHistory",
+			Handler:    _IssueService_ListIssueHistory_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "UploadAttachment",
+			Handler:       _IssueService_UploadAttachment_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "DownloadAttachment",
+			Handler:       _IssueService_DownloadAttachment_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "buganizer.proto",
 }
 
 const (
-	SLAService_CalculateSLATarget_FullMethodName = "/buganizer.SLAService/CalculateSLATarget"
-	SLAService_CheckSLARisk_FullMethodName       = "/buganizer.SLAService/CheckSLARisk"
-	SLAService_GetSLAStats_FullMethodName        = "/buganizer.SLAService/GetSLAStats"
+	SLAService_CalculateSLATarget_FullMethodName     = "/buganizer.SLAService/CalculateSLATarget"
+	SLAService_CheckSLARisk_FullMethodName           = "/buganizer.SLAService/CheckSLARisk"
+	SLAService_GetSLAStats_FullMethodName            = "/buganizer.SLAService/GetSLAStats"
+	SLAService_GetSLATrend_FullMethodName            = "/buganizer.SLAService/GetSLATrend"
+	SLAService_CreateSLAPolicy_FullMethodName        = "/buganizer.SLAService/CreateSLAPolicy"
+	SLAService_GetSLAPolicy_FullMethodName           = "/buganizer.SLAService/GetSLAPolicy"
+	SLAService_ListSLAPolicies_FullMethodName        = "/buganizer.SLAService/ListSLAPolicies"
+	SLAService_UpdateSLAPolicy_FullMethodName        = "/buganizer.SLAService/UpdateSLAPolicy"
+	SLAService_DeleteSLAPolicy_FullMethodName        = "/buganizer.SLAService/DeleteSLAPolicy"
+	SLAService_SetComponentSLAPolicy_FullMethodName  = "/buganizer.SLAService/SetComponentSLAPolicy"
+	SLAService_CreateBusinessCalendar_FullMethodName = "/buganizer.SLAService/CreateBusinessCalendar"
+	SLAService_GetBusinessCalendar_FullMethodName    = "/buganizer.SLAService/GetBusinessCalendar"
+	SLAService_ListBusinessCalendars_FullMethodName  = "/buganizer.SLAService/ListBusinessCalendars"
+	SLAService_UpdateBusinessCalendar_FullMethodName = "/buganizer.SLAService/UpdateBusinessCalendar"
+	SLAService_DeleteBusinessCalendar_FullMethodName = "/buganizer.SLAService/DeleteBusinessCalendar"
+	SLAService_ImportHolidays_FullMethodName         = "/buganizer.SLAService/ImportHolidays"
+	SLAService_SetTeamCalendar_FullMethodName        = "/buganizer.SLAService/SetTeamCalendar"
+	SLAService_GetSLAClock_FullMethodName            = "/buganizer.SLAService/GetSLAClock"
+	SLAService_SyncSLAClock_FullMethodName           = "/buganizer.SLAService/SyncSLAClock"
+	SLAService_RecalculateSLA_FullMethodName         = "/buganizer.SLAService/RecalculateSLA"
+	SLAService_CreateEscalationPolicy_FullMethodName = "/buganizer.SLAService/CreateEscalationPolicy"
+	SLAService_GetEscalationPolicy_FullMethodName    = "/buganizer.SLAService/GetEscalationPolicy"
+	SLAService_ListEscalationPolicies_FullMethodName = "/buganizer.SLAService/ListEscalationPolicies"
+	SLAService_UpdateEscalationPolicy_FullMethodName = "/buganizer.SLAService/UpdateEscalationPolicy"
+	SLAService_DeleteEscalationPolicy_FullMethodName = "/buganizer.SLAService/DeleteEscalationPolicy"
+	SLAService_SetTeamOnCall_FullMethodName          = "/buganizer.SLAService/SetTeamOnCall"
+	SLAService_ListIssueEscalations_FullMethodName   = "/buganizer.SLAService/ListIssueEscalations"
+	SLAService_CancelEscalations_FullMethodName      = "/buganizer.SLAService/CancelEscalations"
 )
 
 // SLAServiceClient is the client API for SLAService service.
@@ -345,6 +769,59 @@ type SLAServiceClient interface {
 	CheckSLARisk(ctx context.Context, in *CheckSLARiskRequest, opts ...grpc.CallOption) (*CheckSLARiskResponse, error)
 	// Get SLA statistics for a component or team
 	GetSLAStats(ctx context.Context, in *GetSLAStatsRequest, opts ...grpc.CallOption) (*SLAStats, error)
+	// Get SLA compliance, resolution times and breaches over time
+	GetSLATrend(ctx context.Context, in *GetSLATrendRequest, opts ...grpc.CallOption) (*SLATrend, error)
+	// Create an SLA policy for a component, or the organization default
+	CreateSLAPolicy(ctx context.Context, in *CreateSLAPolicyRequest, opts ...grpc.CallOption) (*SLAPolicy, error)
+	// Get an SLA policy
+	GetSLAPolicy(ctx context.Context, in *GetSLAPolicyRequest, opts ...grpc.CallOption) (*SLAPolicy, error)
+	// List the SLA policies of the caller's organization
+	ListSLAPolicies(ctx context.Context, in *ListSLAPoliciesRequest, opts ...grpc.CallOption) (*ListSLAPoliciesResponse, error)
+	// Update an SLA policy
+	UpdateSLAPolicy(ctx context.Context, in *UpdateSLAPolicyRequest, opts ...grpc.CallOption) (*SLAPolicy, error)
+	// Delete an SLA policy
+	DeleteSLAPolicy(ctx context.Context, in *DeleteSLAPolicyRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Create or replace the SLA policy of a component
+	SetComponentSLAPolicy(ctx context.Context, in *SetComponentSLAPolicyRequest, opts ...grpc.CallOption) (*SLAPolicy, error)
+	// Create a business-hours calendar
+	CreateBusinessCalendar(ctx context.Context, in *CreateBusinessCalendarRequest, opts ...grpc.CallOption) (*BusinessCalendar, error)
+	// Get a business-hours calendar
+	GetBusinessCalendar(ctx context.Context, in *GetBusinessCalendarRequest, opts ...grpc.CallOption) (*BusinessCalendar, error)
+	// List the business-hours calendars of the caller's organization
+	ListBusinessCalendars(ctx context.Context, in *ListBusinessCalendarsRequest, opts ...grpc.CallOption) (*ListBusinessCalendarsResponse, error)
+	// Update a business-hours calendar, replacing its holidays
+	UpdateBusinessCalendar(ctx context.Context, in *UpdateBusinessCalendarRequest, opts ...grpc.CallOption) (*BusinessCalendar, error)
+	// Delete a business-hours calendar
+	DeleteBusinessCalendar(ctx context.Context, in *DeleteBusinessCalendarRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Import holidays into a calendar from an iCalendar file
+	ImportHolidays(ctx context.Context, in *ImportHolidaysRequest, opts ...grpc.CallOption) (*BusinessCalendar, error)
+	// Attach a calendar to a team, or detach it
+	SetTeamCalendar(ctx context.Context, in *SetTeamCalendarRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Get the state of an issue's SLA clock and its pause history
+	GetSLAClock(ctx context.Context, in *GetSLAClockRequest, opts ...grpc.CallOption) (*SLAClock, error)
+	// Pause or resume an issue's SLA clock to match its status and labels.
+	// Resuming moves the deadlines out by the paused time.
+	SyncSLAClock(ctx context.Context, in *SyncSLAClockRequest, opts ...grpc.CallOption) (*SLAClock, error)
+	// Recalculate an issue's SLA deadlines after its priority, severity or
+	// component changed. Watchers are notified when a deadline moves earlier.
+	RecalculateSLA(ctx context.Context, in *RecalculateSLARequest, opts ...grpc.CallOption) (*SLATarget, error)
+	// Create an escalation policy for a component or a team
+	CreateEscalationPolicy(ctx context.Context, in *CreateEscalationPolicyRequest, opts ...grpc.CallOption) (*EscalationPolicy, error)
+	// Get an escalation policy
+	GetEscalationPolicy(ctx context.Context, in *GetEscalationPolicyRequest, opts ...grpc.CallOption) (*EscalationPolicy, error)
+	// List the escalation policies of the caller's organization
+	ListEscalationPolicies(ctx context.Context, in *ListEscalationPoliciesRequest, opts ...grpc.CallOption) (*ListEscalationPoliciesResponse, error)
+	// Update an escalation policy, replacing its steps. Escalations already
+	// scheduled keep the steps they started with.
+	UpdateEscalationPolicy(ctx context.Context, in *UpdateEscalationPolicyRequest, opts ...grpc.CallOption) (*EscalationPolicy, error)
+	// Delete an escalation policy
+	DeleteEscalationPolicy(ctx context.Context, in *DeleteEscalationPolicyRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Set who is on call for a team, or clear it
+	SetTeamOnCall(ctx context.Context, in *SetTeamOnCallRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// List the escalation steps scheduled or run for an issue
+	ListIssueEscalations(ctx context.Context, in *ListIssueEscalationsRequest, opts ...grpc.CallOption) (*ListIssueEscalationsResponse, error)
+	// Cancel the pending escalation steps of an issue
+	CancelEscalations(ctx context.Context, in *CancelEscalationsRequest, opts ...grpc.CallOption) (*ListIssueEscalationsResponse, error)
 }
 
 type sLAServiceClient struct {
@@ -385,30 +862,333 @@ func (c *sLAServiceClient) GetSLAStats(ctx context.Context, in *GetSLAStatsReque
 	return out, nil
 }
 
-// SLAServiceServer is the server API for SLAService service.
-// All implementations must embed UnimplementedSLAServiceServer
-// for forward compatibility.
-//
-// SLA service for managing Service Level Agreements
-type SLAServiceServer interface {
-	// Calculate SLA target date based on priority and severity
-	CalculateSLATarget(context.Context, *CalculateSLARequest) (*SLATarget, error)
-	// Check if issues are at risk of SLA breach
-	CheckSLARisk(context.Context, *CheckSLARiskRequest) (*CheckSLARiskResponse, error)
-	// Get SLA statistics for a component or team
-	GetSLAStats(context.Context, *GetSLAStatsRequest) (*SLAStats, error)
-	mustEmbedUnimplementedSLAServiceServer()
+func (c *sLAServiceClient) GetSLATrend(ctx context.Context, in *GetSLATrendRequest, opts ...grpc.CallOption) (*SLATrend, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SLATrend)
+	err := c.cc.Invoke(ctx, SLAService_GetSLATrend_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-// UnimplementedSLAServiceServer must be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedSLAServiceServer struct{}
+func (c *sLAServiceClient) CreateSLAPolicy(ctx context.Context, in *CreateSLAPolicyRequest, opts ...grpc.CallOption) (*SLAPolicy, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SLAPolicy)
+	err := c.cc.Invoke(ctx, SLAService_CreateSLAPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
 
-func (UnimplementedSLAServiceServer) CalculateSLATarget(context.Context, *CalculateSLARequest) (*SLATarget, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CalculateSLATarget not implemented")
+func (c *sLAServiceClient) GetSLAPolicy(ctx context.Context, in *GetSLAPolicyRequest, opts ...grpc.CallOption) (*SLAPolicy, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SLAPolicy)
+	err := c.cc.Invoke(ctx, SLAService_GetSLAPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sLAServiceClient) ListSLAPolicies(ctx context.Context, in *ListSLAPoliciesRequest, opts ...grpc.CallOption) (*ListSLAPoliciesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSLAPoliciesResponse)
+	err := c.cc.Invoke(ctx, SLAService_ListSLAPolicies_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sLAServiceClient) UpdateSLAPolicy(ctx context.Context, in *UpdateSLAPolicyRequest, opts ...grpc.CallOption) (*SLAPolicy, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SLAPolicy)
+	err := c.cc.Invoke(ctx, SLAService_UpdateSLAPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sLAServiceClient) DeleteSLAPolicy(ctx context.Context, in *DeleteSLAPolicyRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, SLAService_DeleteSLAPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sLAServiceClient) SetComponentSLAPolicy(ctx context.Context, in *SetComponentSLAPolicyRequest, opts ...grpc.CallOption) (*SLAPolicy, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SLAPolicy)
+	err := c.cc.Invoke(ctx, SLAService_SetComponentSLAPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sLAServiceClient) CreateBusinessCalendar(ctx context.Context, in *CreateBusinessCalendarRequest, opts ...grpc.CallOption) (*BusinessCalendar, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BusinessCalendar)
+	err := c.cc.Invoke(ctx, SLAService_CreateBusinessCalendar_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sLAServiceClient) GetBusinessCalendar(ctx context.Context, in *GetBusinessCalendarRequest, opts ...grpc.CallOption) (*BusinessCalendar, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BusinessCalendar)
+	err := c.cc.Invoke(ctx, SLAService_GetBusinessCalendar_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sLAServiceClient) ListBusinessCalendars(ctx context.Context, in *ListBusinessCalendarsRequest, opts ...grpc.CallOption) (*ListBusinessCalendarsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListBusinessCalendarsResponse)
+	err := c.cc.Invoke(ctx, SLAService_ListBusinessCalendars_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sLAServiceClient) UpdateBusinessCalendar(ctx context.Context, in *UpdateBusinessCalendarRequest, opts ...grpc.CallOption) (*BusinessCalendar, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BusinessCalendar)
+	err := c.cc.Invoke(ctx, SLAService_UpdateBusinessCalendar_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sLAServiceClient) DeleteBusinessCalendar(ctx context.Context, in *DeleteBusinessCalendarRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, SLAService_DeleteBusinessCalendar_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sLAServiceClient) ImportHolidays(ctx context.Context, in *ImportHolidaysRequest, opts ...grpc.CallOption) (*BusinessCalendar, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BusinessCalendar)
+	err := c.cc.Invoke(ctx, SLAService_ImportHolidays_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sLAServiceClient) SetTeamCalendar(ctx context.Context, in *SetTeamCalendarRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, SLAService_SetTeamCalendar_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sLAServiceClient) GetSLAClock(ctx context.Context, in *GetSLAClockRequest, opts ...grpc.CallOption) (*SLAClock, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SLAClock)
+	err := c.cc.Invoke(ctx, SLAService_GetSLAClock_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sLAServiceClient) SyncSLAClock(ctx context.Context, in *SyncSLAClockRequest, opts ...grpc.CallOption) (*SLAClock, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SLAClock)
+	err := c.cc.Invoke(ctx, SLAService_SyncSLAClock_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sLAServiceClient) RecalculateSLA(ctx context.Context, in *RecalculateSLARequest, opts ...grpc.CallOption) (*SLATarget, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SLATarget)
+	err := c.cc.Invoke(ctx, SLAService_RecalculateSLA_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sLAServiceClient) CreateEscalationPolicy(ctx context.Context, in *CreateEscalationPolicyRequest, opts ...grpc.CallOption) (*EscalationPolicy, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EscalationPolicy)
+	err := c.cc.Invoke(ctx, SLAService_CreateEscalationPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sLAServiceClient) GetEscalationPolicy(ctx context.Context, in *GetEscalationPolicyRequest, opts ...grpc.CallOption) (*EscalationPolicy, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EscalationPolicy)
+	err := c.cc.Invoke(ctx, SLAService_GetEscalationPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sLAServiceClient) ListEscalationPolicies(ctx context.Context, in *ListEscalationPoliciesRequest, opts ...grpc.CallOption) (*ListEscalationPoliciesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListEscalationPoliciesResponse)
+	err := c.cc.Invoke(ctx, SLAService_ListEscalationPolicies_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sLAServiceClient) UpdateEscalationPolicy(ctx context.Context, in *UpdateEscalationPolicyRequest, opts ...grpc.CallOption) (*EscalationPolicy, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EscalationPolicy)
+	err := c.cc.Invoke(ctx, SLAService_UpdateEscalationPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sLAServiceClient) DeleteEscalationPolicy(ctx context.Context, in *DeleteEscalationPolicyRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, SLAService_DeleteEscalationPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sLAServiceClient) SetTeamOnCall(ctx context.Context, in *SetTeamOnCallRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, SLAService_SetTeamOnCall_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sLAServiceClient) ListIssueEscalations(ctx context.Context, in *ListIssueEscalationsRequest, opts ...grpc.CallOption) (*ListIssueEscalationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListIssueEscalationsResponse)
+	err := c.cc.Invoke(ctx, SLAService_ListIssueEscalations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sLAServiceClient) CancelEscalations(ctx context.Context, in *CancelEscalationsRequest, opts ...grpc.CallOption) (*ListIssueEscalationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListIssueEscalationsResponse)
+	err := c.cc.Invoke(ctx, SLAService_CancelEscalations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SLAServiceServer is the server API for SLAService service.
+// All implementations must embed UnimplementedSLAServiceServer
+// for forward compatibility.
+//
+// SLA service for managing Service Level Agreements
+type SLAServiceServer interface {
+	// Calculate SLA target date based on priority and severity
+	CalculateSLATarget(context.Context, *CalculateSLARequest) (*SLATarget, error)
+	// Check if issues are at risk of SLA breach
+	CheckSLARisk(context.Context, *CheckSLARiskRequest) (*CheckSLARiskResponse, error)
+	// Get SLA statistics for a component or team
+	GetSLAStats(context.Context, *GetSLAStatsRequest) (*SLAStats, error)
+	// Get SLA compliance, resolution times and breaches over time
+	GetSLATrend(context.Context, *GetSLATrendRequest) (*SLATrend, error)
+	// Create an SLA policy for a component, or the organization default
+	CreateSLAPolicy(context.Context, *CreateSLAPolicyRequest) (*SLAPolicy, error)
+	// Get an SLA policy
+	GetSLAPolicy(context.Context, *GetSLAPolicyRequest) (*SLAPolicy, error)
+	// List the SLA policies of the caller's organization
+	ListSLAPolicies(context.Context, *ListSLAPoliciesRequest) (*ListSLAPoliciesResponse, error)
+	// Update an SLA policy
+	UpdateSLAPolicy(context.Context, *UpdateSLAPolicyRequest) (*SLAPolicy, error)
+	// Delete an SLA policy
+	DeleteSLAPolicy(context.Context, *DeleteSLAPolicyRequest) (*emptypb.Empty, error)
+	// Create or replace the SLA policy of a component
+	SetComponentSLAPolicy(context.Context, *SetComponentSLAPolicyRequest) (*SLAPolicy, error)
+	// Create a business-hours calendar
+	CreateBusinessCalendar(context.Context, *CreateBusinessCalendarRequest) (*BusinessCalendar, error)
+	// Get a business-hours calendar
+	GetBusinessCalendar(context.Context, *GetBusinessCalendarRequest) (*BusinessCalendar, error)
+	// List the business-hours calendars of the caller's organization
+	ListBusinessCalendars(context.Context, *ListBusinessCalendarsRequest) (*ListBusinessCalendarsResponse, error)
+	// Update a business-hours calendar, replacing its holidays
+	UpdateBusinessCalendar(context.Context, *UpdateBusinessCalendarRequest) (*BusinessCalendar, error)
+	// Delete a business-hours calendar
+	DeleteBusinessCalendar(context.Context, *DeleteBusinessCalendarRequest) (*emptypb.Empty, error)
+	// Import holidays into a calendar from an iCalendar file
+	ImportHolidays(context.Context, *ImportHolidaysRequest) (*BusinessCalendar, error)
+	// Attach a calendar to a team, or detach it
+	SetTeamCalendar(context.Context, *SetTeamCalendarRequest) (*emptypb.Empty, error)
+	// Get the state of an issue's SLA clock and its pause history
+	GetSLAClock(context.Context, *GetSLAClockRequest) (*SLAClock, error)
+	// Pause or resume an issue's SLA clock to match its status and labels.
+	// Resuming moves the deadlines out by the paused time.
+	SyncSLAClock(context.Context, *SyncSLAClockRequest) (*SLAClock, error)
+	// Recalculate an issue's SLA deadlines after its priority, severity or
+	// component changed. Watchers are notified when a deadline moves earlier.
+	RecalculateSLA(context.Context, *RecalculateSLARequest) (*SLATarget, error)
+	// Create an escalation policy for a component or a team
+	CreateEscalationPolicy(context.Context, *CreateEscalationPolicyRequest) (*EscalationPolicy, error)
+	// Get an escalation policy
+	GetEscalationPolicy(context.Context, *GetEscalationPolicyRequest) (*EscalationPolicy, error)
+	// List the escalation policies of the caller's organization
+	ListEscalationPolicies(context.Context, *ListEscalationPoliciesRequest) (*ListEscalationPoliciesResponse, error)
+	// Update an escalation policy, replacing its steps. Escalations already
+	// scheduled keep the steps they started with.
+	UpdateEscalationPolicy(context.Context, *UpdateEscalationPolicyRequest) (*EscalationPolicy, error)
+	// Delete an escalation policy
+	DeleteEscalationPolicy(context.Context, *DeleteEscalationPolicyRequest) (*emptypb.Empty, error)
+	// Set who is on call for a team, or clear it
+	SetTeamOnCall(context.Context, *SetTeamOnCallRequest) (*emptypb.Empty, error)
+	// List the escalation steps scheduled or run for an issue
+	ListIssueEscalations(context.Context, *ListIssueEscalationsRequest) (*ListIssueEscalationsResponse, error)
+	// Cancel the pending escalation steps of an issue
+	CancelEscalations(context.Context, *CancelEscalationsRequest) (*ListIssueEscalationsResponse, error)
+	mustEmbedUnimplementedSLAServiceServer()
+}
+
+// UnimplementedSLAServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedSLAServiceServer struct{}
+
+func (UnimplementedSLAServiceServer) CalculateSLATarget(context.Context, *CalculateSLARequest) (*SLATarget, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CalculateSLATarget not implemented")
 }
 func (UnimplementedSLAServiceServer) CheckSLARisk(context.Context, *CheckSLARiskRequest) (*CheckSLARiskResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CheckSLARisk not implemented")
@@ -416,6 +1196,81 @@ func (UnimplementedSLAServiceServer) CheckSLARisk(context.Context, *CheckSLARisk
 func (UnimplementedSLAServiceServer) GetSLAStats(context.Context, *GetSLAStatsRequest) (*SLAStats, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetSLAStats not implemented")
 }
+func (UnimplementedSLAServiceServer) GetSLATrend(context.Context, *GetSLATrendRequest) (*SLATrend, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSLATrend not implemented")
+}
+func (UnimplementedSLAServiceServer) CreateSLAPolicy(context.Context, *CreateSLAPolicyRequest) (*SLAPolicy, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSLAPolicy not implemented")
+}
+func (UnimplementedSLAServiceServer) GetSLAPolicy(context.Context, *GetSLAPolicyRequest) (*SLAPolicy, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSLAPolicy not implemented")
+}
+func (UnimplementedSLAServiceServer) ListSLAPolicies(context.Context, *ListSLAPoliciesRequest) (*ListSLAPoliciesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSLAPolicies not implemented")
+}
+func (UnimplementedSLAServiceServer) UpdateSLAPolicy(context.Context, *UpdateSLAPolicyRequest) (*SLAPolicy, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSLAPolicy not implemented")
+}
+func (UnimplementedSLAServiceServer) DeleteSLAPolicy(context.Context, *DeleteSLAPolicyRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSLAPolicy not implemented")
+}
+func (UnimplementedSLAServiceServer) SetComponentSLAPolicy(context.Context, *SetComponentSLAPolicyRequest) (*SLAPolicy, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetComponentSLAPolicy not implemented")
+}
+func (UnimplementedSLAServiceServer) CreateBusinessCalendar(context.Context, *CreateBusinessCalendarRequest) (*BusinessCalendar, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateBusinessCalendar not implemented")
+}
+func (UnimplementedSLAServiceServer) GetBusinessCalendar(context.Context, *GetBusinessCalendarRequest) (*BusinessCalendar, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBusinessCalendar not implemented")
+}
+func (UnimplementedSLAServiceServer) ListBusinessCalendars(context.Context, *ListBusinessCalendarsRequest) (*ListBusinessCalendarsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBusinessCalendars not implemented")
+}
+func (UnimplementedSLAServiceServer) UpdateBusinessCalendar(context.Context, *UpdateBusinessCalendarRequest) (*BusinessCalendar, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateBusinessCalendar not implemented")
+}
+func (UnimplementedSLAServiceServer) DeleteBusinessCalendar(context.Context, *DeleteBusinessCalendarRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteBusinessCalendar not implemented")
+}
+func (UnimplementedSLAServiceServer) ImportHolidays(context.Context, *ImportHolidaysRequest) (*BusinessCalendar, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportHolidays not implemented")
+}
+func (UnimplementedSLAServiceServer) SetTeamCalendar(context.Context, *SetTeamCalendarRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTeamCalendar not implemented")
+}
+func (UnimplementedSLAServiceServer) GetSLAClock(context.Context, *GetSLAClockRequest) (*SLAClock, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSLAClock not implemented")
+}
+func (UnimplementedSLAServiceServer) SyncSLAClock(context.Context, *SyncSLAClockRequest) (*SLAClock, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SyncSLAClock not implemented")
+}
+func (UnimplementedSLAServiceServer) RecalculateSLA(context.Context, *RecalculateSLARequest) (*SLATarget, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecalculateSLA not implemented")
+}
+func (UnimplementedSLAServiceServer) CreateEscalationPolicy(context.Context, *CreateEscalationPolicyRequest) (*EscalationPolicy, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateEscalationPolicy not implemented")
+}
+func (UnimplementedSLAServiceServer) GetEscalationPolicy(context.Context, *GetEscalationPolicyRequest) (*EscalationPolicy, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEscalationPolicy not implemented")
+}
+func (UnimplementedSLAServiceServer) ListEscalationPolicies(context.Context, *ListEscalationPoliciesRequest) (*ListEscalationPoliciesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListEscalationPolicies not implemented")
+}
+func (UnimplementedSLAServiceServer) UpdateEscalationPolicy(context.Context, *UpdateEscalationPolicyRequest) (*EscalationPolicy, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateEscalationPolicy not implemented")
+}
+func (UnimplementedSLAServiceServer) DeleteEscalationPolicy(context.Context, *DeleteEscalationPolicyRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteEscalationPolicy not implemented")
+}
+func (UnimplementedSLAServiceServer) SetTeamOnCall(context.Context, *SetTeamOnCallRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTeamOnCall not implemented")
+}
+func (UnimplementedSLAServiceServer) ListIssueEscalations(context.Context, *ListIssueEscalationsRequest) (*ListIssueEscalationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListIssueEscalations not implemented")
+}
+func (UnimplementedSLAServiceServer) CancelEscalations(context.Context, *CancelEscalationsRequest) (*ListIssueEscalationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelEscalations not implemented")
+}
 func (UnimplementedSLAServiceServer) mustEmbedUnimplementedSLAServiceServer() {}
 func (UnimplementedSLAServiceServer) testEmbeddedByValue()                    {}
 
@@ -491,82 +1346,1270 @@ func _SLAService_GetSLAStats_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
-// SLAService_ServiceDesc is the grpc.ServiceDesc for SLAService service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var SLAService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "buganizer.SLAService",
-	HandlerType: (*SLAServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "CalculateSLATarget",
-			Handler:    _SLAService_CalculateSLATarget_Handler,
-		},
-		{
-			MethodName: "CheckSLARisk",
-			Handler:    _SLAService_CheckSLARisk_Handler,
-		},
-		{
-			MethodName: "GetSLAStats",
-			Handler:    _SLAService_GetSLAStats_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "buganizer.proto",
+func _SLAService_GetSLATrend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSLATrendRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SLAServiceServer).GetSLATrend(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SLAService_GetSLATrend_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SLAServiceServer).GetSLATrend(ctx, req.(*GetSLATrendRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SLAService_CreateSLAPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSLAPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SLAServiceServer).CreateSLAPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SLAService_CreateSLAPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SLAServiceServer).CreateSLAPolicy(ctx, req.(*CreateSLAPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SLAService_GetSLAPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSLAPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SLAServiceServer).GetSLAPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SLAService_GetSLAPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SLAServiceServer).GetSLAPolicy(ctx, req.(*GetSLAPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SLAService_ListSLAPolicies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSLAPoliciesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SLAServiceServer).ListSLAPolicies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SLAService_ListSLAPolicies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SLAServiceServer).ListSLAPolicies(ctx, req.(*ListSLAPoliciesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SLAService_UpdateSLAPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSLAPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SLAServiceServer).UpdateSLAPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SLAService_UpdateSLAPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SLAServiceServer).UpdateSLAPolicy(ctx, req.(*UpdateSLAPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SLAService_DeleteSLAPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSLAPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SLAServiceServer).DeleteSLAPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SLAService_DeleteSLAPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SLAServiceServer).DeleteSLAPolicy(ctx, req.(*DeleteSLAPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SLAService_SetComponentSLAPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetComponentSLAPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SLAServiceServer).SetComponentSLAPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SLAService_SetComponentSLAPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SLAServiceServer).SetComponentSLAPolicy(ctx, req.(*SetComponentSLAPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SLAService_CreateBusinessCalendar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateBusinessCalendarRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SLAServiceServer).CreateBusinessCalendar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SLAService_CreateBusinessCalendar_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SLAServiceServer).CreateBusinessCalendar(ctx, req.(*CreateBusinessCalendarRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SLAService_GetBusinessCalendar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBusinessCalendarRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SLAServiceServer).GetBusinessCalendar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SLAService_GetBusinessCalendar_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SLAServiceServer).GetBusinessCalendar(ctx, req.(*GetBusinessCalendarRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SLAService_ListBusinessCalendars_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBusinessCalendarsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SLAServiceServer).ListBusinessCalendars(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SLAService_ListBusinessCalendars_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SLAServiceServer).ListBusinessCalendars(ctx, req.(*ListBusinessCalendarsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SLAService_UpdateBusinessCalendar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateBusinessCalendarRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SLAServiceServer).UpdateBusinessCalendar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SLAService_UpdateBusinessCalendar_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SLAServiceServer).UpdateBusinessCalendar(ctx, req.(*UpdateBusinessCalendarRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SLAService_DeleteBusinessCalendar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteBusinessCalendarRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SLAServiceServer).DeleteBusinessCalendar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SLAService_DeleteBusinessCalendar_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SLAServiceServer).DeleteBusinessCalendar(ctx, req.(*DeleteBusinessCalendarRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SLAService_ImportHolidays_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportHolidaysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SLAServiceServer).ImportHolidays(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SLAService_ImportHolidays_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SLAServiceServer).ImportHolidays(ctx, req.(*ImportHolidaysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SLAService_SetTeamCalendar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetTeamCalendarRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SLAServiceServer).SetTeamCalendar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SLAService_SetTeamCalendar_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SLAServiceServer).SetTeamCalendar(ctx, req.(*SetTeamCalendarRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SLAService_GetSLAClock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSLAClockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SLAServiceServer).GetSLAClock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SLAService_GetSLAClock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SLAServiceServer).GetSLAClock(ctx, req.(*GetSLAClockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SLAService_SyncSLAClock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SyncSLAClockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SLAServiceServer).SyncSLAClock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SLAService_SyncSLAClock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SLAServiceServer).SyncSLAClock(ctx, req.(*SyncSLAClockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SLAService_RecalculateSLA_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecalculateSLARequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SLAServiceServer).RecalculateSLA(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SLAService_RecalculateSLA_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SLAServiceServer).RecalculateSLA(ctx, req.(*RecalculateSLARequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SLAService_CreateEscalationPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateEscalationPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SLAServiceServer).CreateEscalationPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SLAService_CreateEscalationPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SLAServiceServer).CreateEscalationPolicy(ctx, req.(*CreateEscalationPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SLAService_GetEscalationPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEscalationPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SLAServiceServer).GetEscalationPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SLAService_GetEscalationPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SLAServiceServer).GetEscalationPolicy(ctx, req.(*GetEscalationPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SLAService_ListEscalationPolicies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEscalationPoliciesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SLAServiceServer).ListEscalationPolicies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SLAService_ListEscalationPolicies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SLAServiceServer).ListEscalationPolicies(ctx, req.(*ListEscalationPoliciesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SLAService_UpdateEscalationPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateEscalationPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SLAServiceServer).UpdateEscalationPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SLAService_UpdateEscalationPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SLAServiceServer).UpdateEscalationPolicy(ctx, req.(*UpdateEscalationPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SLAService_DeleteEscalationPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteEscalationPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SLAServiceServer).DeleteEscalationPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SLAService_DeleteEscalationPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SLAServiceServer).DeleteEscalationPolicy(ctx, req.(*DeleteEscalationPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SLAService_SetTeamOnCall_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetTeamOnCallRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SLAServiceServer).SetTeamOnCall(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SLAService_SetTeamOnCall_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SLAServiceServer).SetTeamOnCall(ctx, req.(*SetTeamOnCallRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SLAService_ListIssueEscalations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListIssueEscalationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SLAServiceServer).ListIssueEscalations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SLAService_ListIssueEscalations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SLAServiceServer).ListIssueEscalations(ctx, req.(*ListIssueEscalationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SLAService_CancelEscalations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelEscalationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SLAServiceServer).CancelEscalations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SLAService_CancelEscalations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SLAServiceServer).CancelEscalations(ctx, req.(*CancelEscalationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// SLAService_ServiceDesc is the grpc.ServiceDesc for SLAService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SLAService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "buganizer.SLAService",
+	HandlerType: (*SLAServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CalculateSLATarget",
+			Handler:    _SLAService_CalculateSLATarget_Handler,
+		},
+		{
+			MethodName: "CheckSLARisk",
+			Handler:    _SLAService_CheckSLARisk_Handler,
+		},
+		{
+			MethodName: "GetSLAStats",
+			Handler:    _SLAService_GetSLAStats_Handler,
+		},
+		{
+			MethodName: "GetSLATrend",
+			Handler:    _SLAService_GetSLATrend_Handler,
+		},
+		{
+			MethodName: "CreateSLAPolicy",
+			Handler:    _SLAService_CreateSLAPolicy_Handler,
+		},
+		{
+			MethodName: "GetSLAPolicy",
+			Handler:    _SLAService_GetSLAPolicy_Handler,
+		},
+		{
+			MethodName: "ListSLAPolicies",
+			Handler:    _SLAService_ListSLAPolicies_Handler,
+		},
+		{
+			MethodName: "UpdateSLAPolicy",
+			Handler:    _SLAService_UpdateSLAPolicy_Handler,
+		},
+		{
+			MethodName: "DeleteSLAPolicy",
+			Handler:    _SLAService_DeleteSLAPolicy_Handler,
+		},
+		{
+			MethodName: "SetComponentSLAPolicy",
+			Handler:    _SLAService_SetComponentSLAPolicy_Handler,
+		},
+		{
+			MethodName: "CreateBusinessCalendar",
+			Handler:    _SLAService_CreateBusinessCalendar_Handler,
+		},
+		{
+			MethodName: "GetBusinessCalendar",
+			Handler:    _SLAService_GetBusinessCalendar_Handler,
+		},
+		{
+			MethodName: "ListBusinessCalendars",
+			Handler:    _SLAService_ListBusinessCalendars_Handler,
+		},
+		{
+			MethodName: "UpdateBusinessCalendar",
+			Handler:    _SLAService_UpdateBusinessCalendar_Handler,
+		},
+		{
+			MethodName: "DeleteBusinessCalendar",
+			Handler:    _SLAService_DeleteBusinessCalendar_Handler,
+		},
+		{
+			MethodName: "ImportHolidays",
+			Handler:    _SLAService_ImportHolidays_Handler,
+		},
+		{
+			MethodName: "SetTeamCalendar",
+			Handler:    _SLAService_SetTeamCalendar_Handler,
+		},
+		{
+			MethodName: "GetSLAClock",
+			Handler:    _SLAService_GetSLAClock_Handler,
+		},
+		{
+			MethodName: "SyncSLAClock",
+			Handler:    _SLAService_SyncSLAClock_Handler,
+		},
+		{
+			MethodName: "RecalculateSLA",
+			Handler:    _SLAService_RecalculateSLA_Handler,
+		},
+		{
+			MethodName: "CreateEscalationPolicy",
+			Handler:    _SLAService_CreateEscalationPolicy_Handler,
+		},
+		{
+			MethodName: "GetEscalationPolicy",
+			Handler:    _SLAService_GetEscalationPolicy_Handler,
+		},
+		{
+			MethodName: "ListEscalationPolicies",
+			Handler:    _SLAService_ListEscalationPolicies_Handler,
+		},
+		{
+			MethodName: "UpdateEscalationPolicy",
+			Handler:    _SLAService_UpdateEscalationPolicy_Handler,
+		},
+		{
+			MethodName: "DeleteEscalationPolicy",
+			Handler:    _SLAService_DeleteEscalationPolicy_Handler,
+		},
+		{
+			MethodName: "SetTeamOnCall",
+			Handler:    _SLAService_SetTeamOnCall_Handler,
+		},
+		{
+			MethodName: "ListIssueEscalations",
+			Handler:    _SLAService_ListIssueEscalations_Handler,
+		},
+		{
+			MethodName: "CancelEscalations",
+			Handler:    _SLAService_CancelEscalations_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "buganizer.proto",
+}
+
+const (
+	OnCallService_CreateRotation_FullMethodName    = "/buganizer.OnCallService/CreateRotation"
+	OnCallService_GetRotation_FullMethodName       = "/buganizer.OnCallService/GetRotation"
+	OnCallService_ListRotations_FullMethodName     = "/buganizer.OnCallService/ListRotations"
+	OnCallService_UpdateRotation_FullMethodName    = "/buganizer.OnCallService/UpdateRotation"
+	OnCallService_DeleteRotation_FullMethodName    = "/buganizer.OnCallService/DeleteRotation"
+	OnCallService_CreateOverride_FullMethodName    = "/buganizer.OnCallService/CreateOverride"
+	OnCallService_DeleteOverride_FullMethodName    = "/buganizer.OnCallService/DeleteOverride"
+	OnCallService_GetCurrentOnCall_FullMethodName  = "/buganizer.OnCallService/GetCurrentOnCall"
+	OnCallService_ListCurrentOnCall_FullMethodName = "/buganizer.OnCallService/ListCurrentOnCall"
+	OnCallService_ListShifts_FullMethodName        = "/buganizer.OnCallService/ListShifts"
+)
+
+// OnCallServiceClient is the client API for OnCallService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// On-call service for team rotation schedules
+type OnCallServiceClient interface {
+	// Create the on-call rotation of a team
+	CreateRotation(ctx context.Context, in *CreateRotationRequest, opts ...grpc.CallOption) (*Rotation, error)
+	// Get a rotation
+	GetRotation(ctx context.Context, in *GetRotationRequest, opts ...grpc.CallOption) (*Rotation, error)
+	// List the rotations of the caller's organization
+	ListRotations(ctx context.Context, in *ListRotationsRequest, opts ...grpc.CallOption) (*ListRotationsResponse, error)
+	// Update a rotation
+	UpdateRotation(ctx context.Context, in *UpdateRotationRequest, opts ...grpc.CallOption) (*Rotation, error)
+	// Delete a rotation and its overrides
+	DeleteRotation(ctx context.Context, in *DeleteRotationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Put someone on call instead of the rotation for a while
+	CreateOverride(ctx context.Context, in *CreateOverrideRequest, opts ...grpc.CallOption) (*OnCallOverride, error)
+	// Delete an override
+	DeleteOverride(ctx context.Context, in *DeleteOverrideRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Get who is on call for a team now
+	GetCurrentOnCall(ctx context.Context, in *GetCurrentOnCallRequest, opts ...grpc.CallOption) (*OnCallShift, error)
+	// List who is on call now for each team of the caller's organization
+	ListCurrentOnCall(ctx context.Context, in *ListCurrentOnCallRequest, opts ...grpc.CallOption) (*ListShiftsResponse, error)
+	// List the shifts of a team, or of a user across teams
+	ListShifts(ctx context.Context, in *ListShiftsRequest, opts ...grpc.CallOption) (*ListShiftsResponse, error)
+}
+
+type onCallServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewOnCallServiceClient(cc grpc.ClientConnInterface) OnCallServiceClient {
+	return &onCallServiceClient{cc}
+}
+
+func (c *onCallServiceClient) CreateRotation(ctx context.Context, in *CreateRotationRequest, opts ...grpc.CallOption) (*Rotation, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Rotation)
+	err := c.cc.Invoke(ctx, OnCallService_CreateRotation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *onCallServiceClient) GetRotation(ctx context.Context, in *GetRotationRequest, opts ...grpc.CallOption) (*Rotation, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Rotation)
+	err := c.cc.Invoke(ctx, OnCallService_GetRotation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *onCallServiceClient) ListRotations(ctx context.Context, in *ListRotationsRequest, opts ...grpc.CallOption) (*ListRotationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRotationsResponse)
+	err := c.cc.Invoke(ctx, OnCallService_ListRotations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *onCallServiceClient) UpdateRotation(ctx context.Context, in *UpdateRotationRequest, opts ...grpc.CallOption) (*Rotation, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Rotation)
+	err := c.cc.Invoke(ctx, OnCallService_UpdateRotation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *onCallServiceClient) DeleteRotation(ctx context.Context, in *DeleteRotationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, OnCallService_DeleteRotation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *onCallServiceClient) CreateOverride(ctx context.Context, in *CreateOverrideRequest, opts ...grpc.CallOption) (*OnCallOverride, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OnCallOverride)
+	err := c.cc.Invoke(ctx, OnCallService_CreateOverride_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *onCallServiceClient) DeleteOverride(ctx context.Context, in *DeleteOverrideRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, OnCallService_DeleteOverride_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *onCallServiceClient) GetCurrentOnCall(ctx context.Context, in *GetCurrentOnCallRequest, opts ...grpc.CallOption) (*OnCallShift, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OnCallShift)
+	err := c.cc.Invoke(ctx, OnCallService_GetCurrentOnCall_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *onCallServiceClient) ListCurrentOnCall(ctx context.Context, in *ListCurrentOnCallRequest, opts ...grpc.CallOption) (*ListShiftsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListShiftsResponse)
+	err := c.cc.Invoke(ctx, OnCallService_ListCurrentOnCall_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *onCallServiceClient) ListShifts(ctx context.Context, in *ListShiftsRequest, opts ...grpc.CallOption) (*ListShiftsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListShiftsResponse)
+	err := c.cc.Invoke(ctx, OnCallService_ListShifts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// OnCallServiceServer is the server API for OnCallService service.
+// All implementations must embed UnimplementedOnCallServiceServer
+// for forward compatibility.
+//
+// On-call service for team rotation schedules
+type OnCallServiceServer interface {
+	// Create the on-call rotation of a team
+	CreateRotation(context.Context, *CreateRotationRequest) (*Rotation, error)
+	// Get a rotation
+	GetRotation(context.Context, *GetRotationRequest) (*Rotation, error)
+	// List the rotations of the caller's organization
+	ListRotations(context.Context, *ListRotationsRequest) (*ListRotationsResponse, error)
+	// Update a rotation
+	UpdateRotation(context.Context, *UpdateRotationRequest) (*Rotation, error)
+	// Delete a rotation and its overrides
+	DeleteRotation(context.Context, *DeleteRotationRequest) (*emptypb.Empty, error)
+	// Put someone on call instead of the rotation for a while
+	CreateOverride(context.Context, *CreateOverrideRequest) (*OnCallOverride, error)
+	// Delete an override
+	DeleteOverride(context.Context, *DeleteOverrideRequest) (*emptypb.Empty, error)
+	// Get who is on call for a team now
+	GetCurrentOnCall(context.Context, *GetCurrentOnCallRequest) (*OnCallShift, error)
+	// List who is on call now for each team of the caller's organization
+	ListCurrentOnCall(context.Context, *ListCurrentOnCallRequest) (*ListShiftsResponse, error)
+	// List the shifts of a team, or of a user across teams
+	ListShifts(context.Context, *ListShiftsRequest) (*ListShiftsResponse, error)
+	mustEmbedUnimplementedOnCallServiceServer()
+}
+
+// UnimplementedOnCallServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedOnCallServiceServer struct{}
+
+func (UnimplementedOnCallServiceServer) CreateRotation(context.Context, *CreateRotationRequest) (*Rotation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRotation not implemented")
+}
+func (UnimplementedOnCallServiceServer) GetRotation(context.Context, *GetRotationRequest) (*Rotation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRotation not implemented")
+}
+func (UnimplementedOnCallServiceServer) ListRotations(context.Context, *ListRotationsRequest) (*ListRotationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRotations not implemented")
+}
+func (UnimplementedOnCallServiceServer) UpdateRotation(context.Context, *UpdateRotationRequest) (*Rotation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRotation not implemented")
+}
+func (UnimplementedOnCallServiceServer) DeleteRotation(context.Context, *DeleteRotationRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRotation not implemented")
+}
+func (UnimplementedOnCallServiceServer) CreateOverride(context.Context, *CreateOverrideRequest) (*OnCallOverride, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateOverride not implemented")
+}
+func (UnimplementedOnCallServiceServer) DeleteOverride(context.Context, *DeleteOverrideRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteOverride not implemented")
+}
+func (UnimplementedOnCallServiceServer) GetCurrentOnCall(context.Context, *GetCurrentOnCallRequest) (*OnCallShift, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCurrentOnCall not implemented")
+}
+func (UnimplementedOnCallServiceServer) ListCurrentOnCall(context.Context, *ListCurrentOnCallRequest) (*ListShiftsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCurrentOnCall not implemented")
+}
+func (UnimplementedOnCallServiceServer) ListShifts(context.Context, *ListShiftsRequest) (*ListShiftsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListShifts not implemented")
+}
+func (UnimplementedOnCallServiceServer) mustEmbedUnimplementedOnCallServiceServer() {}
+func (UnimplementedOnCallServiceServer) testEmbeddedByValue()                       {}
+
+// UnsafeOnCallServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to OnCallServiceServer will
+// result in compilation errors.
+type UnsafeOnCallServiceServer interface {
+	mustEmbedUnimplementedOnCallServiceServer()
+}
+
+func RegisterOnCallServiceServer(s grpc.ServiceRegistrar, srv OnCallServiceServer) {
+	// If the following call pancis, it indicates UnimplementedOnCallServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&OnCallService_ServiceDesc, srv)
+}
+
+func _OnCallService_CreateRotation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRotationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OnCallServiceServer).CreateRotation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OnCallService_CreateRotation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OnCallServiceServer).CreateRotation(ctx, req.(*CreateRotationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OnCallService_GetRotation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRotationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OnCallServiceServer).GetRotation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OnCallService_GetRotation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OnCallServiceServer).GetRotation(ctx, req.(*GetRotationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OnCallService_ListRotations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRotationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OnCallServiceServer).ListRotations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OnCallService_ListRotations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OnCallServiceServer).ListRotations(ctx, req.(*ListRotationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OnCallService_UpdateRotation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRotationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OnCallServiceServer).UpdateRotation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OnCallService_UpdateRotation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OnCallServiceServer).UpdateRotation(ctx, req.(*UpdateRotationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OnCallService_DeleteRotation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRotationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OnCallServiceServer).DeleteRotation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OnCallService_DeleteRotation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OnCallServiceServer).DeleteRotation(ctx, req.(*DeleteRotationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OnCallService_CreateOverride_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateOverrideRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OnCallServiceServer).CreateOverride(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OnCallService_CreateOverride_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OnCallServiceServer).CreateOverride(ctx, req.(*CreateOverrideRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OnCallService_DeleteOverride_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteOverrideRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OnCallServiceServer).DeleteOverride(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OnCallService_DeleteOverride_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OnCallServiceServer).DeleteOverride(ctx, req.(*DeleteOverrideRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OnCallService_GetCurrentOnCall_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCurrentOnCallRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OnCallServiceServer).GetCurrentOnCall(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OnCallService_GetCurrentOnCall_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OnCallServiceServer).GetCurrentOnCall(ctx, req.(*GetCurrentOnCallRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OnCallService_ListCurrentOnCall_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCurrentOnCallRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OnCallServiceServer).ListCurrentOnCall(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OnCallService_ListCurrentOnCall_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OnCallServiceServer).ListCurrentOnCall(ctx, req.(*ListCurrentOnCallRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OnCallService_ListShifts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListShiftsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OnCallServiceServer).ListShifts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OnCallService_ListShifts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OnCallServiceServer).ListShifts(ctx, req.(*ListShiftsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// OnCallService_ServiceDesc is the grpc.ServiceDesc for OnCallService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var OnCallService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "buganizer.OnCallService",
+	HandlerType: (*OnCallServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateRotation",
+			Handler:    _OnCallService_CreateRotation_Handler,
+		},
+		{
+			MethodName: "GetRotation",
+			Handler:    _OnCallService_GetRotation_Handler,
+		},
+		{
+			MethodName: "ListRotations",
+			Handler:    _OnCallService_ListRotations_Handler,
+		},
+		{
+			MethodName: "UpdateRotation",
+			Handler:    _OnCallService_UpdateRotation_Handler,
+		},
+		{
+			MethodName: "DeleteRotation",
+			Handler:    _OnCallService_DeleteRotation_Handler,
+		},
+		{
+			MethodName: "CreateOverride",
+			Handler:    _OnCallService_CreateOverride_Handler,
+		},
+		{
+			MethodName: "DeleteOverride",
+			Handler:    _OnCallService_DeleteOverride_Handler,
+		},
+		{
+			MethodName: "GetCurrentOnCall",
+			Handler:    _OnCallService_GetCurrentOnCall_Handler,
+		},
+		{
+			MethodName: "ListCurrentOnCall",
+			Handler:    _OnCallService_ListCurrentOnCall_Handler,
+		},
+		{
+			MethodName: "ListShifts",
+			Handler:    _OnCallService_ListShifts_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "buganizer.proto",
+}
+
+const (
+	NotificationService_SendSlackNotification_FullMethodName         = "/buganizer.NotificationService/SendSlackNotification"
+	NotificationService_RegisterWebhook_FullMethodName               = "/buganizer.NotificationService/RegisterWebhook"
+	NotificationService_ListWebhooks_FullMethodName                  = "/buganizer.NotificationService/ListWebhooks"
+	NotificationService_GetWebhook_FullMethodName                    = "/buganizer.NotificationService/GetWebhook"
+	NotificationService_UpdateWebhook_FullMethodName                 = "/buganizer.NotificationService/UpdateWebhook"
+	NotificationService_DeleteWebhook_FullMethodName                 = "/buganizer.NotificationService/DeleteWebhook"
+	NotificationService_TestWebhook_FullMethodName                   = "/buganizer.NotificationService/TestWebhook"
+	NotificationService_RotateWebhookSecret_FullMethodName           = "/buganizer.NotificationService/RotateWebhookSecret"
+	NotificationService_UpdateNotificationPreferences_FullMethodName = "/buganizer.NotificationService/UpdateNotificationPreferences"
+	NotificationService_ListWebhookDeliveries_FullMethodName         = "/buganizer.NotificationService/ListWebhookDeliveries"
+	NotificationService_RedeliverWebhook_FullMethodName              = "/buganizer.NotificationService/RedeliverWebhook"
+	NotificationService_Unsubscribe_FullMethodName                   = "/buganizer.NotificationService/Unsubscribe"
+	NotificationService_ListNotifications_FullMethodName             = "/buganizer.NotificationService/ListNotifications"
+	NotificationService_GetUnreadCount_FullMethodName                = "/buganizer.NotificationService/GetUnreadCount"
+	NotificationService_MarkNotificationRead_FullMethodName          = "/buganizer.NotificationService/MarkNotificationRead"
+	NotificationService_MarkAllRead_FullMethodName                   = "/buganizer.NotificationService/MarkAllRead"
+)
+
+// NotificationServiceClient is the client API for NotificationService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Notification service for integrations with messaging platforms
+type NotificationServiceClient interface {
+	// Send notification to Slack
+	SendSlackNotification(ctx context.Context, in *NotificationRequest, opts ...grpc.CallOption) (*NotificationResponse, error)
+	// Register webhook URL for notifications
+	RegisterWebhook(ctx context.Context, in *RegisterWebhookRequest, opts ...grpc.CallOption) (*RegisterWebhookResponse, error)
+	// List the caller's webhooks, or every webhook of their organization for admins
+	ListWebhooks(ctx context.Context, in *ListWebhooksRequest, opts ...grpc.CallOption) (*ListWebhooksResponse, error)
+	// Get a webhook
+	GetWebhook(ctx context.Context, in *GetWebhookRequest, opts ...grpc.CallOption) (*Webhook, error)
+	// Update a webhook's URL, description or events
+	UpdateWebhook(ctx context.Context, in *UpdateWebhookRequest, opts ...grpc.CallOption) (*Webhook, error)
+	// Delete a webhook
+	DeleteWebhook(ctx context.Context, in *DeleteWebhookRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Send a synthetic ping to a webhook and return its response
+	TestWebhook(ctx context.Context, in *TestWebhookRequest, opts ...grpc.CallOption) (*WebhookDelivery, error)
+	// Replace a webhook's signing secret, returning the new one
+	RotateWebhookSecret(ctx context.Context, in *RotateWebhookSecretRequest, opts ...grpc.CallOption) (*RotateWebhookSecretResponse, error)
+	// Update notification preferences
+	UpdateNotificationPreferences(ctx context.Context, in *UpdateNotificationPreferencesRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// List the delivery attempts of a webhook, newest first
+	ListWebhookDeliveries(ctx context.Context, in *ListWebhookDeliveriesRequest, opts ...grpc.CallOption) (*ListWebhookDeliveriesResponse, error)
+	// Send the payload of a past delivery to its webhook again
+	RedeliverWebhook(ctx context.Context, in *RedeliverWebhookRequest, opts ...grpc.CallOption) (*WebhookDelivery, error)
+	// Turn off email notifications with a signed unsubscribe link. Served over
+	// HTTP at /api/v1/notifications/unsubscribe by a custom gateway handler.
+	Unsubscribe(ctx context.Context, in *UnsubscribeRequest, opts ...grpc.CallOption) (*UnsubscribeResponse, error)
+	// List the current user's in-app notifications, newest first
+	ListNotifications(ctx context.Context, in *ListNotificationsRequest, opts ...grpc.CallOption) (*ListNotificationsResponse, error)
+	// Count the current user's unread notifications
+	GetUnreadCount(ctx context.Context, in *GetUnreadCountRequest, opts ...grpc.CallOption) (*GetUnreadCountResponse, error)
+	// Mark one of the current user's notifications read
+	MarkNotificationRead(ctx context.Context, in *MarkNotificationReadRequest, opts ...grpc.CallOption) (*Notification, error)
+	// Mark all of the current user's notifications read
+	MarkAllRead(ctx context.Context, in *MarkAllReadRequest, opts ...grpc.CallOption) (*MarkAllReadResponse, error)
+}
+
+type notificationServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewNotificationServiceClient(cc grpc.ClientConnInterface) NotificationServiceClient {
+	return &notificationServiceClient{cc}
+}
+
+func (c *notificationServiceClient) SendSlackNotification(ctx context.Context, in *NotificationRequest, opts ...grpc.CallOption) (*NotificationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(NotificationResponse)
+	err := c.cc.Invoke(ctx, NotificationService_SendSlackNotification_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationServiceClient) RegisterWebhook(ctx context.Context, in *RegisterWebhookRequest, opts ...grpc.CallOption) (*RegisterWebhookResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RegisterWebhookResponse)
+	err := c.cc.Invoke(ctx, NotificationService_RegisterWebhook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationServiceClient) ListWebhooks(ctx context.Context, in *ListWebhooksRequest, opts ...grpc.CallOption) (*ListWebhooksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListWebhooksResponse)
+	err := c.cc.Invoke(ctx, NotificationService_ListWebhooks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationServiceClient) GetWebhook(ctx context.Context, in *GetWebhookRequest, opts ...grpc.CallOption) (*Webhook, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Webhook)
+	err := c.cc.Invoke(ctx, NotificationService_GetWebhook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationServiceClient) UpdateWebhook(ctx context.Context, in *UpdateWebhookRequest, opts ...grpc.CallOption) (*Webhook, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Webhook)
+	err := c.cc.Invoke(ctx, NotificationService_UpdateWebhook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationServiceClient) DeleteWebhook(ctx context.Context, in *DeleteWebhookRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, NotificationService_DeleteWebhook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationServiceClient) TestWebhook(ctx context.Context, in *TestWebhookRequest, opts ...grpc.CallOption) (*WebhookDelivery, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WebhookDelivery)
+	err := c.cc.Invoke(ctx, NotificationService_TestWebhook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationServiceClient) RotateWebhookSecret(ctx context.Context, in *RotateWebhookSecretRequest, opts ...grpc.CallOption) (*RotateWebhookSecretResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RotateWebhookSecretResponse)
+	err := c.cc.Invoke(ctx, NotificationService_RotateWebhookSecret_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationServiceClient) UpdateNotificationPreferences(ctx context.Context, in *UpdateNotificationPreferencesRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, NotificationService_UpdateNotificationPreferences_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationServiceClient) ListWebhookDeliveries(ctx context.Context, in *ListWebhookDeliveriesRequest, opts ...grpc.CallOption) (*ListWebhookDeliveriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListWebhookDeliveriesResponse)
+	err := c.cc.Invoke(ctx, NotificationService_ListWebhookDeliveries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-const (
-	NotificationService_SendSlackNotification_FullMethodName         = "/buganizer.NotificationService/SendSlackNotification"
-	NotificationService_RegisterWebhook_FullMethodName               = "/buganizer.NotificationService/RegisterWebhook"
-	NotificationService_UpdateNotificationPreferences_FullMethodName = "/buganizer.NotificationService/UpdateNotificationPreferences"
-)
-
-// NotificationServiceClient is the client API for NotificationService service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
-//
-// Notification service for integrations with messaging platforms
-type NotificationServiceClient interface {
-	// Send notification to Slack
-	SendSlackNotification(ctx context.Context, in *NotificationRequest, opts ...grpc.CallOption) (*NotificationResponse, error)
-	// Register webhook URL for notifications
-	RegisterWebhook(ctx context.Context, in *RegisterWebhookRequest, opts ...grpc.CallOption) (*RegisterWebhookResponse, error)
-	// Update notification preferences
-	UpdateNotificationPreferences(ctx context.Context, in *UpdateNotificationPreferencesRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+func (c *notificationServiceClient) RedeliverWebhook(ctx context.Context, in *RedeliverWebhookRequest, opts ...grpc.CallOption) (*WebhookDelivery, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WebhookDelivery)
+	err := c.cc.Invoke(ctx, NotificationService_RedeliverWebhook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-type notificationServiceClient struct {
-	cc grpc.ClientConnInterface
+func (c *notificationServiceClient) Unsubscribe(ctx context.Context, in *UnsubscribeRequest, opts ...grpc.CallOption) (*UnsubscribeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnsubscribeResponse)
+	err := c.cc.Invoke(ctx, NotificationService_Unsubscribe_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func NewNotificationServiceClient(cc grpc.ClientConnInterface) NotificationServiceClient {
-	return &notificationServiceClient{cc}
+func (c *notificationServiceClient) ListNotifications(ctx context.Context, in *ListNotificationsRequest, opts ...grpc.CallOption) (*ListNotificationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListNotificationsResponse)
+	err := c.cc.Invoke(ctx, NotificationService_ListNotifications_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (c *notificationServiceClient) SendSlackNotification(ctx context.Context, in *NotificationRequest, opts ...grpc.CallOption) (*NotificationResponse, error) {
+func (c *notificationServiceClient) GetUnreadCount(ctx context.Context, in *GetUnreadCountRequest, opts ...grpc.CallOption) (*GetUnreadCountResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(NotificationResponse)
-	err := c.cc.Invoke(ctx, NotificationService_SendSlackNotification_FullMethodName, in, out, cOpts...)
+	out := new(GetUnreadCountResponse)
+	err := c.cc.Invoke(ctx, NotificationService_GetUnreadCount_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *notificationServiceClient) RegisterWebhook(ctx context.Context, in *RegisterWebhookRequest, opts ...grpc.CallOption) (*RegisterWebhookResponse, error) {
+func (c *notificationServiceClient) MarkNotificationRead(ctx context.Context, in *MarkNotificationReadRequest, opts ...grpc.CallOption) (*Notification, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(RegisterWebhookResponse)
-	err := c.cc.Invoke(ctx, NotificationService_RegisterWebhook_FullMethodName, in, out, cOpts...)
+	out := new(Notification)
+	err := c.cc.Invoke(ctx, NotificationService_MarkNotificationRead_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *notificationServiceClient) UpdateNotificationPreferences(ctx context.Context, in *UpdateNotificationPreferencesRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+func (c *notificationServiceClient) MarkAllRead(ctx context.Context, in *MarkAllReadRequest, opts ...grpc.CallOption) (*MarkAllReadResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(emptypb.Empty)
-	err := c.cc.Invoke(ctx, NotificationService_UpdateNotificationPreferences_FullMethodName, in, out, cOpts...)
+	out := new(MarkAllReadResponse)
+	err := c.cc.Invoke(ctx, NotificationService_MarkAllRead_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -583,8 +2626,35 @@ type NotificationServiceServer interface {
 	SendSlackNotification(context.Context, *NotificationRequest) (*NotificationResponse, error)
 	// Register webhook URL for notifications
 	RegisterWebhook(context.Context, *RegisterWebhookRequest) (*RegisterWebhookResponse, error)
+	// List the caller's webhooks, or every webhook of their organization for admins
+	ListWebhooks(context.Context, *ListWebhooksRequest) (*ListWebhooksResponse, error)
+	// Get a webhook
+	GetWebhook(context.Context, *GetWebhookRequest) (*Webhook, error)
+	// Update a webhook's URL, description or events
+	UpdateWebhook(context.Context, *UpdateWebhookRequest) (*Webhook, error)
+	// Delete a webhook
+	DeleteWebhook(context.Context, *DeleteWebhookRequest) (*emptypb.Empty, error)
+	// Send a synthetic ping to a webhook and return its response
+	TestWebhook(context.Context, *TestWebhookRequest) (*WebhookDelivery, error)
+	// Replace a webhook's signing secret, returning the new one
+	RotateWebhookSecret(context.Context, *RotateWebhookSecretRequest) (*RotateWebhookSecretResponse, error)
 	// Update notification preferences
 	UpdateNotificationPreferences(context.Context, *UpdateNotificationPreferencesRequest) (*emptypb.Empty, error)
+	// List the delivery attempts of a webhook, newest first
+	ListWebhookDeliveries(context.Context, *ListWebhookDeliveriesRequest) (*ListWebhookDeliveriesResponse, error)
+	// Send the payload of a past delivery to its webhook again
+	RedeliverWebhook(context.Context, *RedeliverWebhookRequest) (*WebhookDelivery, error)
+	// Turn off email notifications with a signed unsubscribe link. Served over
+	// HTTP at /api/v1/notifications/unsubscribe by a custom gateway handler.
+	Unsubscribe(context.Context, *UnsubscribeRequest) (*UnsubscribeResponse, error)
+	// List the current user's in-app notifications, newest first
+	ListNotifications(context.Context, *ListNotificationsRequest) (*ListNotificationsResponse, error)
+	// Count the current user's unread notifications
+	GetUnreadCount(context.Context, *GetUnreadCountRequest) (*GetUnreadCountResponse, error)
+	// Mark one of the current user's notifications read
+	MarkNotificationRead(context.Context, *MarkNotificationReadRequest) (*Notification, error)
+	// Mark all of the current user's notifications read
+	MarkAllRead(context.Context, *MarkAllReadRequest) (*MarkAllReadResponse, error)
 	mustEmbedUnimplementedNotificationServiceServer()
 }
 
@@ -601,9 +2671,48 @@ func (UnimplementedNotificationServiceServer) SendSlackNotification(context.Cont
 func (UnimplementedNotificationServiceServer) RegisterWebhook(context.Context, *RegisterWebhookRequest) (*RegisterWebhookResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RegisterWebhook not implemented")
 }
+func (UnimplementedNotificationServiceServer) ListWebhooks(context.Context, *ListWebhooksRequest) (*ListWebhooksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWebhooks not implemented")
+}
+func (UnimplementedNotificationServiceServer) GetWebhook(context.Context, *GetWebhookRequest) (*Webhook, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWebhook not implemented")
+}
+func (UnimplementedNotificationServiceServer) UpdateWebhook(context.Context, *UpdateWebhookRequest) (*Webhook, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateWebhook not implemented")
+}
+func (UnimplementedNotificationServiceServer) DeleteWebhook(context.Context, *DeleteWebhookRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteWebhook not implemented")
+}
+func (UnimplementedNotificationServiceServer) TestWebhook(context.Context, *TestWebhookRequest) (*WebhookDelivery, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TestWebhook not implemented")
+}
+func (UnimplementedNotificationServiceServer) RotateWebhookSecret(context.Context, *RotateWebhookSecretRequest) (*RotateWebhookSecretResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateWebhookSecret not implemented")
+}
 func (UnimplementedNotificationServiceServer) UpdateNotificationPreferences(context.Context, *UpdateNotificationPreferencesRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateNotificationPreferences not implemented")
 }
+func (UnimplementedNotificationServiceServer) ListWebhookDeliveries(context.Context, *ListWebhookDeliveriesRequest) (*ListWebhookDeliveriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWebhookDeliveries not implemented")
+}
+func (UnimplementedNotificationServiceServer) RedeliverWebhook(context.Context, *RedeliverWebhookRequest) (*WebhookDelivery, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedeliverWebhook not implemented")
+}
+func (UnimplementedNotificationServiceServer) Unsubscribe(context.Context, *UnsubscribeRequest) (*UnsubscribeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Unsubscribe not implemented")
+}
+func (UnimplementedNotificationServiceServer) ListNotifications(context.Context, *ListNotificationsRequest) (*ListNotificationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListNotifications not implemented")
+}
+func (UnimplementedNotificationServiceServer) GetUnreadCount(context.Context, *GetUnreadCountRequest) (*GetUnreadCountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUnreadCount not implemented")
+}
+func (UnimplementedNotificationServiceServer) MarkNotificationRead(context.Context, *MarkNotificationReadRequest) (*Notification, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkNotificationRead not implemented")
+}
+func (UnimplementedNotificationServiceServer) MarkAllRead(context.Context, *MarkAllReadRequest) (*MarkAllReadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkAllRead not implemented")
+}
 func (UnimplementedNotificationServiceServer) mustEmbedUnimplementedNotificationServiceServer() {}
 func (UnimplementedNotificationServiceServer) testEmbeddedByValue()                             {}
 
@@ -661,6 +2770,114 @@ func _NotificationService_RegisterWebhook_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _NotificationService_ListWebhooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWebhooksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).ListWebhooks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationService_ListWebhooks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).ListWebhooks(ctx, req.(*ListWebhooksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationService_GetWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).GetWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationService_GetWebhook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).GetWebhook(ctx, req.(*GetWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationService_UpdateWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).UpdateWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationService_UpdateWebhook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).UpdateWebhook(ctx, req.(*UpdateWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationService_DeleteWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).DeleteWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationService_DeleteWebhook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).DeleteWebhook(ctx, req.(*DeleteWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationService_TestWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TestWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).TestWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationService_TestWebhook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).TestWebhook(ctx, req.(*TestWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationService_RotateWebhookSecret_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RotateWebhookSecretRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).RotateWebhookSecret(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationService_RotateWebhookSecret_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).RotateWebhookSecret(ctx, req.(*RotateWebhookSecretRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _NotificationService_UpdateNotificationPreferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UpdateNotificationPreferencesRequest)
 	if err := dec(in); err != nil {
@@ -679,6 +2896,132 @@ func _NotificationService_UpdateNotificationPreferences_Handler(srv interface{},
 	return interceptor(ctx, in, info, handler)
 }
 
+func _NotificationService_ListWebhookDeliveries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWebhookDeliveriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).ListWebhookDeliveries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationService_ListWebhookDeliveries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).ListWebhookDeliveries(ctx, req.(*ListWebhookDeliveriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationService_RedeliverWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RedeliverWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).RedeliverWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationService_RedeliverWebhook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).RedeliverWebhook(ctx, req.(*RedeliverWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationService_Unsubscribe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnsubscribeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).Unsubscribe(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationService_Unsubscribe_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).Unsubscribe(ctx, req.(*UnsubscribeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationService_ListNotifications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListNotificationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).ListNotifications(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationService_ListNotifications_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).ListNotifications(ctx, req.(*ListNotificationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationService_GetUnreadCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUnreadCountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).GetUnreadCount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationService_GetUnreadCount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).GetUnreadCount(ctx, req.(*GetUnreadCountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationService_MarkNotificationRead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkNotificationReadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).MarkNotificationRead(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationService_MarkNotificationRead_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).MarkNotificationRead(ctx, req.(*MarkNotificationReadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationService_MarkAllRead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkAllReadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).MarkAllRead(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationService_MarkAllRead_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).MarkAllRead(ctx, req.(*MarkAllReadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // NotificationService_ServiceDesc is the grpc.ServiceDesc for NotificationService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -694,10 +3037,62 @@ var NotificationService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RegisterWebhook",
 			Handler:    _NotificationService_RegisterWebhook_Handler,
 		},
+		{
+			MethodName: "ListWebhooks",
+			Handler:    _NotificationService_ListWebhooks_Handler,
+		},
+		{
+			MethodName: "GetWebhook",
+			Handler:    _NotificationService_GetWebhook_Handler,
+		},
+		{
+			MethodName: "UpdateWebhook",
+			Handler:    _NotificationService_UpdateWebhook_Handler,
+		},
+		{
+			MethodName: "DeleteWebhook",
+			Handler:    _NotificationService_DeleteWebhook_Handler,
+		},
+		{
+			MethodName: "TestWebhook",
+			Handler:    _NotificationService_TestWebhook_Handler,
+		},
+		{
+			MethodName: "RotateWebhookSecret",
+			Handler:    _NotificationService_RotateWebhookSecret_Handler,
+		},
 		{
 			MethodName: "UpdateNotificationPreferences",
 			Handler:    _NotificationService_UpdateNotificationPreferences_Handler,
 		},
+		{
+			MethodName: "ListWebhookDeliveries",
+			Handler:    _NotificationService_ListWebhookDeliveries_Handler,
+		},
+		{
+			MethodName: "RedeliverWebhook",
+			Handler:    _NotificationService_RedeliverWebhook_Handler,
+		},
+		{
+			MethodName: "Unsubscribe",
+			Handler:    _NotificationService_Unsubscribe_Handler,
+		},
+		{
+			MethodName: "ListNotifications",
+			Handler:    _NotificationService_ListNotifications_Handler,
+		},
+		{
+			MethodName: "GetUnreadCount",
+			Handler:    _NotificationService_GetUnreadCount_Handler,
+		},
+		{
+			MethodName: "MarkNotificationRead",
+			Handler:    _NotificationService_MarkNotificationRead_Handler,
+		},
+		{
+			MethodName: "MarkAllRead",
+			Handler:    _NotificationService_MarkAllRead_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "buganizer.proto",
@@ -15,12 +15,44 @@ type IssueRepository interface {
 	// Create adds a new issue to the database
 	Create(ctx context.Context, issue *models.Issue) error
 
+	// CreateWithOutbox adds a new issue and the notifications about it in
+	// one transaction
+	CreateWithOutbox(ctx context.Context, issue *models.Issue, messages []*models.OutboxMessage) error
+
 	// GetByID retrieves an issue by its ID
 	GetByID(ctx context.Context, id uuid.UUID) (*models.Issue, error)
 
 	// Update updates an existing issue
 	Update(ctx context.Context, issue *models.Issue) error
 
+	// UpdateWithOutbox updates an existing issue and adds the notifications
+	// about the change in one transaction
+	UpdateWithOutbox(ctx context.Context, issue *models.Issue, messages []*models.OutboxMessage) error
+
+	// RecordFirstResponse sets when an issue first got a response, unless it
+	// already has one. It reports whether the time was recorded.
+	RecordFirstResponse(ctx context.Context, issueID uuid.UUID, at time.Time) (bool, error)
+
+	// SetDueDates updates an issue's SLA deadlines without touching the rest of it
+	SetDueDates(ctx context.Context, issueID uuid.UUID, dueDate, responseDueDate *time.Time) error
+
+	// ListWithDeadlines retrieves issues with a running deadline: a due date, or
+	// a response due date and no first response. Issues in the excluded
+	// statuses are skipped.
+	ListWithDeadlines(ctx context.Context, excludeStatuses []string, limit, offset int) ([]*models.Issue, error)
+
+	// RecordResolution sets when an issue was first resolved, unless it
+	// already has been. It reports whether the time was recorded.
+	RecordResolution(ctx context.Context, issueID uuid.UUID, at time.Time) (bool, error)
+
+	// GetSLAStats counts the SLAs met and missed by the issues matching a
+	// filter, overall and grouped by each SLAStatsDimension
+	GetSLAStats(ctx context.Context, filter models.SLAStatsFilter) ([]*models.SLACounts, error)
+
+	// GetSLATrend counts resolutions and breaches of the issues matching a
+	// filter per bucket, including empty buckets, oldest first
+	GetSLATrend(ctx context.Context, filter models.SLATrendFilter) ([]*models.SLATrendBucket, error)
+
 	// List retrieves issues with filtering, pagination
 	// filter is a search query like "is:open assignee:me component:frontend"
 	List(ctx context.Context, filter string, limit, offset int) ([]*models.Issue, int, error)
@@ -43,6 +75,10 @@ type CommentRepository interface {
 	// Create adds a new comment to the database
 	Create(ctx context.Context, comment *models.Comment) error
 
+	// CreateWithOutbox adds a new comment and the notifications about it in
+	// one transaction
+	CreateWithOutbox(ctx context.Context, comment *models.Comment, messages []*models.OutboxMessage) error
+
 	// GetByID retrieves a comment by its ID
 	GetByID(ctx context.Context, id uuid.UUID) (*models.Comment, error)
 
@@ -67,10 +103,168 @@ type AttachmentRepository interface {
 	// GetIssueAttachments retrieves all attachments for an issue
 	GetIssueAttachments(ctx context.Context, issueID uuid.UUID) ([]*models.Attachment, error)
 
+	// UpdateScanResult records the outcome of a malware scan
+	UpdateScanResult(ctx context.Context, attachment *models.Attachment) error
+
+	// ClaimPendingScans marks up to limit attachments still waiting for a scan
+	// that was not started after staleBefore as being scanned, and returns them
+	ClaimPendingScans(ctx context.Context, staleBefore time.Time, limit int) ([]*models.Attachment, error)
+
+	// SetThumbnail records the storage key of an attachment's thumbnail
+	SetThumbnail(ctx context.Context, id uuid.UUID, thumbnailKey string) error
+
+	// GetStorageUsage returns the total attachment bytes of an issue and of its organization
+	GetStorageUsage(ctx context.Context, issueID uuid.UUID) (issueBytes, orgBytes int64, err error)
+
 	// Delete removes an attachment
 	Delete(ctx context.Context, id uuid.UUID) error
 }
 
+// AttachmentUploadRepository defines the interface for chunked upload session operations
+type AttachmentUploadRepository interface {
+	// Create adds a new upload session
+	Create(ctx context.Context, upload *models.AttachmentUpload) error
+
+	// GetByID retrieves an upload session by its ID
+	GetByID(ctx context.Context, id uuid.UUID) (*models.AttachmentUpload, error)
+
+	// UpdateProgress records the bytes received and parts staged so far
+	UpdateProgress(ctx context.Context, upload *models.AttachmentUpload) error
+
+	// Delete removes an upload session
+	Delete(ctx context.Context, id uuid.UUID) error
+}
+
+// WatcherRepository defines the interface for issue watcher (CC list) operations
+type WatcherRepository interface {
+	// Add adds a user to an issue's watchers (no-op if already watching)
+	Add(ctx context.Context, watcher *models.IssueWatcher) error
+
+	// Remove removes a user from an issue's watchers
+	Remove(ctx context.Context, issueID, userID uuid.UUID) error
+
+	// ListByIssue retrieves all watchers of an issue
+	ListByIssue(ctx context.Context, issueID uuid.UUID) ([]*models.IssueWatcher, error)
+}
+
+// SLANotificationRepository defines the interface for tracking the SLA
+// notifications sent for issues
+type SLANotificationRepository interface {
+	// Claim records that a notification for a threshold is being sent. It
+	// reports false if one was already sent for the issue, target and threshold.
+	Claim(ctx context.Context, issueID uuid.UUID, target models.SLATarget, threshold int, at time.Time) (bool, error)
+
+	// Release removes a claim whose notification could not be sent
+	Release(ctx context.Context, issueID uuid.UUID, target models.SLATarget, threshold int) error
+}
+
+// LeaderLock defines the interface for electing a single leader among
+// replicas, e.g. to run a background job once
+type LeaderLock interface {
+	// TryAcquire takes the lock without waiting, reporting whether it is
+	// held. It can be called again while held to check it was not lost.
+	TryAcquire(ctx context.Context) (bool, error)
+
+	// Release gives up the lock
+	Release(ctx context.Context) error
+}
+
+// EscalationRepository defines the interface for escalation policy and
+// scheduled escalation step operations
+type EscalationRepository interface {
+	// CreatePolicy adds a new escalation policy and its steps to the database
+	CreatePolicy(ctx context.Context, policy *models.EscalationPolicy) error
+
+	// GetPolicy retrieves an escalation policy and its steps by its ID
+	GetPolicy(ctx context.Context, id uuid.UUID) (*models.EscalationPolicy, error)
+
+	// GetPolicyForComponent retrieves the policy that applies to a component:
+	// its own policy, or else the policy of the team owning it
+	GetPolicyForComponent(ctx context.Context, componentID uuid.UUID) (*models.EscalationPolicy, error)
+
+	// ListPolicies retrieves the escalation policies of an organization
+	ListPolicies(ctx context.Context, organizationID uuid.UUID) ([]*models.EscalationPolicy, error)
+
+	// UpdatePolicy updates an existing escalation policy, replacing its steps
+	UpdatePolicy(ctx context.Context, policy *models.EscalationPolicy) error
+
+	// DeletePolicy removes an escalation policy
+	DeletePolicy(ctx context.Context, id uuid.UUID) error
+
+	// SetTeamOnCall sets the user on call for a team, or clears it when userID is nil
+	SetTeamOnCall(ctx context.Context, teamID uuid.UUID, userID *uuid.UUID) error
+
+	// Schedule adds the steps of an issue's escalation, unless the issue has
+	// already been escalated. It reports whether the steps were added.
+	Schedule(ctx context.Context, escalations []*models.Escalation) (bool, error)
+
+	// ListByIssue retrieves the escalation steps of an issue in run order
+	ListByIssue(ctx context.Context, issueID uuid.UUID) ([]*models.Escalation, error)
+
+	// ClaimDue leases up to limit pending steps whose time has come, so no
+	// other scheduler runs them until the lease expires
+	ClaimDue(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]*models.Escalation, error)
+
+	// Finish records the outcome of a step
+	Finish(ctx context.Context, id uuid.UUID, status models.EscalationStatus, lastError string, at time.Time) error
+
+	// Retry reschedules a step that failed
+	Retry(ctx context.Context, id uuid.UUID, runAt time.Time, lastError string) error
+
+	// CancelPending cancels the pending steps of an issue, returning them
+	CancelPending(ctx context.Context, issueID uuid.UUID, at time.Time) ([]*models.Escalation, error)
+}
+
+// OnCallRepository defines the interface for on-call rotation operations
+type OnCallRepository interface {
+	// CreateRotation adds a new rotation and its members to the database
+	CreateRotation(ctx context.Context, rotation *models.OnCallRotation) error
+
+	// GetRotation retrieves a rotation and its members by its ID
+	GetRotation(ctx context.Context, id uuid.UUID) (*models.OnCallRotation, error)
+
+	// GetRotationByTeam retrieves the rotation of a team
+	GetRotationByTeam(ctx context.Context, teamID uuid.UUID) (*models.OnCallRotation, error)
+
+	// ListRotations retrieves the rotations of an organization
+	ListRotations(ctx context.Context, organizationID uuid.UUID) ([]*models.OnCallRotation, error)
+
+	// UpdateRotation updates an existing rotation, replacing its members
+	UpdateRotation(ctx context.Context, rotation *models.OnCallRotation) error
+
+	// DeleteRotation removes a rotation and its overrides
+	DeleteRotation(ctx context.Context, id uuid.UUID) error
+
+	// CreateOverride adds a new override to a rotation
+	CreateOverride(ctx context.Context, override *models.OnCallOverride) error
+
+	// GetOverride retrieves an override by its ID
+	GetOverride(ctx context.Context, id uuid.UUID) (*models.OnCallOverride, error)
+
+	// DeleteOverride removes an override
+	DeleteOverride(ctx context.Context, id uuid.UUID) error
+
+	// ListOverrides retrieves the overrides of a rotation overlapping
+	// [from, to), oldest first
+	ListOverrides(ctx context.Context, rotationID uuid.UUID, from, to time.Time) ([]*models.OnCallOverride, error)
+
+	// GetTeamOnCall gets the user set by hand as on call for a team
+	GetTeamOnCall(ctx context.Context, teamID uuid.UUID) (uuid.UUID, error)
+
+	// ListTeamOnCall gets the users set by hand as on call for the teams of
+	// an organization, by team
+	ListTeamOnCall(ctx context.Context, organizationID uuid.UUID) (map[uuid.UUID]uuid.UUID, error)
+}
+
+// IssueHistoryRepository defines the interface for issue change history operations
+type IssueHistoryRepository interface {
+	// Create records changes to an issue
+	Create(ctx context.Context, entries ...*models.IssueHistory) error
+
+	// ListByIssue retrieves the changes to an issue, newest first
+	ListByIssue(ctx context.Context, issueID uuid.UUID) ([]*models.IssueHistory, error)
+}
+
 // UserRepository defines the interface for user data operations
 type UserRepository interface {
 	// Create adds a new user to the database
@@ -96,6 +290,12 @@ type UserRepository interface {
 
 	// GetUserTeams gets the teams a user belongs to
 	GetUserTeams(ctx context.Context, userID uuid.UUID) ([]*models.Team, error)
+
+	// IsAdmin checks if a user holds the ADMIN role
+	IsAdmin(ctx context.Context, userID uuid.UUID) (bool, error)
+
+	// GetOrganizationID gets the organization a user belongs to
+	GetOrganizationID(ctx context.Context, userID uuid.UUID) (uuid.UUID, error)
 }
 
 // TeamRepository defines the interface for team data operations
@@ -120,6 +320,9 @@ type TeamRepository interface {
 
 	// RemoveMember removes a user from a team
 	RemoveMember(ctx context.Context, teamID, userID uuid.UUID) error
+
+	// GetOrganizationID gets the organization a team belongs to
+	GetOrganizationID(ctx context.Context, teamID uuid.UUID) (uuid.UUID, error)
 }
 
 // ComponentRepository defines the interface for component data operations
@@ -138,6 +341,87 @@ type ComponentRepository interface {
 
 	// GetTeamComponents gets components owned by a team
 	GetTeamComponents(ctx context.Context, teamID uuid.UUID) ([]*models.Component, error)
+
+	// GetOrganizationID gets the organization a component belongs to
+	GetOrganizationID(ctx context.Context, componentID uuid.UUID) (uuid.UUID, error)
+}
+
+// SLAConfigRepository defines the interface for SLA policy data operations
+type SLAConfigRepository interface {
+	// Create adds a new SLA policy to the database
+	Create(ctx context.Context, config *models.SLAConfig) error
+
+	// GetByID retrieves an SLA policy by its ID
+	GetByID(ctx context.Context, id uuid.UUID) (*models.SLAConfig, error)
+
+	// GetByComponent retrieves the policy configured for a component
+	GetByComponent(ctx context.Context, componentID uuid.UUID) (*models.SLAConfig, error)
+
+	// GetOrganizationDefault retrieves the default policy of an organization
+	GetOrganizationDefault(ctx context.Context, organizationID uuid.UUID) (*models.SLAConfig, error)
+
+	// GetEffective retrieves the policy that applies to a component: its own
+	// policy, or else its organization's default
+	GetEffective(ctx context.Context, componentID uuid.UUID) (*models.SLAConfig, error)
+
+	// List retrieves the SLA policies of an organization
+	List(ctx context.Context, organizationID uuid.UUID) ([]*models.SLAConfig, error)
+
+	// Update updates an existing SLA policy
+	Update(ctx context.Context, config *models.SLAConfig) error
+
+	// Delete removes an SLA policy
+	Delete(ctx context.Context, id uuid.UUID) error
+}
+
+// SLAPauseRepository defines the interface for SLA pause data operations
+type SLAPauseRepository interface {
+	// Create starts a pause, unless the issue is already paused. It reports
+	// whether the pause was started.
+	Create(ctx context.Context, pause *models.SLAPause) (bool, error)
+
+	// GetOpen retrieves the pause an issue is currently in
+	GetOpen(ctx context.Context, issueID uuid.UUID) (*models.SLAPause, error)
+
+	// End ends the current pause of an issue and returns it
+	End(ctx context.Context, issueID uuid.UUID, at time.Time) (*models.SLAPause, error)
+
+	// ListByIssue retrieves the pauses of an issue, oldest first
+	ListByIssue(ctx context.Context, issueID uuid.UUID) ([]*models.SLAPause, error)
+
+	// ListOpen retrieves the current pauses of the given issues, keyed by issue ID
+	ListOpen(ctx context.Context, issueIDs []uuid.UUID) (map[uuid.UUID]*models.SLAPause, error)
+}
+
+// CalendarRepository defines the interface for business calendar data operations
+type CalendarRepository interface {
+	// Create adds a new calendar and its holidays to the database
+	Create(ctx context.Context, calendar *models.BusinessCalendar) error
+
+	// GetByID retrieves a calendar and its holidays by its ID
+	GetByID(ctx context.Context, id uuid.UUID) (*models.BusinessCalendar, error)
+
+	// GetByTeam retrieves the calendar attached to a team
+	GetByTeam(ctx context.Context, teamID uuid.UUID) (*models.BusinessCalendar, error)
+
+	// GetByComponentTeam retrieves the calendar of the team owning a component
+	GetByComponentTeam(ctx context.Context, componentID uuid.UUID) (*models.BusinessCalendar, error)
+
+	// List retrieves the calendars of an organization
+	List(ctx context.Context, organizationID uuid.UUID) ([]*models.BusinessCalendar, error)
+
+	// Update updates an existing calendar and replaces its holidays
+	Update(ctx context.Context, calendar *models.BusinessCalendar) error
+
+	// AddHolidays adds holidays to a calendar, renaming those already present
+	AddHolidays(ctx context.Context, calendarID uuid.UUID, holidays []models.Holiday) error
+
+	// Delete removes a calendar
+	Delete(ctx context.Context, id uuid.UUID) error
+
+	// SetTeamCalendar attaches a calendar to a team, or detaches it when
+	// calendarID is nil
+	SetTeamCalendar(ctx context.Context, teamID uuid.UUID, calendarID *uuid.UUID) error
 }
 
 // ViewRepository defines the interface for saved view data operations
@@ -175,13 +459,91 @@ type WebhookRepository interface {
 	// UpdateStatus updates the last called time and success status of a webhook
 	UpdateStatus(ctx context.Context, id uuid.UUID, lastCalledAt *time.Time, success bool) error
 
-	// ListByEventType lists webhooks subscribed to a specific event type
-	ListByEventType(ctx context.Context, eventType string) ([]models.Webhook, error)
+	// ListByEventType lists the webhooks created by users of an organization
+	// that are subscribed to a specific event type
+	ListByEventType(ctx context.Context, organizationID uuid.UUID, eventType string) ([]models.Webhook, error)
+
+	// List lists the webhooks created by users of an organization, or only
+	// those created by one user when creatorID is set
+	List(ctx context.Context, organizationID uuid.UUID, creatorID *uuid.UUID) ([]models.Webhook, error)
 
 	// Delete removes a webhook
 	Delete(ctx context.Context, id uuid.UUID) error
 }
 
+// WebhookDeliveryRepository defines the interface for webhook delivery log operations
+type WebhookDeliveryRepository interface {
+	// Create records a delivery attempt
+	Create(ctx context.Context, delivery *models.WebhookDelivery) error
+
+	// GetByID retrieves a delivery attempt by its ID
+	GetByID(ctx context.Context, id uuid.UUID) (*models.WebhookDelivery, error)
+
+	// ListByWebhook lists the delivery attempts of a webhook, newest first,
+	// with the total count
+	ListByWebhook(ctx context.Context, webhookID uuid.UUID, failedOnly bool, limit, offset int) ([]*models.WebhookDelivery, int, error)
+}
+
+// NotificationRepository defines the interface for in-app notification operations
+type NotificationRepository interface {
+	// Create adds a notification to a user's inbox. Adding one that already
+	// exists does nothing.
+	Create(ctx context.Context, notification *models.Notification) error
+
+	// GetByID retrieves a notification by its ID
+	GetByID(ctx context.Context, id uuid.UUID) (*models.Notification, error)
+
+	// ListByUser lists a user's notifications, newest first, with the total
+	// count
+	ListByUser(ctx context.Context, userID uuid.UUID, unreadOnly bool, limit, offset int) ([]*models.Notification, int, error)
+
+	// CountUnread counts a user's unread notifications
+	CountUnread(ctx context.Context, userID uuid.UUID) (int, error)
+
+	// MarkRead marks a notification read, keeping the time it was first read
+	MarkRead(ctx context.Context, id uuid.UUID, at time.Time) error
+
+	// MarkAllRead marks all of a user's notifications read and returns how
+	// many were unread
+	MarkAllRead(ctx context.Context, userID uuid.UUID, at time.Time) (int, error)
+
+	// DeleteOlderThan deletes up to limit notifications created before a
+	// time and returns how many were deleted
+	DeleteOlderThan(ctx context.Context, before time.Time, limit int) (int, error)
+}
+
+// OutboxRepository defines the interface for notification outbox operations
+type OutboxRepository interface {
+	// Enqueue adds messages to the outbox
+	Enqueue(ctx context.Context, messages []*models.OutboxMessage) error
+
+	// ClaimDue leases up to limit pending messages whose time has come, so no
+	// other worker delivers them until the lease expires
+	ClaimDue(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]*models.OutboxMessage, error)
+
+	// MarkDelivered records that a message was delivered
+	MarkDelivered(ctx context.Context, id uuid.UUID, at time.Time) error
+
+	// Expand replaces a message with the messages it fans out into
+	Expand(ctx context.Context, id uuid.UUID, messages []*models.OutboxMessage, at time.Time) error
+
+	// ListDigest lists the pending DIGEST messages of a user that are due
+	ListDigest(ctx context.Context, userID uuid.UUID, now time.Time) ([]*models.OutboxMessage, error)
+
+	// CompleteDigest replaces DIGEST messages with the messages sending their
+	// digest
+	CompleteDigest(ctx context.Context, ids []uuid.UUID, messages []*models.OutboxMessage, at time.Time) error
+
+	// Retry reschedules a message that failed
+	Retry(ctx context.Context, id uuid.UUID, nextAttemptAt time.Time, lastError string) error
+
+	// MarkDead gives up on a message
+	MarkDead(ctx context.Context, id uuid.UUID, lastError string) error
+
+	// GetStats summarizes the queue
+	GetStats(ctx context.Context) (*models.OutboxStats, error)
+}
+
 // NotificationPreferenceRepository defines the interface for notification preference data operations
 type NotificationPreferenceRepository interface {
 	// Upsert creates or updates notification preferences for a user
@@ -0,0 +1,78 @@
+// repositories/postgres/advisory_lock.go
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"sync"
+
+	"github.com/matthewmc1/buganizer/repositories"
+)
+
+// AdvisoryLock implements the LeaderLock interface with a PostgreSQL
+// session-level advisory lock. The lock belongs to a connection, so one is
+// held out of the pool while the lock is held; if it drops, the lock is lost
+// and another replica can take it.
+type AdvisoryLock struct {
+	db  *sql.DB
+	key int64
+
+	mu   sync.Mutex
+	conn *sql.Conn
+}
+
+// NewAdvisoryLock creates a new PostgreSQL advisory lock for the given key
+func NewAdvisoryLock(db *sql.DB, key int64) *AdvisoryLock {
+	return &AdvisoryLock{
+		db:  db,
+		key: key,
+	}
+}
+
+// TryAcquire takes the lock without waiting, reporting whether it is held
+func (l *AdvisoryLock) TryAcquire(ctx context.Context) (bool, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	// Already held, as long as the connection holding it is alive
+	if l.conn != nil {
+		if err := l.conn.PingContext(ctx); err == nil {
+			return true, nil
+		}
+		l.conn.Close()
+		l.conn = nil
+	}
+
+	conn, err := l.db.Conn(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	var acquired bool
+	err = conn.QueryRowContext(ctx, `SELECT pg_try_advisory_lock($1)`, l.key).Scan(&acquired)
+	if err != nil || !acquired {
+		conn.Close()
+		return false, err
+	}
+
+	l.conn = conn
+	return true, nil
+}
+
+// Release gives up the lock if it is held
+func (l *AdvisoryLock) Release(ctx context.Context) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.conn == nil {
+		return nil
+	}
+
+	_, err := l.conn.ExecContext(ctx, `SELECT pg_advisory_unlock($1)`, l.key)
+	l.conn.Close()
+	l.conn = nil
+	return err
+}
+
+// Ensure AdvisoryLock implements repositories.LeaderLock
+var _ repositories.LeaderLock = (*AdvisoryLock)(nil)
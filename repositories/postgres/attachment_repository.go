@@ -4,6 +4,7 @@ package postgres
 import (
 	"context"
 	"database/sql"
+	"time"
 
 	"github.com/google/uuid"
 
@@ -27,9 +28,10 @@ func NewAttachmentRepository(db *sql.DB) *AttachmentRepository {
 func (r *AttachmentRepository) Create(ctx context.Context, attachment *models.Attachment) error {
 	query := `
 		INSERT INTO attachments (
-			id, issue_id, uploader_id, filename, file_url, file_size, created_at
+			id, issue_id, uploader_id, filename, file_url, storage_key, file_size,
+			content_type, sha256, scan_status, scan_result, scan_started_at, created_at
 		) VALUES (
-			$1, $2, $3, $4, $5, $6, $7
+			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $12
 		)
 	`
 
@@ -41,7 +43,12 @@ func (r *AttachmentRepository) Create(ctx context.Context, attachment *models.At
 		attachment.UploaderID,
 		attachment.Filename,
 		attachment.FileURL,
+		attachment.StorageKey,
 		attachment.FileSize,
+		attachment.ContentType,
+		attachment.SHA256,
+		attachment.ScanStatus,
+		attachment.ScanResult,
 		attachment.CreatedAt,
 	)
 
@@ -52,7 +59,8 @@ func (r *AttachmentRepository) Create(ctx context.Context, attachment *models.At
 func (r *AttachmentRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.Attachment, error) {
 	query := `
 		SELECT
-			id, issue_id, uploader_id, filename, file_url, file_size, created_at
+			id, issue_id, uploader_id, filename, file_url, storage_key, file_size,
+			content_type, sha256, scan_status, scan_result, thumbnail_key, created_at
 		FROM attachments
 		WHERE id = $1
 	`
@@ -69,7 +77,13 @@ func (r *AttachmentRepository) GetByID(ctx context.Context, id uuid.UUID) (*mode
 		&attachment.UploaderID,
 		&attachment.Filename,
 		&attachment.FileURL,
+		&attachment.StorageKey,
 		&attachment.FileSize,
+		&attachment.ContentType,
+		&attachment.SHA256,
+		&attachment.ScanStatus,
+		&attachment.ScanResult,
+		&attachment.ThumbnailKey,
 		&attachment.CreatedAt,
 	)
 
@@ -84,7 +98,8 @@ func (r *AttachmentRepository) GetByID(ctx context.Context, id uuid.UUID) (*mode
 func (r *AttachmentRepository) GetIssueAttachments(ctx context.Context, issueID uuid.UUID) ([]*models.Attachment, error) {
 	query := `
 		SELECT
-			id, issue_id, uploader_id, filename, file_url, file_size, created_at
+			id, issue_id, uploader_id, filename, file_url, storage_key, file_size,
+			content_type, sha256, scan_status, scan_result, thumbnail_key, created_at
 		FROM attachments
 		WHERE issue_id = $1
 		ORDER BY created_at DESC
@@ -106,7 +121,13 @@ func (r *AttachmentRepository) GetIssueAttachments(ctx context.Context, issueID
 			&attachment.UploaderID,
 			&attachment.Filename,
 			&attachment.FileURL,
+			&attachment.StorageKey,
 			&attachment.FileSize,
+			&attachment.ContentType,
+			&attachment.SHA256,
+			&attachment.ScanStatus,
+			&attachment.ScanResult,
+			&attachment.ThumbnailKey,
 			&attachment.CreatedAt,
 		)
 		if err != nil {
@@ -123,6 +144,115 @@ func (r *AttachmentRepository) GetIssueAttachments(ctx context.Context, issueID
 	return attachments, nil
 }
 
+// UpdateScanResult records the outcome of a malware scan and the content's
+// storage location, which changes when the content is quarantined
+func (r *AttachmentRepository) UpdateScanResult(ctx context.Context, attachment *models.Attachment) error {
+	query := `
+		UPDATE attachments
+		SET
+			scan_status = $1,
+			scan_result = $2,
+			storage_key = $3,
+			file_url = $4
+		WHERE id = $5
+	`
+
+	_, err := r.db.ExecContext(
+		ctx,
+		query,
+		attachment.ScanStatus,
+		attachment.ScanResult,
+		attachment.StorageKey,
+		attachment.FileURL,
+		attachment.ID,
+	)
+
+	return err
+}
+
+// ClaimPendingScans marks up to limit attachments still waiting for a scan
+// that was not started after staleBefore as being scanned, and returns them.
+// Attachments are created with the scan started, so only scans lost to a
+// restart or crash are claimed.
+func (r *AttachmentRepository) ClaimPendingScans(ctx context.Context, staleBefore time.Time, limit int) ([]*models.Attachment, error) {
+	query := `
+		UPDATE attachments
+		SET scan_started_at = NOW()
+		WHERE id IN (
+			SELECT id FROM attachments
+			WHERE scan_status = 'PENDING' AND (scan_started_at IS NULL OR scan_started_at < $1)
+			ORDER BY created_at
+			LIMIT $2
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING
+			id, issue_id, uploader_id, filename, file_url, storage_key, file_size,
+			content_type, sha256, scan_status, scan_result, thumbnail_key, created_at
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, staleBefore, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var attachments []*models.Attachment
+	for rows.Next() {
+		var attachment models.Attachment
+
+		err := rows.Scan(
+			&attachment.ID,
+			&attachment.IssueID,
+			&attachment.UploaderID,
+			&attachment.Filename,
+			&attachment.FileURL,
+			&attachment.StorageKey,
+			&attachment.FileSize,
+			&attachment.ContentType,
+			&attachment.SHA256,
+			&attachment.ScanStatus,
+			&attachment.ScanResult,
+			&attachment.ThumbnailKey,
+			&attachment.CreatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		attachments = append(attachments, &attachment)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return attachments, nil
+}
+
+// SetThumbnail records the storage key of an attachment's thumbnail
+func (r *AttachmentRepository) SetThumbnail(ctx context.Context, id uuid.UUID, thumbnailKey string) error {
+	query := `UPDATE attachments SET thumbnail_key = $1 WHERE id = $2`
+	_, err := r.db.ExecContext(ctx, query, thumbnailKey, id)
+	return err
+}
+
+// GetStorageUsage returns the total attachment size of an issue and of the
+// organization the issue belongs to
+func (r *AttachmentRepository) GetStorageUsage(ctx context.Context, issueID uuid.UUID) (int64, int64, error) {
+	query := `
+		SELECT
+			COALESCE(SUM(a.file_size) FILTER (WHERE a.issue_id = $1), 0),
+			COALESCE(SUM(a.file_size), 0)
+		FROM attachments a
+		JOIN issues i ON a.issue_id = i.id
+		WHERE i.organization_id = (SELECT organization_id FROM issues WHERE id = $1)
+	`
+
+	var issueBytes, orgBytes int64
+	err := r.db.QueryRowContext(ctx, query, issueID).Scan(&issueBytes, &orgBytes)
+	return issueBytes, orgBytes, err
+}
+
 // Delete removes an attachment
 func (r *AttachmentRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	query := `DELETE FROM attachments WHERE id = $1`
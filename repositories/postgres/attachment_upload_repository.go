@@ -0,0 +1,124 @@
+// repositories/postgres/attachment_upload_repository.go
+package postgres
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+
+	"github.com/matthewmc1/buganizer/models"
+	"github.com/matthewmc1/buganizer/repositories"
+)
+
+// AttachmentUploadRepository implements the AttachmentUploadRepository interface for PostgreSQL
+type AttachmentUploadRepository struct {
+	db *sql.DB
+}
+
+// NewAttachmentUploadRepository creates a new PostgreSQL attachment upload repository
+func NewAttachmentUploadRepository(db *sql.DB) *AttachmentUploadRepository {
+	return &AttachmentUploadRepository{
+		db: db,
+	}
+}
+
+// Create adds a new upload session
+func (r *AttachmentUploadRepository) Create(ctx context.Context, upload *models.AttachmentUpload) error {
+	query := `
+		INSERT INTO attachment_uploads (
+			id, issue_id, uploader_id, filename, total_size, received_bytes, part_offsets,
+			created_at, updated_at
+		) VALUES (
+			$1, $2, $3, $4, $5, $6, $7, $8, $9
+		)
+	`
+
+	_, err := r.db.ExecContext(
+		ctx,
+		query,
+		upload.ID,
+		upload.IssueID,
+		upload.UploaderID,
+		upload.Filename,
+		upload.TotalSize,
+		upload.ReceivedBytes,
+		pq.Array(upload.PartOffsets),
+		upload.CreatedAt,
+		upload.UpdatedAt,
+	)
+
+	return err
+}
+
+// GetByID retrieves an upload session by its ID
+func (r *AttachmentUploadRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.AttachmentUpload, error) {
+	query := `
+		SELECT
+			id, issue_id, uploader_id, filename, total_size, received_bytes, part_offsets,
+			created_at, updated_at
+		FROM attachment_uploads
+		WHERE id = $1
+	`
+
+	var upload models.AttachmentUpload
+	var partOffsets []int64
+
+	err := r.db.QueryRowContext(
+		ctx,
+		query,
+		id,
+	).Scan(
+		&upload.ID,
+		&upload.IssueID,
+		&upload.UploaderID,
+		&upload.Filename,
+		&upload.TotalSize,
+		&upload.ReceivedBytes,
+		pq.Array(&partOffsets),
+		&upload.CreatedAt,
+		&upload.UpdatedAt,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	upload.PartOffsets = partOffsets
+
+	return &upload, nil
+}
+
+// UpdateProgress records the bytes received and parts staged so far
+func (r *AttachmentUploadRepository) UpdateProgress(ctx context.Context, upload *models.AttachmentUpload) error {
+	query := `
+		UPDATE attachment_uploads
+		SET
+			received_bytes = $1,
+			part_offsets = $2,
+			updated_at = $3
+		WHERE id = $4
+	`
+
+	_, err := r.db.ExecContext(
+		ctx,
+		query,
+		upload.ReceivedBytes,
+		pq.Array(upload.PartOffsets),
+		upload.UpdatedAt,
+		upload.ID,
+	)
+
+	return err
+}
+
+// Delete removes an upload session
+func (r *AttachmentUploadRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	query := `DELETE FROM attachment_uploads WHERE id = $1`
+	_, err := r.db.ExecContext(ctx, query, id)
+	return err
+}
+
+// Ensure AttachmentUploadRepository implements repositories.AttachmentUploadRepository
+var _ repositories.AttachmentUploadRepository = (*AttachmentUploadRepository)(nil)
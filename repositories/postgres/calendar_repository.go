@@ -0,0 +1,342 @@
+// repositories/postgres/calendar_repository.go
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+
+	"github.com/matthewmc1/buganizer/models"
+	"github.com/matthewmc1/buganizer/repositories"
+)
+
+// calendarColumns lists the columns read by every calendar query
+const calendarColumns = `
+			c.id, c.organization_id, c.name, c.time_zone, c.working_days,
+			c.day_start, c.day_end, c.created_at, c.updated_at, c.created_by_id`
+
+// CalendarRepository implements the CalendarRepository interface for PostgreSQL
+type CalendarRepository struct {
+	db *sql.DB
+}
+
+// NewCalendarRepository creates a new PostgreSQL business calendar repository
+func NewCalendarRepository(db *sql.DB) *CalendarRepository {
+	return &CalendarRepository{
+		db: db,
+	}
+}
+
+// Create adds a new calendar and its holidays to the database
+func (r *CalendarRepository) Create(ctx context.Context, calendar *models.BusinessCalendar) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	query := `
+		INSERT INTO business_calendars (
+			id, organization_id, name, time_zone, working_days,
+			day_start, day_end, created_at, updated_at, created_by_id
+		) VALUES (
+			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10
+		)
+	`
+
+	_, err = tx.ExecContext(
+		ctx,
+		query,
+		calendar.ID,
+		calendar.OrganizationID,
+		calendar.Name,
+		calendar.TimeZone,
+		pq.Array(weekdaysToInts(calendar.WorkingDays)),
+		calendar.DayStart,
+		calendar.DayEnd,
+		calendar.CreatedAt,
+		calendar.UpdatedAt,
+		calendar.CreatedByID,
+	)
+	if err != nil {
+		return err
+	}
+
+	if err := upsertHolidays(ctx, tx, calendar.ID, calendar.Holidays); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// GetByID retrieves a calendar and its holidays by its ID
+func (r *CalendarRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.BusinessCalendar, error) {
+	query := `SELECT ` + calendarColumns + ` FROM business_calendars c WHERE c.id = $1`
+	return r.get(ctx, query, id)
+}
+
+// GetByTeam retrieves the calendar attached to a team
+func (r *CalendarRepository) GetByTeam(ctx context.Context, teamID uuid.UUID) (*models.BusinessCalendar, error) {
+	query := `
+		SELECT ` + calendarColumns + `
+		FROM business_calendars c
+		JOIN teams t ON t.calendar_id = c.id
+		WHERE t.id = $1
+	`
+	return r.get(ctx, query, teamID)
+}
+
+// GetByComponentTeam retrieves the calendar of the team owning a component
+func (r *CalendarRepository) GetByComponentTeam(ctx context.Context, componentID uuid.UUID) (*models.BusinessCalendar, error) {
+	query := `
+		SELECT ` + calendarColumns + `
+		FROM business_calendars c
+		JOIN teams t ON t.calendar_id = c.id
+		JOIN components co ON co.team_id = t.id
+		WHERE co.id = $1
+	`
+	return r.get(ctx, query, componentID)
+}
+
+// List retrieves the calendars of an organization
+func (r *CalendarRepository) List(ctx context.Context, organizationID uuid.UUID) ([]*models.BusinessCalendar, error) {
+	query := `
+		SELECT ` + calendarColumns + `
+		FROM business_calendars c
+		WHERE c.organization_id = $1
+		ORDER BY c.name
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, organizationID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var calendars []*models.BusinessCalendar
+	for rows.Next() {
+		calendar, err := scanCalendar(rows)
+		if err != nil {
+			return nil, err
+		}
+		calendars = append(calendars, calendar)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	for _, calendar := range calendars {
+		if calendar.Holidays, err = r.getHolidays(ctx, calendar.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	return calendars, nil
+}
+
+// Update updates an existing calendar and replaces its holidays
+func (r *CalendarRepository) Update(ctx context.Context, calendar *models.BusinessCalendar) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	query := `
+		UPDATE business_calendars
+		SET
+			name = $1,
+			time_zone = $2,
+			working_days = $3,
+			day_start = $4,
+			day_end = $5,
+			updated_at = $6
+		WHERE id = $7
+	`
+
+	_, err = tx.ExecContext(
+		ctx,
+		query,
+		calendar.Name,
+		calendar.TimeZone,
+		pq.Array(weekdaysToInts(calendar.WorkingDays)),
+		calendar.DayStart,
+		calendar.DayEnd,
+		calendar.UpdatedAt,
+		calendar.ID,
+	)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, `DELETE FROM calendar_holidays WHERE calendar_id = $1`, calendar.ID)
+	if err != nil {
+		return err
+	}
+
+	if err := upsertHolidays(ctx, tx, calendar.ID, calendar.Holidays); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// AddHolidays adds holidays to a calendar, renaming those already present
+func (r *CalendarRepository) AddHolidays(ctx context.Context, calendarID uuid.UUID, holidays []models.Holiday) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := upsertHolidays(ctx, tx, calendarID, holidays); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// Delete removes a calendar. Teams and SLA policies using it fall back to
+// wall-clock time.
+func (r *CalendarRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	query := `DELETE FROM business_calendars WHERE id = $1`
+	_, err := r.db.ExecContext(ctx, query, id)
+	return err
+}
+
+// SetTeamCalendar attaches a calendar to a team, or detaches it when
+// calendarID is nil
+func (r *CalendarRepository) SetTeamCalendar(ctx context.Context, teamID uuid.UUID, calendarID *uuid.UUID) error {
+	query := `UPDATE teams SET calendar_id = $1, updated_at = $2 WHERE id = $3`
+
+	result, err := r.db.ExecContext(ctx, query, calendarID, time.Now(), teamID)
+	if err != nil {
+		return err
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+// get runs a query selecting calendarColumns and loads the holidays of the
+// calendar found
+func (r *CalendarRepository) get(ctx context.Context, query string, args ...interface{}) (*models.BusinessCalendar, error) {
+	calendar, err := scanCalendar(r.db.QueryRowContext(ctx, query, args...))
+	if err != nil {
+		return nil, err
+	}
+
+	calendar.Holidays, err = r.getHolidays(ctx, calendar.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return calendar, nil
+}
+
+// getHolidays retrieves the holidays of a calendar in date order
+func (r *CalendarRepository) getHolidays(ctx context.Context, calendarID uuid.UUID) ([]models.Holiday, error) {
+	query := `SELECT date, name FROM calendar_holidays WHERE calendar_id = $1 ORDER BY date`
+
+	rows, err := r.db.QueryContext(ctx, query, calendarID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var holidays []models.Holiday
+	for rows.Next() {
+		var holiday models.Holiday
+		if err := rows.Scan(&holiday.Date, &holiday.Name); err != nil {
+			return nil, err
+		}
+		holiday.Date = holiday.Date.UTC()
+		holidays = append(holidays, holiday)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return holidays, nil
+}
+
+// upsertHolidays inserts holidays within a transaction
+func upsertHolidays(ctx context.Context, tx *sql.Tx, calendarID uuid.UUID, holidays []models.Holiday) error {
+	query := `
+		INSERT INTO calendar_holidays (calendar_id, date, name)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (calendar_id, date) DO UPDATE SET name = EXCLUDED.name
+	`
+
+	for _, holiday := range holidays {
+		_, err := tx.ExecContext(ctx, query, calendarID, holiday.Date.Format("2006-01-02"), holiday.Name)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// scanCalendar scans a row selected with calendarColumns
+func scanCalendar(row interface {
+	Scan(dest ...interface{}) error
+}) (*models.BusinessCalendar, error) {
+	var calendar models.BusinessCalendar
+	var workingDays []int64
+	var createdByID sql.NullString
+
+	err := row.Scan(
+		&calendar.ID,
+		&calendar.OrganizationID,
+		&calendar.Name,
+		&calendar.TimeZone,
+		pq.Array(&workingDays),
+		&calendar.DayStart,
+		&calendar.DayEnd,
+		&calendar.CreatedAt,
+		&calendar.UpdatedAt,
+		&createdByID,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, day := range workingDays {
+		calendar.WorkingDays = append(calendar.WorkingDays, time.Weekday(day))
+	}
+
+	// Handle optional fields
+	if createdByID.Valid {
+		id, err := uuid.Parse(createdByID.String)
+		if err == nil {
+			calendar.CreatedByID = &id
+		}
+	}
+
+	return &calendar, nil
+}
+
+// weekdaysToInts converts weekdays for storage in an INTEGER[] column
+func weekdaysToInts(days []time.Weekday) []int64 {
+	ints := make([]int64, len(days))
+	for i, day := range days {
+		ints[i] = int64(day)
+	}
+	return ints
+}
+
+// Ensure CalendarRepository implements repositories.CalendarRepository
+var _ repositories.CalendarRepository = (*CalendarRepository)(nil)
@@ -25,6 +25,19 @@ func NewCommentRepository(db *sql.DB) *CommentRepository {
 
 // Create adds a new comment to the database
 func (r *CommentRepository) Create(ctx context.Context, comment *models.Comment) error {
+	return createComment(ctx, r.db, comment)
+}
+
+// CreateWithOutbox adds a new comment and the notifications about it in one
+// transaction
+func (r *CommentRepository) CreateWithOutbox(ctx context.Context, comment *models.Comment, messages []*models.OutboxMessage) error {
+	return withOutbox(ctx, r.db, messages, func(tx *sql.Tx) error {
+		return createComment(ctx, tx, comment)
+	})
+}
+
+// createComment inserts a comment
+func createComment(ctx context.Context, db execer, comment *models.Comment) error {
 	query := `
 		INSERT INTO comments (
 			id, issue_id, author_id, content, created_at, updated_at
@@ -33,7 +46,7 @@ func (r *CommentRepository) Create(ctx context.Context, comment *models.Comment)
 		)
 	`
 
-	_, err := r.db.ExecContext(
+	_, err := db.ExecContext(
 		ctx,
 		query,
 		comment.ID,
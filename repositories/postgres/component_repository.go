@@ -201,5 +201,14 @@ func (r *ComponentRepository) GetTeamComponents(ctx context.Context, teamID uuid
 	return components, nil
 }
 
+// GetOrganizationID gets the organization a component belongs to
+func (r *ComponentRepository) GetOrganizationID(ctx context.Context, componentID uuid.UUID) (uuid.UUID, error) {
+	query := `SELECT organization_id FROM components WHERE id = $1`
+
+	var organizationID uuid.UUID
+	err := r.db.QueryRowContext(ctx, query, componentID).Scan(&organizationID)
+	return organizationID, err
+}
+
 // Ensure ComponentRepository implements repositories.ComponentRepository
 var _ repositories.ComponentRepository = (*ComponentRepository)(nil)
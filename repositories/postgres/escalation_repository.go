@@ -0,0 +1,525 @@
+// repositories/postgres/escalation_repository.go
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+
+	"github.com/matthewmc1/buganizer/models"
+	"github.com/matthewmc1/buganizer/repositories"
+)
+
+// escalationPolicyColumns lists the columns read by every escalation policy query
+const escalationPolicyColumns = `
+			p.id, p.organization_id, p.name, p.component_id, p.team_id,
+			p.priorities, p.created_at, p.updated_at, p.created_by_id`
+
+// escalationColumns lists the columns read by every scheduled step query
+const escalationColumns = `
+			id, issue_id, policy_id, position, action, target, run_at,
+			status, attempts, last_error, locked_until, finished_at, created_at`
+
+// EscalationRepository implements the EscalationRepository interface for PostgreSQL
+type EscalationRepository struct {
+	db *sql.DB
+}
+
+// NewEscalationRepository creates a new PostgreSQL escalation repository
+func NewEscalationRepository(db *sql.DB) *EscalationRepository {
+	return &EscalationRepository{
+		db: db,
+	}
+}
+
+// CreatePolicy adds a new escalation policy and its steps to the database
+func (r *EscalationRepository) CreatePolicy(ctx context.Context, policy *models.EscalationPolicy) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	query := `
+		INSERT INTO escalation_policies (
+			id, organization_id, name, component_id, team_id,
+			priorities, created_at, updated_at, created_by_id
+		) VALUES (
+			$1, $2, $3, $4, $5, $6, $7, $8, $9
+		)
+	`
+
+	_, err = tx.ExecContext(
+		ctx,
+		query,
+		policy.ID,
+		policy.OrganizationID,
+		policy.Name,
+		policy.ComponentID,
+		policy.TeamID,
+		pq.Array(prioritiesToStrings(policy.Priorities)),
+		policy.CreatedAt,
+		policy.UpdatedAt,
+		policy.CreatedByID,
+	)
+	if err != nil {
+		return err
+	}
+
+	if err := insertEscalationSteps(ctx, tx, policy.ID, policy.Steps); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// GetPolicy retrieves an escalation policy and its steps by its ID
+func (r *EscalationRepository) GetPolicy(ctx context.Context, id uuid.UUID) (*models.EscalationPolicy, error) {
+	query := `SELECT ` + escalationPolicyColumns + ` FROM escalation_policies p WHERE p.id = $1`
+	return r.getPolicy(ctx, query, id)
+}
+
+// GetPolicyForComponent retrieves the policy of a component, or else of the
+// team owning it
+func (r *EscalationRepository) GetPolicyForComponent(ctx context.Context, componentID uuid.UUID) (*models.EscalationPolicy, error) {
+	query := `
+		SELECT ` + escalationPolicyColumns + `
+		FROM escalation_policies p
+		JOIN components c ON c.id = $1
+		WHERE p.component_id = c.id OR p.team_id = c.team_id
+		ORDER BY p.component_id NULLS LAST
+		LIMIT 1
+	`
+	return r.getPolicy(ctx, query, componentID)
+}
+
+// ListPolicies retrieves the escalation policies of an organization
+func (r *EscalationRepository) ListPolicies(ctx context.Context, organizationID uuid.UUID) ([]*models.EscalationPolicy, error) {
+	query := `
+		SELECT ` + escalationPolicyColumns + `
+		FROM escalation_policies p
+		WHERE p.organization_id = $1
+		ORDER BY p.name
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, organizationID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var policies []*models.EscalationPolicy
+	for rows.Next() {
+		policy, err := scanEscalationPolicy(rows)
+		if err != nil {
+			return nil, err
+		}
+		policies = append(policies, policy)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	for _, policy := range policies {
+		if policy.Steps, err = r.getSteps(ctx, policy.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	return policies, nil
+}
+
+// UpdatePolicy updates an existing escalation policy, replacing its steps
+func (r *EscalationRepository) UpdatePolicy(ctx context.Context, policy *models.EscalationPolicy) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	query := `
+		UPDATE escalation_policies
+		SET
+			name = $1,
+			priorities = $2,
+			updated_at = $3
+		WHERE id = $4
+	`
+
+	_, err = tx.ExecContext(
+		ctx,
+		query,
+		policy.Name,
+		pq.Array(prioritiesToStrings(policy.Priorities)),
+		policy.UpdatedAt,
+		policy.ID,
+	)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, `DELETE FROM escalation_steps WHERE policy_id = $1`, policy.ID)
+	if err != nil {
+		return err
+	}
+
+	if err := insertEscalationSteps(ctx, tx, policy.ID, policy.Steps); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// DeletePolicy removes an escalation policy. Steps already scheduled keep running.
+func (r *EscalationRepository) DeletePolicy(ctx context.Context, id uuid.UUID) error {
+	query := `DELETE FROM escalation_policies WHERE id = $1`
+	_, err := r.db.ExecContext(ctx, query, id)
+	return err
+}
+
+// SetTeamOnCall sets the user on call for a team, or clears it when userID is nil
+func (r *EscalationRepository) SetTeamOnCall(ctx context.Context, teamID uuid.UUID, userID *uuid.UUID) error {
+	query := `UPDATE teams SET on_call_id = $1, updated_at = $2 WHERE id = $3`
+
+	result, err := r.db.ExecContext(ctx, query, userID, time.Now(), teamID)
+	if err != nil {
+		return err
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+// Schedule adds the steps of an issue's escalation unless the issue already
+// has escalation steps
+func (r *EscalationRepository) Schedule(ctx context.Context, escalations []*models.Escalation) (bool, error) {
+	if len(escalations) == 0 {
+		return false, nil
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback()
+
+	var exists bool
+	err = tx.QueryRowContext(ctx, `SELECT EXISTS (SELECT 1 FROM escalations WHERE issue_id = $1)`, escalations[0].IssueID).Scan(&exists)
+	if err != nil {
+		return false, err
+	}
+	if exists {
+		return false, nil
+	}
+
+	query := `
+		INSERT INTO escalations (
+			id, issue_id, policy_id, position, action, target, run_at, status, created_at
+		) VALUES (
+			$1, $2, $3, $4, $5, $6, $7, $8, $9
+		)
+	`
+
+	for _, escalation := range escalations {
+		_, err := tx.ExecContext(
+			ctx,
+			query,
+			escalation.ID,
+			escalation.IssueID,
+			escalation.PolicyID,
+			escalation.Position,
+			escalation.Action,
+			escalation.Target,
+			escalation.RunAt,
+			escalation.Status,
+			escalation.CreatedAt,
+		)
+		if err != nil {
+			return false, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// ListByIssue retrieves the escalation steps of an issue in run order
+func (r *EscalationRepository) ListByIssue(ctx context.Context, issueID uuid.UUID) ([]*models.Escalation, error) {
+	query := `
+		SELECT ` + escalationColumns + `
+		FROM escalations
+		WHERE issue_id = $1
+		ORDER BY run_at, position
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, issueID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return scanEscalations(rows)
+}
+
+// ClaimDue leases up to limit pending steps whose time has come. Rows locked
+// by another scheduler's claim are skipped, and a step whose lease expired
+// without an outcome is claimed again.
+func (r *EscalationRepository) ClaimDue(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]*models.Escalation, error) {
+	query := `
+		UPDATE escalations
+		SET
+			attempts = attempts + 1,
+			locked_until = $1
+		WHERE id IN (
+			SELECT id FROM escalations
+			WHERE status = 'PENDING'
+				AND run_at <= $2
+				AND (locked_until IS NULL OR locked_until < $2)
+			ORDER BY run_at
+			LIMIT $3
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING ` + escalationColumns
+
+	rows, err := r.db.QueryContext(ctx, query, now.Add(lease), now, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return scanEscalations(rows)
+}
+
+// Finish records the outcome of a step, unless it was cancelled meanwhile
+func (r *EscalationRepository) Finish(ctx context.Context, id uuid.UUID, status models.EscalationStatus, lastError string, at time.Time) error {
+	query := `
+		UPDATE escalations
+		SET status = $1, last_error = $2, finished_at = $3, locked_until = NULL
+		WHERE id = $4 AND status = 'PENDING'
+	`
+	_, err := r.db.ExecContext(ctx, query, status, lastError, at, id)
+	return err
+}
+
+// Retry reschedules a step that failed
+func (r *EscalationRepository) Retry(ctx context.Context, id uuid.UUID, runAt time.Time, lastError string) error {
+	query := `
+		UPDATE escalations
+		SET run_at = $1, last_error = $2, locked_until = NULL
+		WHERE id = $3 AND status = 'PENDING'
+	`
+	_, err := r.db.ExecContext(ctx, query, runAt, lastError, id)
+	return err
+}
+
+// CancelPending cancels the pending steps of an issue, returning them
+func (r *EscalationRepository) CancelPending(ctx context.Context, issueID uuid.UUID, at time.Time) ([]*models.Escalation, error) {
+	query := `
+		UPDATE escalations
+		SET status = 'CANCELLED', finished_at = $1, locked_until = NULL
+		WHERE issue_id = $2 AND status = 'PENDING'
+		RETURNING ` + escalationColumns
+
+	rows, err := r.db.QueryContext(ctx, query, at, issueID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return scanEscalations(rows)
+}
+
+// getPolicy runs a query selecting escalationPolicyColumns and loads the steps
+// of the policy found
+func (r *EscalationRepository) getPolicy(ctx context.Context, query string, args ...interface{}) (*models.EscalationPolicy, error) {
+	policy, err := scanEscalationPolicy(r.db.QueryRowContext(ctx, query, args...))
+	if err != nil {
+		return nil, err
+	}
+
+	policy.Steps, err = r.getSteps(ctx, policy.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return policy, nil
+}
+
+// getSteps retrieves the steps of a policy in order
+func (r *EscalationRepository) getSteps(ctx context.Context, policyID uuid.UUID) ([]models.EscalationStep, error) {
+	query := `
+		SELECT action, delay_minutes, target
+		FROM escalation_steps
+		WHERE policy_id = $1
+		ORDER BY position
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, policyID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var steps []models.EscalationStep
+	for rows.Next() {
+		var step models.EscalationStep
+		if err := rows.Scan(&step.Action, &step.DelayMinutes, &step.Target); err != nil {
+			return nil, err
+		}
+		steps = append(steps, step)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return steps, nil
+}
+
+// insertEscalationSteps inserts the steps of a policy within a transaction
+func insertEscalationSteps(ctx context.Context, tx *sql.Tx, policyID uuid.UUID, steps []models.EscalationStep) error {
+	query := `
+		INSERT INTO escalation_steps (policy_id, position, action, delay_minutes, target)
+		VALUES ($1, $2, $3, $4, $5)
+	`
+
+	for i, step := range steps {
+		_, err := tx.ExecContext(ctx, query, policyID, i, step.Action, step.DelayMinutes, step.Target)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// scanEscalationPolicy scans a row selected with escalationPolicyColumns
+func scanEscalationPolicy(row interface {
+	Scan(dest ...interface{}) error
+}) (*models.EscalationPolicy, error) {
+	var policy models.EscalationPolicy
+	var componentID, teamID, createdByID sql.NullString
+	var priorities []string
+
+	err := row.Scan(
+		&policy.ID,
+		&policy.OrganizationID,
+		&policy.Name,
+		&componentID,
+		&teamID,
+		pq.Array(&priorities),
+		&policy.CreatedAt,
+		&policy.UpdatedAt,
+		&createdByID,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, priority := range priorities {
+		policy.Priorities = append(policy.Priorities, models.Priority(priority))
+	}
+
+	// Handle optional fields
+	if componentID.Valid {
+		id, err := uuid.Parse(componentID.String)
+		if err == nil {
+			policy.ComponentID = &id
+		}
+	}
+
+	if teamID.Valid {
+		id, err := uuid.Parse(teamID.String)
+		if err == nil {
+			policy.TeamID = &id
+		}
+	}
+
+	if createdByID.Valid {
+		id, err := uuid.Parse(createdByID.String)
+		if err == nil {
+			policy.CreatedByID = &id
+		}
+	}
+
+	return &policy, nil
+}
+
+// scanEscalations scans rows selected with escalationColumns
+func scanEscalations(rows *sql.Rows) ([]*models.Escalation, error) {
+	var escalations []*models.Escalation
+	for rows.Next() {
+		var escalation models.Escalation
+		var policyID sql.NullString
+		var lockedUntil, finishedAt sql.NullTime
+
+		err := rows.Scan(
+			&escalation.ID,
+			&escalation.IssueID,
+			&policyID,
+			&escalation.Position,
+			&escalation.Action,
+			&escalation.Target,
+			&escalation.RunAt,
+			&escalation.Status,
+			&escalation.Attempts,
+			&escalation.LastError,
+			&lockedUntil,
+			&finishedAt,
+			&escalation.CreatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		// Handle optional fields
+		if policyID.Valid {
+			id, err := uuid.Parse(policyID.String)
+			if err == nil {
+				escalation.PolicyID = &id
+			}
+		}
+
+		if lockedUntil.Valid {
+			escalation.LockedUntil = &lockedUntil.Time
+		}
+
+		if finishedAt.Valid {
+			escalation.FinishedAt = &finishedAt.Time
+		}
+
+		escalations = append(escalations, &escalation)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return escalations, nil
+}
+
+// prioritiesToStrings converts priorities for storage in a VARCHAR[] column
+func prioritiesToStrings(priorities []models.Priority) []string {
+	strs := make([]string, len(priorities))
+	for i, priority := range priorities {
+		strs[i] = string(priority)
+	}
+	return strs
+}
+
+// Ensure EscalationRepository implements repositories.EscalationRepository
+var _ repositories.EscalationRepository = (*EscalationRepository)(nil)
@@ -0,0 +1,119 @@
+// repositories/postgres/issue_history_repository.go
+package postgres
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/google/uuid"
+
+	"github.com/matthewmc1/buganizer/models"
+	"github.com/matthewmc1/buganizer/repositories"
+)
+
+// IssueHistoryRepository implements the IssueHistoryRepository interface for PostgreSQL
+type IssueHistoryRepository struct {
+	db *sql.DB
+}
+
+// NewIssueHistoryRepository creates a new PostgreSQL issue history repository
+func NewIssueHistoryRepository(db *sql.DB) *IssueHistoryRepository {
+	return &IssueHistoryRepository{
+		db: db,
+	}
+}
+
+// Create records changes to an issue in a single transaction
+func (r *IssueHistoryRepository) Create(ctx context.Context, entries ...*models.IssueHistory) error {
+	if len(entries) == 0 {
+		return nil
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	query := `
+		INSERT INTO issue_history (
+			id, issue_id, actor_id, field, old_value, new_value, created_at
+		) VALUES (
+			$1, $2, $3, $4, $5, $6, $7
+		)
+	`
+
+	for _, entry := range entries {
+		_, err := tx.ExecContext(
+			ctx,
+			query,
+			entry.ID,
+			entry.IssueID,
+			entry.ActorID,
+			entry.Field,
+			entry.OldValue,
+			entry.NewValue,
+			entry.CreatedAt,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// ListByIssue retrieves the changes to an issue, newest first
+func (r *IssueHistoryRepository) ListByIssue(ctx context.Context, issueID uuid.UUID) ([]*models.IssueHistory, error) {
+	query := `
+		SELECT
+			id, issue_id, actor_id, field, old_value, new_value, created_at
+		FROM issue_history
+		WHERE issue_id = $1
+		ORDER BY created_at DESC
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, issueID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var entries []*models.IssueHistory
+	for rows.Next() {
+		var entry models.IssueHistory
+		var actorID sql.NullString
+
+		err := rows.Scan(
+			&entry.ID,
+			&entry.IssueID,
+			&actorID,
+			&entry.Field,
+			&entry.OldValue,
+			&entry.NewValue,
+			&entry.CreatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		// Handle optional fields
+		if actorID.Valid {
+			id, err := uuid.Parse(actorID.String)
+			if err == nil {
+				entry.ActorID = &id
+			}
+		}
+
+		entries = append(entries, &entry)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return entries, nil
+}
+
+// Ensure IssueHistoryRepository implements repositories.IssueHistoryRepository
+var _ repositories.IssueHistoryRepository = (*IssueHistoryRepository)(nil)
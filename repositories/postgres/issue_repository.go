@@ -29,12 +29,26 @@ func NewIssueRepository(db *sql.DB) *IssueRepository {
 
 // Create adds a new issue to the database
 func (r *IssueRepository) Create(ctx context.Context, issue *models.Issue) error {
+	return createIssue(ctx, r.db, issue)
+}
+
+// CreateWithOutbox adds a new issue and the notifications about it in one
+// transaction
+func (r *IssueRepository) CreateWithOutbox(ctx context.Context, issue *models.Issue, messages []*models.OutboxMessage) error {
+	return withOutbox(ctx, r.db, messages, func(tx *sql.Tx) error {
+		return createIssue(ctx, tx, issue)
+	})
+}
+
+// createIssue inserts an issue
+func createIssue(ctx context.Context, db execer, issue *models.Issue) error {
 	query := `
 		INSERT INTO issues (
 			id, title, description, reproduce_steps, component_id, reporter_id, assignee_id,
-			priority, severity, status, due_date, created_at, updated_at, labels
+			priority, severity, status, due_date, response_due_date, first_response_at,
+			resolved_at, created_at, updated_at, labels
 		) VALUES (
-			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14
+			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17
 		)
 	`
 
@@ -43,7 +57,7 @@ func (r *IssueRepository) Create(ctx context.Context, issue *models.Issue) error
 		assigneeID = issue.AssigneeID
 	}
 
-	_, err := r.db.ExecContext(
+	_, err := db.ExecContext(
 		ctx,
 		query,
 		issue.ID,
@@ -57,6 +71,9 @@ func (r *IssueRepository) Create(ctx context.Context, issue *models.Issue) error
 		issue.Severity,
 		issue.Status,
 		issue.DueDate,
+		issue.ResponseDueDate,
+		issue.FirstResponseAt,
+		issue.ResolvedAt,
 		issue.CreatedAt,
 		issue.UpdatedAt,
 		pq.Array(issue.Labels),
@@ -70,14 +87,15 @@ func (r *IssueRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.Is
 	query := `
 		SELECT
 			id, title, description, reproduce_steps, component_id, reporter_id, assignee_id,
-			priority, severity, status, due_date, created_at, updated_at, labels
+			priority, severity, status, due_date, response_due_date, first_response_at,
+			resolved_at, created_at, updated_at, labels
 		FROM issues
 		WHERE id = $1
 	`
 
 	var issue models.Issue
 	var assigneeID sql.NullString
-	var dueDate sql.NullTime
+	var dueDate, responseDueDate, firstResponseAt, resolvedAt sql.NullTime
 	var labels []string
 
 	err := r.db.QueryRowContext(
@@ -96,6 +114,9 @@ func (r *IssueRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.Is
 		&issue.Severity,
 		&issue.Status,
 		&dueDate,
+		&responseDueDate,
+		&firstResponseAt,
+		&resolvedAt,
 		&issue.CreatedAt,
 		&issue.UpdatedAt,
 		pq.Array(&labels),
@@ -117,6 +138,18 @@ func (r *IssueRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.Is
 		issue.DueDate = &dueDate.Time
 	}
 
+	if responseDueDate.Valid {
+		issue.ResponseDueDate = &responseDueDate.Time
+	}
+
+	if firstResponseAt.Valid {
+		issue.FirstResponseAt = &firstResponseAt.Time
+	}
+
+	if resolvedAt.Valid {
+		issue.ResolvedAt = &resolvedAt.Time
+	}
+
 	issue.Labels = labels
 
 	return &issue, nil
@@ -124,6 +157,19 @@ func (r *IssueRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.Is
 
 // Update updates an existing issue
 func (r *IssueRepository) Update(ctx context.Context, issue *models.Issue) error {
+	return updateIssue(ctx, r.db, issue)
+}
+
+// UpdateWithOutbox updates an existing issue and adds the notifications about
+// the change in one transaction
+func (r *IssueRepository) UpdateWithOutbox(ctx context.Context, issue *models.Issue, messages []*models.OutboxMessage) error {
+	return withOutbox(ctx, r.db, messages, func(tx *sql.Tx) error {
+		return updateIssue(ctx, tx, issue)
+	})
+}
+
+// updateIssue updates the fields of an issue that UpdateIssue can change
+func updateIssue(ctx context.Context, db execer, issue *models.Issue) error {
 	query := `
 		UPDATE issues
 		SET
@@ -136,9 +182,10 @@ func (r *IssueRepository) Update(ctx context.Context, issue *models.Issue) error
 			severity = $7,
 			status = $8,
 			due_date = $9,
-			updated_at = $10,
-			labels = $11
-		WHERE id = $12
+			response_due_date = $10,
+			updated_at = $11,
+			labels = $12
+		WHERE id = $13
 	`
 
 	var assigneeID *uuid.UUID
@@ -146,7 +193,7 @@ func (r *IssueRepository) Update(ctx context.Context, issue *models.Issue) error
 		assigneeID = issue.AssigneeID
 	}
 
-	_, err := r.db.ExecContext(
+	_, err := db.ExecContext(
 		ctx,
 		query,
 		issue.Title,
@@ -158,6 +205,7 @@ func (r *IssueRepository) Update(ctx context.Context, issue *models.Issue) error
 		issue.Severity,
 		issue.Status,
 		issue.DueDate,
+		issue.ResponseDueDate,
 		issue.UpdatedAt,
 		pq.Array(issue.Labels),
 		issue.ID,
@@ -166,6 +214,49 @@ func (r *IssueRepository) Update(ctx context.Context, issue *models.Issue) error
 	return err
 }
 
+// RecordFirstResponse sets when an issue first got a response, unless it
+// already has one. It reports whether the time was recorded.
+func (r *IssueRepository) RecordFirstResponse(ctx context.Context, issueID uuid.UUID, at time.Time) (bool, error) {
+	query := `UPDATE issues SET first_response_at = $1 WHERE id = $2 AND first_response_at IS NULL`
+
+	result, err := r.db.ExecContext(ctx, query, at, issueID)
+	if err != nil {
+		return false, err
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return rows > 0, nil
+}
+
+// RecordResolution sets when an issue was first resolved, unless it already
+// has been. It reports whether the time was recorded.
+func (r *IssueRepository) RecordResolution(ctx context.Context, issueID uuid.UUID, at time.Time) (bool, error) {
+	query := `UPDATE issues SET resolved_at = $1 WHERE id = $2 AND resolved_at IS NULL`
+
+	result, err := r.db.ExecContext(ctx, query, at, issueID)
+	if err != nil {
+		return false, err
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return rows > 0, nil
+}
+
+// SetDueDates updates an issue's SLA deadlines without touching the rest of it
+func (r *IssueRepository) SetDueDates(ctx context.Context, issueID uuid.UUID, dueDate, responseDueDate *time.Time) error {
+	query := `UPDATE issues SET due_date = $1, response_due_date = $2 WHERE id = $3`
+	_, err := r.db.ExecContext(ctx, query, dueDate, responseDueDate, issueID)
+	return err
+}
+
 // List retrieves issues with filtering, pagination
 func (r *IssueRepository) List(ctx context.Context, filter string, limit, offset int) ([]*models.Issue, int, error) {
 	// Parse filter and build WHERE clause
@@ -187,7 +278,8 @@ func (r *IssueRepository) List(ctx context.Context, filter string, limit, offset
 	query := fmt.Sprintf(`
 		SELECT
 			id, title, description, reproduce_steps, component_id, reporter_id, assignee_id,
-			priority, severity, status, due_date, created_at, updated_at, labels
+			priority, severity, status, due_date, response_due_date, first_response_at,
+			resolved_at, created_at, updated_at, labels
 		FROM issues
 		%s
 		ORDER BY created_at DESC
@@ -203,56 +295,221 @@ func (r *IssueRepository) List(ctx context.Context, filter string, limit, offset
 	}
 	defer rows.Close()
 
-	// Parse results
-	var issues []*models.Issue
-	for rows.Next() {
-		var issue models.Issue
-		var assigneeID sql.NullString
-		var dueDate sql.NullTime
-		var labels []string
+	issues, err := scanIssues(rows)
+	if err != nil {
+		return nil, 0, err
+	}
 
-		err := rows.Scan(
-			&issue.ID,
-			&issue.Title,
-			&issue.Description,
-			&issue.ReproduceSteps,
-			&issue.ComponentID,
-			&issue.ReporterID,
-			&assigneeID,
-			&issue.Priority,
-			&issue.Severity,
-			&issue.Status,
-			&dueDate,
-			&issue.CreatedAt,
-			&issue.UpdatedAt,
-			pq.Array(&labels),
+	return issues, total, nil
+}
+
+// ListWithDeadlines retrieves open issues whose resolution or response
+// deadline is still running, oldest first
+func (r *IssueRepository) ListWithDeadlines(ctx context.Context, excludeStatuses []string, limit, offset int) ([]*models.Issue, error) {
+	query := `
+		SELECT
+			id, title, description, reproduce_steps, component_id, reporter_id, assignee_id,
+			priority, severity, status, due_date, response_due_date, first_response_at,
+			resolved_at, created_at, updated_at, labels
+		FROM issues
+		WHERE (due_date IS NOT NULL OR (response_due_date IS NOT NULL AND first_response_at IS NULL))
+			AND status <> ALL($1)
+		ORDER BY created_at ASC, id
+		LIMIT $2 OFFSET $3
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, pq.Array(excludeStatuses), limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return scanIssues(rows)
+}
+
+// GetSLAStats counts SLAs met and missed in one pass over the matching
+// issues, using grouping sets for the overall row and each breakdown.
+// A resolution SLA is met when the issue was resolved by its due date and
+// missed when resolved late or still open past it; response SLAs likewise
+// use the first response.
+func (r *IssueRepository) GetSLAStats(ctx context.Context, filter models.SLAStatsFilter) ([]*models.SLACounts, error) {
+	query := `
+		WITH scoped AS (
+			SELECT
+				i.priority, i.severity, i.component_id, c.team_id, i.assignee_id,
+				CASE
+					WHEN i.due_date IS NULL THEN NULL
+					WHEN i.resolved_at IS NOT NULL THEN i.resolved_at <= i.due_date
+					WHEN i.due_date < $5 AND i.status <> ALL($6) THEN FALSE
+				END AS resolution_met,
+				CASE
+					WHEN i.response_due_date IS NULL THEN NULL
+					WHEN i.first_response_at IS NOT NULL THEN i.first_response_at <= i.response_due_date
+					WHEN i.response_due_date < $5 THEN FALSE
+				END AS response_met
+			FROM issues i
+			LEFT JOIN components c ON c.id = i.component_id
+			WHERE i.created_at >= $1 AND i.created_at <= $2
+				AND ($3::uuid IS NULL OR i.component_id = $3)
+				AND ($4::uuid IS NULL OR c.team_id = $4)
 		)
-		if err != nil {
-			return nil, 0, err
-		}
+		SELECT
+			CASE
+				WHEN GROUPING(priority) = 0 THEN 'priority'
+				WHEN GROUPING(severity) = 0 THEN 'severity'
+				WHEN GROUPING(component_id) = 0 THEN 'component'
+				WHEN GROUPING(team_id) = 0 THEN 'team'
+				WHEN GROUPING(assignee_id) = 0 THEN 'assignee'
+				ELSE ''
+			END,
+			COALESCE(priority, severity, component_id::text, team_id::text, assignee_id::text, ''),
+			COUNT(*),
+			COUNT(*) FILTER (WHERE resolution_met),
+			COUNT(*) FILTER (WHERE NOT resolution_met),
+			COUNT(*) FILTER (WHERE response_met),
+			COUNT(*) FILTER (WHERE NOT response_met)
+		FROM scoped
+		GROUP BY GROUPING SETS ((), (priority), (severity), (component_id), (team_id), (assignee_id))
+	`
 
-		// Handle optional fields
-		if assigneeID.Valid {
-			id, err := uuid.Parse(assigneeID.String)
-			if err == nil {
-				issue.AssigneeID = &id
-			}
+	rows, err := r.db.QueryContext(
+		ctx,
+		query,
+		filter.CreatedFrom,
+		filter.CreatedTo,
+		filter.ComponentID,
+		filter.TeamID,
+		filter.Now,
+		pq.Array(filter.ResolvedStatuses),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var counts []*models.SLACounts
+	for rows.Next() {
+		var c models.SLACounts
+		if err := rows.Scan(
+			&c.Dimension,
+			&c.Key,
+			&c.Issues,
+			&c.Met,
+			&c.Missed,
+			&c.ResponseMet,
+			&c.ResponseMissed,
+		); err != nil {
+			return nil, err
 		}
+		counts = append(counts, &c)
+	}
 
-		if dueDate.Valid {
-			issue.DueDate = &dueDate.Time
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return counts, nil
+}
+
+// GetSLATrend buckets resolutions by when they happened and breaches by
+// when the deadline passed. Buckets come from generate_series so empty ones
+// are included; the SLA service sums them into rolling compliance.
+func (r *IssueRepository) GetSLATrend(ctx context.Context, filter models.SLATrendFilter) ([]*models.SLATrendBucket, error) {
+	query := `
+		WITH scoped AS (
+			SELECT i.created_at, i.resolved_at, i.due_date, i.status
+			FROM issues i
+			LEFT JOIN components c ON c.id = i.component_id
+			WHERE ($4::uuid IS NULL OR i.component_id = $4)
+				AND ($5::uuid IS NULL OR c.team_id = $5)
+				AND (cardinality($6::text[]) = 0 OR i.priority = ANY($6))
+				AND (cardinality($7::text[]) = 0 OR i.severity = ANY($7))
+		),
+		buckets AS (
+			SELECT generate_series(
+				date_trunc($3, $1::timestamptz),
+				$2::timestamptz,
+				('1 ' || $3)::interval
+			) AS bucket_start
+		),
+		resolutions AS (
+			SELECT
+				date_trunc($3, resolved_at) AS bucket_start,
+				COUNT(*) AS resolved,
+				COUNT(*) FILTER (WHERE resolved_at <= due_date) AS met,
+				COUNT(*) FILTER (WHERE resolved_at > due_date) AS missed,
+				percentile_cont(0.5) WITHIN GROUP (ORDER BY EXTRACT(EPOCH FROM resolved_at - created_at)) AS median_seconds
+			FROM scoped
+			WHERE resolved_at >= $1 AND resolved_at <= $2
+			GROUP BY 1
+		),
+		breaches AS (
+			SELECT date_trunc($3, due_date) AS bucket_start, COUNT(*) AS breached
+			FROM scoped
+			WHERE due_date >= $1 AND due_date <= LEAST($2::timestamptz, $8::timestamptz)
+				AND (resolved_at > due_date OR (resolved_at IS NULL AND status <> ALL($9)))
+			GROUP BY 1
+		)
+		SELECT
+			b.bucket_start,
+			COALESCE(r.resolved, 0),
+			COALESCE(r.met, 0),
+			COALESCE(r.missed, 0),
+			r.median_seconds,
+			COALESCE(br.breached, 0)
+		FROM buckets b
+		LEFT JOIN resolutions r ON r.bucket_start = b.bucket_start
+		LEFT JOIN breaches br ON br.bucket_start = b.bucket_start
+		ORDER BY b.bucket_start
+	`
+
+	rows, err := r.db.QueryContext(
+		ctx,
+		query,
+		filter.From,
+		filter.To,
+		string(filter.Interval),
+		filter.ComponentID,
+		filter.TeamID,
+		pq.Array(filter.Priorities),
+		pq.Array(filter.Severities),
+		filter.Now,
+		pq.Array(filter.ResolvedStatuses),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var buckets []*models.SLATrendBucket
+	for rows.Next() {
+		var b models.SLATrendBucket
+		var medianSeconds sql.NullFloat64
+		if err := rows.Scan(
+			&b.Start,
+			&b.Resolved,
+			&b.Met,
+			&b.Missed,
+			&medianSeconds,
+			&b.Breached,
+		); err != nil {
+			return nil, err
 		}
 
-		issue.Labels = labels
+		// Handle optional fields
+		if medianSeconds.Valid {
+			median := time.Duration(medianSeconds.Float64 * float64(time.Second))
+			b.MedianResolve = &median
+		}
 
-		issues = append(issues, &issue)
+		buckets = append(buckets, &b)
 	}
 
 	if err := rows.Err(); err != nil {
-		return nil, 0, err
+		return nil, err
 	}
 
-	return issues, total, nil
+	return buckets, nil
 }
 
 // GetComponentIssues retrieves issues for a specific component
@@ -341,6 +598,22 @@ func (r *IssueRepository) buildWhereClause(filter string) (string, []interface{}
 					conditions = append(conditions, "assignee_id IS NOT NULL")
 				} else if value == "none" || value == "unassigned" {
 					conditions = append(conditions, "assignee_id IS NULL")
+				} else if ids := strings.Split(value, ","); len(ids) > 1 {
+					// A comma-separated list matches any of the users
+					valid := true
+					for _, id := range ids {
+						if _, err := uuid.Parse(id); err != nil {
+							valid = false
+							break
+						}
+					}
+					if valid {
+						conditions = append(conditions, fmt.Sprintf("assignee_id = ANY($%d::uuid[])", argIndex))
+						args = append(args, pq.Array(ids))
+						argIndex++
+					} else {
+						conditions = append(conditions, "1 = 0") // Always false
+					}
 				} else {
 					// Try to parse as UUID
 					_, err := uuid.Parse(value)
@@ -365,10 +638,26 @@ func (r *IssueRepository) buildWhereClause(filter string) (string, []interface{}
 					// If not UUID, would join with users table to search by name or email
 					conditions = append(conditions, "1 = 0") // Always false
 				}
+			case "cc":
+				// Issues the user is watching; "cc:me" is resolved to a user ID at the service level
+				_, err := uuid.Parse(value)
+				if err == nil {
+					conditions = append(conditions, fmt.Sprintf("id IN (SELECT issue_id FROM issue_watchers WHERE user_id = $%d)", argIndex))
+					args = append(args, value)
+					argIndex++
+				} else {
+					conditions = append(conditions, "1 = 0") // Always false
+				}
 			case "team":
-				// This requires joining with components and teams tables
-				// For simplicity, we'll just return no results for team filters
-				conditions = append(conditions, "1 = 0") // Always false
+				// Issues in the components the team owns
+				_, err := uuid.Parse(value)
+				if err == nil {
+					conditions = append(conditions, fmt.Sprintf("component_id IN (SELECT id FROM components WHERE team_id = $%d)", argIndex))
+					args = append(args, value)
+					argIndex++
+				} else {
+					conditions = append(conditions, "1 = 0") // Always false
+				}
 			case "label":
 				conditions = append(conditions, fmt.Sprintf("$%d = ANY(labels)", argIndex))
 				args = append(args, value)
@@ -421,5 +710,73 @@ func (r *IssueRepository) buildWhereClause(filter string) (string, []interface{}
 	return "", []interface{}{}
 }
 
+// scanIssues scans the rows of a query selecting the columns used by List
+func scanIssues(rows *sql.Rows) ([]*models.Issue, error) {
+	var issues []*models.Issue
+	for rows.Next() {
+		var issue models.Issue
+		var assigneeID sql.NullString
+		var dueDate, responseDueDate, firstResponseAt, resolvedAt sql.NullTime
+		var labels []string
+
+		err := rows.Scan(
+			&issue.ID,
+			&issue.Title,
+			&issue.Description,
+			&issue.ReproduceSteps,
+			&issue.ComponentID,
+			&issue.ReporterID,
+			&assigneeID,
+			&issue.Priority,
+			&issue.Severity,
+			&issue.Status,
+			&dueDate,
+			&responseDueDate,
+			&firstResponseAt,
+			&resolvedAt,
+			&issue.CreatedAt,
+			&issue.UpdatedAt,
+			pq.Array(&labels),
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		// Handle optional fields
+		if assigneeID.Valid {
+			id, err := uuid.Parse(assigneeID.String)
+			if err == nil {
+				issue.AssigneeID = &id
+			}
+		}
+
+		if dueDate.Valid {
+			issue.DueDate = &dueDate.Time
+		}
+
+		if responseDueDate.Valid {
+			issue.ResponseDueDate = &responseDueDate.Time
+		}
+
+		if firstResponseAt.Valid {
+			issue.FirstResponseAt = &firstResponseAt.Time
+		}
+
+		if resolvedAt.Valid {
+			issue.ResolvedAt = &resolvedAt.Time
+		}
+
+		issue.Labels = labels
+
+		issues = append(issues, &issue)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return issues, nil
+}
+
 // Ensure IssueRepository implements repositories.IssueRepository
 var _ repositories.IssueRepository = (*IssueRepository)(nil)
@@ -0,0 +1,34 @@
+// repositories/postgres/issue_repository_test.go
+package postgres
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/matthewmc1/buganizer/models"
+)
+
+func TestBuildWhereClause(t *testing.T) {
+	r := &IssueRepository{}
+	teamID := "33333333-3333-3333-3333-333333333333"
+
+	tests := []struct {
+		filter string
+		where  string
+		args   []interface{}
+	}{
+		{"", "", []interface{}{}},
+		{
+			"team:" + teamID + " is:open",
+			"WHERE component_id IN (SELECT id FROM components WHERE team_id = $1) AND status != $2",
+			[]interface{}{teamID, string(models.StatusClosed)},
+		},
+		{"team:platform", "WHERE 1 = 0", nil},
+	}
+	for _, tt := range tests {
+		where, args := r.buildWhereClause(tt.filter)
+		if where != tt.where || !reflect.DeepEqual(args, tt.args) {
+			t.Errorf("buildWhereClause(%q) = %q %v, want %q %v", tt.filter, where, args, tt.where, tt.args)
+		}
+	}
+}
@@ -4,6 +4,7 @@ package postgres
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 
 	"github.com/google/uuid"
 	"github.com/lib/pq"
@@ -26,26 +27,43 @@ func NewNotificationPreferenceRepository(db *sql.DB) *NotificationPreferenceRepo
 
 // Upsert creates or updates notification preferences for a user
 func (r *NotificationPreferenceRepository) Upsert(ctx context.Context, pref *models.NotificationPreference) error {
+	digestFrequencies, err := json.Marshal(pref.DigestFrequencies)
+	if err != nil {
+		return err
+	}
+
 	query := `
 		INSERT INTO notification_preferences (
-			user_id, email_notifications, slack_notifications, subscribed_events, updated_at
+			user_id, email_notifications, slack_notifications, subscribed_events,
+			digest_frequencies, time_zone, quiet_hours_start, quiet_hours_end,
+			daily_digest_time, updated_at
 		) VALUES (
-			$1, $2, $3, $4, $5
+			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10
 		)
 		ON CONFLICT (user_id) DO UPDATE SET
 			email_notifications = $2,
 			slack_notifications = $3,
 			subscribed_events = $4,
-			updated_at = $5
+			digest_frequencies = $5,
+			time_zone = $6,
+			quiet_hours_start = $7,
+			quiet_hours_end = $8,
+			daily_digest_time = $9,
+			updated_at = $10
 	`
 
-	_, err := r.db.ExecContext(
+	_, err = r.db.ExecContext(
 		ctx,
 		query,
 		pref.UserID,
 		pref.EmailNotifications,
 		pref.SlackNotifications,
 		pq.Array(pref.SubscribedEvents),
+		string(digestFrequencies),
+		pref.TimeZone,
+		pref.QuietHoursStart,
+		pref.QuietHoursEnd,
+		pref.DailyDigestTime,
 		pref.UpdatedAt,
 	)
 
@@ -56,13 +74,16 @@ func (r *NotificationPreferenceRepository) Upsert(ctx context.Context, pref *mod
 func (r *NotificationPreferenceRepository) GetByUserID(ctx context.Context, userID uuid.UUID) (*models.NotificationPreference, error) {
 	query := `
 		SELECT
-			user_id, email_notifications, slack_notifications, subscribed_events, updated_at
+			user_id, email_notifications, slack_notifications, subscribed_events,
+			digest_frequencies, time_zone, quiet_hours_start, quiet_hours_end,
+			daily_digest_time, updated_at
 		FROM notification_preferences
 		WHERE user_id = $1
 	`
 
 	var pref models.NotificationPreference
 	var subscribedEvents []string
+	var digestFrequencies []byte
 
 	err := r.db.QueryRowContext(
 		ctx,
@@ -73,6 +94,11 @@ func (r *NotificationPreferenceRepository) GetByUserID(ctx context.Context, user
 		&pref.EmailNotifications,
 		&pref.SlackNotifications,
 		pq.Array(&subscribedEvents),
+		&digestFrequencies,
+		&pref.TimeZone,
+		&pref.QuietHoursStart,
+		&pref.QuietHoursEnd,
+		&pref.DailyDigestTime,
 		&pref.UpdatedAt,
 	)
 
@@ -81,6 +107,9 @@ func (r *NotificationPreferenceRepository) GetByUserID(ctx context.Context, user
 	}
 
 	pref.SubscribedEvents = subscribedEvents
+	if err := json.Unmarshal(digestFrequencies, &pref.DigestFrequencies); err != nil {
+		return nil, err
+	}
 
 	return &pref, nil
 }
@@ -0,0 +1,208 @@
+// repositories/postgres/notification_repository.go
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/matthewmc1/buganizer/models"
+	"github.com/matthewmc1/buganizer/repositories"
+)
+
+// notificationColumns lists the columns read by every notification query
+const notificationColumns = `
+			id, user_id, type, issue_id, title, message, actor_id, read_at, created_at`
+
+// NotificationRepository implements the NotificationRepository interface for PostgreSQL
+type NotificationRepository struct {
+	db *sql.DB
+}
+
+// NewNotificationRepository creates a new PostgreSQL notification repository
+func NewNotificationRepository(db *sql.DB) *NotificationRepository {
+	return &NotificationRepository{
+		db: db,
+	}
+}
+
+// Create adds a notification to a user's inbox. Adding one that already
+// exists does nothing, so redelivering it from the outbox is harmless.
+func (r *NotificationRepository) Create(ctx context.Context, notification *models.Notification) error {
+	query := `
+		INSERT INTO notifications (
+			id, user_id, type, issue_id, title, message, actor_id, read_at, created_at
+		) VALUES (
+			$1, $2, $3, $4, $5, $6, $7, $8, $9
+		)
+		ON CONFLICT (id) DO NOTHING
+	`
+
+	_, err := r.db.ExecContext(
+		ctx,
+		query,
+		notification.ID,
+		notification.UserID,
+		notification.Type,
+		notification.IssueID,
+		notification.Title,
+		notification.Message,
+		notification.ActorID,
+		notification.ReadAt,
+		notification.CreatedAt,
+	)
+
+	return err
+}
+
+// GetByID retrieves a notification by its ID
+func (r *NotificationRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.Notification, error) {
+	query := `SELECT ` + notificationColumns + ` FROM notifications WHERE id = $1`
+
+	return scanNotification(r.db.QueryRowContext(ctx, query, id))
+}
+
+// ListByUser lists a user's notifications, newest first, with the total count
+func (r *NotificationRepository) ListByUser(ctx context.Context, userID uuid.UUID, unreadOnly bool, limit, offset int) ([]*models.Notification, int, error) {
+	where := `WHERE user_id = $1`
+	if unreadOnly {
+		where += ` AND read_at IS NULL`
+	}
+
+	var total int
+	err := r.db.QueryRowContext(ctx, `SELECT COUNT(*) FROM notifications `+where, userID).Scan(&total)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	query := `
+		SELECT ` + notificationColumns + `
+		FROM notifications
+		` + where + `
+		ORDER BY created_at DESC, id
+		LIMIT $2 OFFSET $3
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, userID, limit, offset)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	var notifications []*models.Notification
+	for rows.Next() {
+		notification, err := scanNotification(rows)
+		if err != nil {
+			return nil, 0, err
+		}
+		notifications = append(notifications, notification)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, 0, err
+	}
+
+	return notifications, total, nil
+}
+
+// CountUnread counts a user's unread notifications
+func (r *NotificationRepository) CountUnread(ctx context.Context, userID uuid.UUID) (int, error) {
+	query := `SELECT COUNT(*) FROM notifications WHERE user_id = $1 AND read_at IS NULL`
+
+	var count int
+	err := r.db.QueryRowContext(ctx, query, userID).Scan(&count)
+	return count, err
+}
+
+// MarkRead marks a notification read, keeping the time it was first read
+func (r *NotificationRepository) MarkRead(ctx context.Context, id uuid.UUID, at time.Time) error {
+	query := `UPDATE notifications SET read_at = $1 WHERE id = $2 AND read_at IS NULL`
+
+	_, err := r.db.ExecContext(ctx, query, at, id)
+	return err
+}
+
+// MarkAllRead marks all of a user's notifications read and returns how many
+// were unread
+func (r *NotificationRepository) MarkAllRead(ctx context.Context, userID uuid.UUID, at time.Time) (int, error) {
+	query := `UPDATE notifications SET read_at = $1 WHERE user_id = $2 AND read_at IS NULL`
+
+	result, err := r.db.ExecContext(ctx, query, at, userID)
+	if err != nil {
+		return 0, err
+	}
+
+	affected, err := result.RowsAffected()
+	return int(affected), err
+}
+
+// DeleteOlderThan deletes up to limit notifications created before a time
+// and returns how many were deleted. Deleting in batches keeps each
+// statement short.
+func (r *NotificationRepository) DeleteOlderThan(ctx context.Context, before time.Time, limit int) (int, error) {
+	query := `
+		DELETE FROM notifications
+		WHERE id IN (
+			SELECT id FROM notifications
+			WHERE created_at < $1
+			LIMIT $2
+		)
+	`
+
+	result, err := r.db.ExecContext(ctx, query, before, limit)
+	if err != nil {
+		return 0, err
+	}
+
+	affected, err := result.RowsAffected()
+	return int(affected), err
+}
+
+// scanNotification scans a row selected with notificationColumns
+func scanNotification(row interface {
+	Scan(dest ...interface{}) error
+}) (*models.Notification, error) {
+	var notification models.Notification
+	var issueID, actorID sql.NullString
+	var readAt sql.NullTime
+
+	err := row.Scan(
+		&notification.ID,
+		&notification.UserID,
+		&notification.Type,
+		&issueID,
+		&notification.Title,
+		&notification.Message,
+		&actorID,
+		&readAt,
+		&notification.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Handle optional fields
+	if issueID.Valid {
+		id, err := uuid.Parse(issueID.String)
+		if err == nil {
+			notification.IssueID = &id
+		}
+	}
+
+	if actorID.Valid {
+		id, err := uuid.Parse(actorID.String)
+		if err == nil {
+			notification.ActorID = &id
+		}
+	}
+
+	if readAt.Valid {
+		notification.ReadAt = &readAt.Time
+	}
+
+	return &notification, nil
+}
+
+var _ repositories.NotificationRepository = (*NotificationRepository)(nil)
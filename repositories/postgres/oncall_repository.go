@@ -0,0 +1,421 @@
+// repositories/postgres/oncall_repository.go
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/matthewmc1/buganizer/models"
+	"github.com/matthewmc1/buganizer/repositories"
+)
+
+// rotationColumns lists the columns read by every rotation query
+const rotationColumns = `
+			id, organization_id, team_id, name, shift_length_days, handoff_time,
+			time_zone, start_date, created_at, updated_at, created_by_id`
+
+// overrideColumns lists the columns read by every override query
+const overrideColumns = `
+			id, rotation_id, user_id, start_at, end_at, reason, created_at, created_by_id`
+
+// OnCallRepository implements the OnCallRepository interface for PostgreSQL
+type OnCallRepository struct {
+	db *sql.DB
+}
+
+// NewOnCallRepository creates a new PostgreSQL on-call repository
+func NewOnCallRepository(db *sql.DB) *OnCallRepository {
+	return &OnCallRepository{
+		db: db,
+	}
+}
+
+// CreateRotation adds a new rotation and its members to the database
+func (r *OnCallRepository) CreateRotation(ctx context.Context, rotation *models.OnCallRotation) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	query := `
+		INSERT INTO oncall_rotations (
+			id, organization_id, team_id, name, shift_length_days, handoff_time,
+			time_zone, start_date, created_at, updated_at, created_by_id
+		) VALUES (
+			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11
+		)
+	`
+
+	_, err = tx.ExecContext(
+		ctx,
+		query,
+		rotation.ID,
+		rotation.OrganizationID,
+		rotation.TeamID,
+		rotation.Name,
+		rotation.ShiftLengthDays,
+		rotation.HandoffTime,
+		rotation.TimeZone,
+		rotation.StartDate.Format("2006-01-02"),
+		rotation.CreatedAt,
+		rotation.UpdatedAt,
+		rotation.CreatedByID,
+	)
+	if err != nil {
+		return err
+	}
+
+	if err := insertRotationMembers(ctx, tx, rotation.ID, rotation.MemberIDs); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// GetRotation retrieves a rotation and its members by its ID
+func (r *OnCallRepository) GetRotation(ctx context.Context, id uuid.UUID) (*models.OnCallRotation, error) {
+	query := `SELECT ` + rotationColumns + ` FROM oncall_rotations WHERE id = $1`
+	return r.getRotation(ctx, query, id)
+}
+
+// GetRotationByTeam retrieves the rotation of a team
+func (r *OnCallRepository) GetRotationByTeam(ctx context.Context, teamID uuid.UUID) (*models.OnCallRotation, error) {
+	query := `SELECT ` + rotationColumns + ` FROM oncall_rotations WHERE team_id = $1`
+	return r.getRotation(ctx, query, teamID)
+}
+
+// ListRotations retrieves the rotations of an organization
+func (r *OnCallRepository) ListRotations(ctx context.Context, organizationID uuid.UUID) ([]*models.OnCallRotation, error) {
+	query := `
+		SELECT ` + rotationColumns + `
+		FROM oncall_rotations
+		WHERE organization_id = $1
+		ORDER BY name
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, organizationID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var rotations []*models.OnCallRotation
+	for rows.Next() {
+		rotation, err := scanRotation(rows)
+		if err != nil {
+			return nil, err
+		}
+		rotations = append(rotations, rotation)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	for _, rotation := range rotations {
+		if rotation.MemberIDs, err = r.getMembers(ctx, rotation.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	return rotations, nil
+}
+
+// UpdateRotation updates an existing rotation, replacing its members
+func (r *OnCallRepository) UpdateRotation(ctx context.Context, rotation *models.OnCallRotation) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	query := `
+		UPDATE oncall_rotations
+		SET
+			name = $1,
+			shift_length_days = $2,
+			handoff_time = $3,
+			time_zone = $4,
+			start_date = $5,
+			updated_at = $6
+		WHERE id = $7
+	`
+
+	_, err = tx.ExecContext(
+		ctx,
+		query,
+		rotation.Name,
+		rotation.ShiftLengthDays,
+		rotation.HandoffTime,
+		rotation.TimeZone,
+		rotation.StartDate.Format("2006-01-02"),
+		rotation.UpdatedAt,
+		rotation.ID,
+	)
+	if err != nil {
+		return err
+	}
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM oncall_rotation_members WHERE rotation_id = $1`, rotation.ID); err != nil {
+		return err
+	}
+
+	if err := insertRotationMembers(ctx, tx, rotation.ID, rotation.MemberIDs); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// DeleteRotation removes a rotation; its members and overrides cascade
+func (r *OnCallRepository) DeleteRotation(ctx context.Context, id uuid.UUID) error {
+	query := `DELETE FROM oncall_rotations WHERE id = $1`
+	_, err := r.db.ExecContext(ctx, query, id)
+	return err
+}
+
+// CreateOverride adds a new override to a rotation
+func (r *OnCallRepository) CreateOverride(ctx context.Context, override *models.OnCallOverride) error {
+	query := `
+		INSERT INTO oncall_overrides (
+			id, rotation_id, user_id, start_at, end_at, reason, created_at, created_by_id
+		) VALUES (
+			$1, $2, $3, $4, $5, $6, $7, $8
+		)
+	`
+
+	_, err := r.db.ExecContext(
+		ctx,
+		query,
+		override.ID,
+		override.RotationID,
+		override.UserID,
+		override.StartAt,
+		override.EndAt,
+		override.Reason,
+		override.CreatedAt,
+		override.CreatedByID,
+	)
+
+	return err
+}
+
+// GetOverride retrieves an override by its ID
+func (r *OnCallRepository) GetOverride(ctx context.Context, id uuid.UUID) (*models.OnCallOverride, error) {
+	query := `SELECT ` + overrideColumns + ` FROM oncall_overrides WHERE id = $1`
+	return scanOverride(r.db.QueryRowContext(ctx, query, id))
+}
+
+// DeleteOverride removes an override
+func (r *OnCallRepository) DeleteOverride(ctx context.Context, id uuid.UUID) error {
+	query := `DELETE FROM oncall_overrides WHERE id = $1`
+	_, err := r.db.ExecContext(ctx, query, id)
+	return err
+}
+
+// ListOverrides retrieves the overrides of a rotation overlapping [from, to),
+// oldest first so later overrides win
+func (r *OnCallRepository) ListOverrides(ctx context.Context, rotationID uuid.UUID, from, to time.Time) ([]*models.OnCallOverride, error) {
+	query := `
+		SELECT ` + overrideColumns + `
+		FROM oncall_overrides
+		WHERE rotation_id = $1 AND start_at < $3 AND end_at > $2
+		ORDER BY created_at, id
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, rotationID, from, to)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var overrides []*models.OnCallOverride
+	for rows.Next() {
+		override, err := scanOverride(rows)
+		if err != nil {
+			return nil, err
+		}
+		overrides = append(overrides, override)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return overrides, nil
+}
+
+// GetTeamOnCall gets the user set by hand as on call for a team
+func (r *OnCallRepository) GetTeamOnCall(ctx context.Context, teamID uuid.UUID) (uuid.UUID, error) {
+	query := `SELECT on_call_id FROM teams WHERE id = $1 AND on_call_id IS NOT NULL`
+
+	var userID uuid.UUID
+	err := r.db.QueryRowContext(ctx, query, teamID).Scan(&userID)
+	return userID, err
+}
+
+// ListTeamOnCall gets the users set by hand as on call for the teams of an
+// organization, by team
+func (r *OnCallRepository) ListTeamOnCall(ctx context.Context, organizationID uuid.UUID) (map[uuid.UUID]uuid.UUID, error) {
+	query := `SELECT id, on_call_id FROM teams WHERE organization_id = $1 AND on_call_id IS NOT NULL`
+
+	rows, err := r.db.QueryContext(ctx, query, organizationID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	onCall := make(map[uuid.UUID]uuid.UUID)
+	for rows.Next() {
+		var teamID, userID uuid.UUID
+		if err := rows.Scan(&teamID, &userID); err != nil {
+			return nil, err
+		}
+		onCall[teamID] = userID
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return onCall, nil
+}
+
+// getRotation runs a query selecting rotationColumns and loads the members of
+// the rotation found
+func (r *OnCallRepository) getRotation(ctx context.Context, query string, args ...interface{}) (*models.OnCallRotation, error) {
+	rotation, err := scanRotation(r.db.QueryRowContext(ctx, query, args...))
+	if err != nil {
+		return nil, err
+	}
+
+	rotation.MemberIDs, err = r.getMembers(ctx, rotation.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return rotation, nil
+}
+
+// getMembers retrieves the members of a rotation in turn order
+func (r *OnCallRepository) getMembers(ctx context.Context, rotationID uuid.UUID) ([]uuid.UUID, error) {
+	query := `
+		SELECT user_id
+		FROM oncall_rotation_members
+		WHERE rotation_id = $1
+		ORDER BY position
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, rotationID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var members []uuid.UUID
+	for rows.Next() {
+		var userID uuid.UUID
+		if err := rows.Scan(&userID); err != nil {
+			return nil, err
+		}
+		members = append(members, userID)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return members, nil
+}
+
+// insertRotationMembers inserts the members of a rotation within a transaction
+func insertRotationMembers(ctx context.Context, tx *sql.Tx, rotationID uuid.UUID, memberIDs []uuid.UUID) error {
+	query := `
+		INSERT INTO oncall_rotation_members (rotation_id, position, user_id)
+		VALUES ($1, $2, $3)
+	`
+
+	for i, userID := range memberIDs {
+		if _, err := tx.ExecContext(ctx, query, rotationID, i, userID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// scanRotation scans a row selected with rotationColumns
+func scanRotation(row interface {
+	Scan(dest ...interface{}) error
+}) (*models.OnCallRotation, error) {
+	var rotation models.OnCallRotation
+	var createdByID sql.NullString
+
+	err := row.Scan(
+		&rotation.ID,
+		&rotation.OrganizationID,
+		&rotation.TeamID,
+		&rotation.Name,
+		&rotation.ShiftLengthDays,
+		&rotation.HandoffTime,
+		&rotation.TimeZone,
+		&rotation.StartDate,
+		&rotation.CreatedAt,
+		&rotation.UpdatedAt,
+		&createdByID,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Handle optional fields
+	if createdByID.Valid {
+		id, err := uuid.Parse(createdByID.String)
+		if err == nil {
+			rotation.CreatedByID = &id
+		}
+	}
+
+	rotation.StartDate = rotation.StartDate.UTC()
+
+	return &rotation, nil
+}
+
+// scanOverride scans a row selected with overrideColumns
+func scanOverride(row interface {
+	Scan(dest ...interface{}) error
+}) (*models.OnCallOverride, error) {
+	var override models.OnCallOverride
+	var createdByID sql.NullString
+
+	err := row.Scan(
+		&override.ID,
+		&override.RotationID,
+		&override.UserID,
+		&override.StartAt,
+		&override.EndAt,
+		&override.Reason,
+		&override.CreatedAt,
+		&createdByID,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Handle optional fields
+	if createdByID.Valid {
+		id, err := uuid.Parse(createdByID.String)
+		if err == nil {
+			override.CreatedByID = &id
+		}
+	}
+
+	return &override, nil
+}
+
+// Ensure OnCallRepository implements repositories.OnCallRepository
+var _ repositories.OnCallRepository = (*OnCallRepository)(nil)
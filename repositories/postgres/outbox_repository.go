@@ -0,0 +1,375 @@
+// repositories/postgres/outbox_repository.go
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+
+	"github.com/matthewmc1/buganizer/models"
+	"github.com/matthewmc1/buganizer/repositories"
+)
+
+// outboxColumns lists the columns read by every outbox query
+const outboxColumns = `
+			id, kind, issue_id, webhook_id, user_id, payload, status, attempts,
+			next_attempt_at, locked_until, last_error, created_at, delivered_at`
+
+// OutboxRepository implements the OutboxRepository interface for PostgreSQL
+type OutboxRepository struct {
+	db *sql.DB
+}
+
+// NewOutboxRepository creates a new PostgreSQL outbox repository
+func NewOutboxRepository(db *sql.DB) *OutboxRepository {
+	return &OutboxRepository{
+		db: db,
+	}
+}
+
+// Enqueue adds messages to the outbox
+func (r *OutboxRepository) Enqueue(ctx context.Context, messages []*models.OutboxMessage) error {
+	if len(messages) == 0 {
+		return nil
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := insertOutboxMessages(ctx, tx, messages); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// ClaimDue leases up to limit pending messages whose time has come, so no
+// other worker delivers them until the lease expires
+func (r *OutboxRepository) ClaimDue(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]*models.OutboxMessage, error) {
+	query := `
+		UPDATE notification_outbox
+		SET
+			attempts = attempts + 1,
+			locked_until = $1
+		WHERE id IN (
+			SELECT id FROM notification_outbox
+			WHERE status = 'PENDING'
+				AND next_attempt_at <= $2
+				AND (locked_until IS NULL OR locked_until < $2)
+			ORDER BY next_attempt_at
+			LIMIT $3
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING ` + outboxColumns
+
+	rows, err := r.db.QueryContext(ctx, query, now.Add(lease), now, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return scanOutboxMessages(rows)
+}
+
+// MarkDelivered records that a message was delivered
+func (r *OutboxRepository) MarkDelivered(ctx context.Context, id uuid.UUID, at time.Time) error {
+	query := `
+		UPDATE notification_outbox
+		SET status = 'DELIVERED', delivered_at = $1, locked_until = NULL
+		WHERE id = $2 AND status = 'PENDING'
+	`
+	_, err := r.db.ExecContext(ctx, query, at, id)
+	return err
+}
+
+// Expand replaces a message with the messages it fans out into. Nothing is
+// added when the message is no longer pending, e.g. because another worker
+// expanded it after its lease expired.
+func (r *OutboxRepository) Expand(ctx context.Context, id uuid.UUID, messages []*models.OutboxMessage, at time.Time) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	query := `
+		UPDATE notification_outbox
+		SET status = 'DELIVERED', delivered_at = $1, locked_until = NULL
+		WHERE id = $2 AND status = 'PENDING'
+	`
+	result, err := tx.ExecContext(ctx, query, at, id)
+	if err != nil {
+		return err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return nil
+	}
+
+	if err := insertOutboxMessages(ctx, tx, messages); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// ListDigest lists the pending DIGEST messages of a user that are due, oldest
+// first, whether or not another worker holds their lease
+func (r *OutboxRepository) ListDigest(ctx context.Context, userID uuid.UUID, now time.Time) ([]*models.OutboxMessage, error) {
+	query := `
+		SELECT ` + outboxColumns + `
+		FROM notification_outbox
+		WHERE kind = 'DIGEST'
+			AND user_id = $1
+			AND status = 'PENDING'
+			AND next_attempt_at <= $2
+		ORDER BY created_at
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, userID, now)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return scanOutboxMessages(rows)
+}
+
+// CompleteDigest replaces DIGEST messages with the messages sending their
+// digest. Nothing is added unless all of them are still pending, so two
+// workers sending the same digest at once send it once; the messages the
+// other worker did not include are sent with the next digest.
+func (r *OutboxRepository) CompleteDigest(ctx context.Context, ids []uuid.UUID, messages []*models.OutboxMessage, at time.Time) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	idStrings := make([]string, len(ids))
+	for i, id := range ids {
+		idStrings[i] = id.String()
+	}
+
+	query := `
+		UPDATE notification_outbox
+		SET status = 'DELIVERED', delivered_at = $1, locked_until = NULL
+		WHERE id = ANY($2::uuid[]) AND status = 'PENDING'
+	`
+	result, err := tx.ExecContext(ctx, query, at, pq.Array(idStrings))
+	if err != nil {
+		return err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected != int64(len(ids)) {
+		return nil
+	}
+
+	if err := insertOutboxMessages(ctx, tx, messages); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// Retry reschedules a message that failed
+func (r *OutboxRepository) Retry(ctx context.Context, id uuid.UUID, nextAttemptAt time.Time, lastError string) error {
+	query := `
+		UPDATE notification_outbox
+		SET next_attempt_at = $1, last_error = $2, locked_until = NULL
+		WHERE id = $3 AND status = 'PENDING'
+	`
+	_, err := r.db.ExecContext(ctx, query, nextAttemptAt, lastError, id)
+	return err
+}
+
+// MarkDead gives up on a message
+func (r *OutboxRepository) MarkDead(ctx context.Context, id uuid.UUID, lastError string) error {
+	query := `
+		UPDATE notification_outbox
+		SET status = 'DEAD', last_error = $1, locked_until = NULL
+		WHERE id = $2 AND status = 'PENDING'
+	`
+	_, err := r.db.ExecContext(ctx, query, lastError, id)
+	return err
+}
+
+// GetStats summarizes the queue
+func (r *OutboxRepository) GetStats(ctx context.Context) (*models.OutboxStats, error) {
+	query := `
+		SELECT
+			COUNT(*) FILTER (WHERE status = 'PENDING'),
+			COUNT(*) FILTER (WHERE status = 'DEAD'),
+			MIN(created_at) FILTER (WHERE status = 'PENDING' AND kind <> 'DIGEST')
+		FROM notification_outbox
+		WHERE status IN ('PENDING', 'DEAD')
+	`
+
+	// DIGEST messages wait for their digest on purpose, so they do not count
+	// as late
+	var stats models.OutboxStats
+	var oldestPending sql.NullTime
+	err := r.db.QueryRowContext(ctx, query).Scan(&stats.Pending, &stats.Dead, &oldestPending)
+	if err != nil {
+		return nil, err
+	}
+
+	// Handle optional fields
+	if oldestPending.Valid {
+		stats.OldestPending = &oldestPending.Time
+	}
+
+	return &stats, nil
+}
+
+// execer runs statements on a database or within a transaction
+type execer interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+}
+
+// withOutbox runs write and adds messages to the outbox in one transaction
+func withOutbox(ctx context.Context, db *sql.DB, messages []*models.OutboxMessage, write func(tx *sql.Tx) error) error {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := write(tx); err != nil {
+		return err
+	}
+
+	if err := insertOutboxMessages(ctx, tx, messages); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// insertOutboxMessages adds messages to the outbox within a transaction, so
+// callers can write them together with the change they describe
+func insertOutboxMessages(ctx context.Context, tx *sql.Tx, messages []*models.OutboxMessage) error {
+	if len(messages) == 0 {
+		return nil
+	}
+
+	stmt, err := tx.PrepareContext(ctx, `
+		INSERT INTO notification_outbox (
+			id, kind, issue_id, webhook_id, user_id, payload, status, attempts,
+			next_attempt_at, last_error, created_at
+		) VALUES (
+			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11
+		)
+	`)
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+
+	for _, message := range messages {
+		_, err := stmt.ExecContext(
+			ctx,
+			message.ID,
+			message.Kind,
+			message.IssueID,
+			message.WebhookID,
+			message.UserID,
+			string(message.Payload),
+			message.Status,
+			message.Attempts,
+			message.NextAttemptAt,
+			message.LastError,
+			message.CreatedAt,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// scanOutboxMessages scans rows selected with outboxColumns
+func scanOutboxMessages(rows *sql.Rows) ([]*models.OutboxMessage, error) {
+	var messages []*models.OutboxMessage
+	for rows.Next() {
+		var message models.OutboxMessage
+		var issueID, webhookID, userID sql.NullString
+		var lockedUntil, deliveredAt sql.NullTime
+
+		err := rows.Scan(
+			&message.ID,
+			&message.Kind,
+			&issueID,
+			&webhookID,
+			&userID,
+			&message.Payload,
+			&message.Status,
+			&message.Attempts,
+			&message.NextAttemptAt,
+			&lockedUntil,
+			&message.LastError,
+			&message.CreatedAt,
+			&deliveredAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		// Handle optional fields
+		if issueID.Valid {
+			id, err := uuid.Parse(issueID.String)
+			if err == nil {
+				message.IssueID = &id
+			}
+		}
+
+		if webhookID.Valid {
+			id, err := uuid.Parse(webhookID.String)
+			if err == nil {
+				message.WebhookID = &id
+			}
+		}
+
+		if userID.Valid {
+			id, err := uuid.Parse(userID.String)
+			if err == nil {
+				message.UserID = &id
+			}
+		}
+
+		if lockedUntil.Valid {
+			message.LockedUntil = &lockedUntil.Time
+		}
+
+		if deliveredAt.Valid {
+			message.DeliveredAt = &deliveredAt.Time
+		}
+
+		messages = append(messages, &message)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return messages, nil
+}
+
+var _ repositories.OutboxRepository = (*OutboxRepository)(nil)
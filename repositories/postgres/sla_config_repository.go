@@ -0,0 +1,263 @@
+// repositories/postgres/sla_config_repository.go
+package postgres
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/google/uuid"
+
+	"github.com/matthewmc1/buganizer/models"
+	"github.com/matthewmc1/buganizer/repositories"
+)
+
+// slaConfigColumns lists the columns read by every SLA policy query
+const slaConfigColumns = `
+			id, organization_id, component_id,
+			critical_response_time, high_response_time, medium_response_time,
+			low_response_time, trivial_response_time,
+			critical_resolution_time, high_resolution_time, medium_resolution_time,
+			low_resolution_time, trivial_resolution_time,
+			s0_factor, s1_factor, s2_factor, s3_factor, calendar_id, recalculate_from,
+			created_at, updated_at, created_by_id`
+
+// SLAConfigRepository implements the SLAConfigRepository interface for PostgreSQL
+type SLAConfigRepository struct {
+	db *sql.DB
+}
+
+// NewSLAConfigRepository creates a new PostgreSQL SLA policy repository
+func NewSLAConfigRepository(db *sql.DB) *SLAConfigRepository {
+	return &SLAConfigRepository{
+		db: db,
+	}
+}
+
+// Create adds a new SLA policy to the database
+func (r *SLAConfigRepository) Create(ctx context.Context, config *models.SLAConfig) error {
+	query := `
+		INSERT INTO sla_configs (` + slaConfigColumns + `
+		) VALUES (
+			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22
+		)
+	`
+
+	_, err := r.db.ExecContext(
+		ctx,
+		query,
+		config.ID,
+		config.OrganizationID,
+		config.ComponentID,
+		config.CriticalResponseTime,
+		config.HighResponseTime,
+		config.MediumResponseTime,
+		config.LowResponseTime,
+		config.TrivialResponseTime,
+		config.CriticalResolutionTime,
+		config.HighResolutionTime,
+		config.MediumResolutionTime,
+		config.LowResolutionTime,
+		config.TrivialResolutionTime,
+		config.S0Factor,
+		config.S1Factor,
+		config.S2Factor,
+		config.S3Factor,
+		config.CalendarID,
+		config.RecalculateFrom,
+		config.CreatedAt,
+		config.UpdatedAt,
+		config.CreatedByID,
+	)
+
+	return err
+}
+
+// GetByID retrieves an SLA policy by its ID
+func (r *SLAConfigRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.SLAConfig, error) {
+	query := `SELECT ` + slaConfigColumns + ` FROM sla_configs WHERE id = $1`
+	return scanSLAConfig(r.db.QueryRowContext(ctx, query, id))
+}
+
+// GetByComponent retrieves the policy a component's organization configured
+// for it
+func (r *SLAConfigRepository) GetByComponent(ctx context.Context, componentID uuid.UUID) (*models.SLAConfig, error) {
+	query := `
+		SELECT ` + slaConfigColumns + `
+		FROM sla_configs
+		WHERE organization_id = (SELECT organization_id FROM components WHERE id = $1)
+			AND component_id = $1
+	`
+	return scanSLAConfig(r.db.QueryRowContext(ctx, query, componentID))
+}
+
+// GetOrganizationDefault retrieves the default policy of an organization
+func (r *SLAConfigRepository) GetOrganizationDefault(ctx context.Context, organizationID uuid.UUID) (*models.SLAConfig, error) {
+	query := `SELECT ` + slaConfigColumns + ` FROM sla_configs WHERE organization_id = $1 AND component_id IS NULL`
+	return scanSLAConfig(r.db.QueryRowContext(ctx, query, organizationID))
+}
+
+// GetEffective retrieves the policy that applies to a component: its own
+// policy, or else its organization's default
+func (r *SLAConfigRepository) GetEffective(ctx context.Context, componentID uuid.UUID) (*models.SLAConfig, error) {
+	query := `
+		SELECT ` + slaConfigColumns + `
+		FROM sla_configs
+		WHERE organization_id = (SELECT organization_id FROM components WHERE id = $1)
+			AND (component_id = $1 OR component_id IS NULL)
+		ORDER BY component_id NULLS LAST
+		LIMIT 1
+	`
+	return scanSLAConfig(r.db.QueryRowContext(ctx, query, componentID))
+}
+
+// List retrieves the SLA policies of an organization, the default first
+func (r *SLAConfigRepository) List(ctx context.Context, organizationID uuid.UUID) ([]*models.SLAConfig, error) {
+	query := `
+		SELECT ` + slaConfigColumns + `
+		FROM sla_configs
+		WHERE organization_id = $1
+		ORDER BY component_id NULLS FIRST, created_at
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, organizationID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var configs []*models.SLAConfig
+	for rows.Next() {
+		config, err := scanSLAConfig(rows)
+		if err != nil {
+			return nil, err
+		}
+		configs = append(configs, config)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return configs, nil
+}
+
+// Update updates an existing SLA policy
+func (r *SLAConfigRepository) Update(ctx context.Context, config *models.SLAConfig) error {
+	query := `
+		UPDATE sla_configs
+		SET
+			critical_response_time = $1,
+			high_response_time = $2,
+			medium_response_time = $3,
+			low_response_time = $4,
+			trivial_response_time = $5,
+			critical_resolution_time = $6,
+			high_resolution_time = $7,
+			medium_resolution_time = $8,
+			low_resolution_time = $9,
+			trivial_resolution_time = $10,
+			s0_factor = $11,
+			s1_factor = $12,
+			s2_factor = $13,
+			s3_factor = $14,
+			calendar_id = $15,
+			recalculate_from = $16,
+			updated_at = $17
+		WHERE id = $18
+	`
+
+	_, err := r.db.ExecContext(
+		ctx,
+		query,
+		config.CriticalResponseTime,
+		config.HighResponseTime,
+		config.MediumResponseTime,
+		config.LowResponseTime,
+		config.TrivialResponseTime,
+		config.CriticalResolutionTime,
+		config.HighResolutionTime,
+		config.MediumResolutionTime,
+		config.LowResolutionTime,
+		config.TrivialResolutionTime,
+		config.S0Factor,
+		config.S1Factor,
+		config.S2Factor,
+		config.S3Factor,
+		config.CalendarID,
+		config.RecalculateFrom,
+		config.UpdatedAt,
+		config.ID,
+	)
+
+	return err
+}
+
+// Delete removes an SLA policy
+func (r *SLAConfigRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	query := `DELETE FROM sla_configs WHERE id = $1`
+	_, err := r.db.ExecContext(ctx, query, id)
+	return err
+}
+
+// scanSLAConfig scans a row selected with slaConfigColumns
+func scanSLAConfig(row interface {
+	Scan(dest ...interface{}) error
+}) (*models.SLAConfig, error) {
+	var config models.SLAConfig
+	var componentID, calendarID, createdByID sql.NullString
+
+	err := row.Scan(
+		&config.ID,
+		&config.OrganizationID,
+		&componentID,
+		&config.CriticalResponseTime,
+		&config.HighResponseTime,
+		&config.MediumResponseTime,
+		&config.LowResponseTime,
+		&config.TrivialResponseTime,
+		&config.CriticalResolutionTime,
+		&config.HighResolutionTime,
+		&config.MediumResolutionTime,
+		&config.LowResolutionTime,
+		&config.TrivialResolutionTime,
+		&config.S0Factor,
+		&config.S1Factor,
+		&config.S2Factor,
+		&config.S3Factor,
+		&calendarID,
+		&config.RecalculateFrom,
+		&config.CreatedAt,
+		&config.UpdatedAt,
+		&createdByID,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Handle optional fields
+	if componentID.Valid {
+		id, err := uuid.Parse(componentID.String)
+		if err == nil {
+			config.ComponentID = &id
+		}
+	}
+
+	if calendarID.Valid {
+		id, err := uuid.Parse(calendarID.String)
+		if err == nil {
+			config.CalendarID = &id
+		}
+	}
+
+	if createdByID.Valid {
+		id, err := uuid.Parse(createdByID.String)
+		if err == nil {
+			config.CreatedByID = &id
+		}
+	}
+
+	return &config, nil
+}
+
+// Ensure SLAConfigRepository implements repositories.SLAConfigRepository
+var _ repositories.SLAConfigRepository = (*SLAConfigRepository)(nil)
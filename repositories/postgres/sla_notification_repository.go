@@ -0,0 +1,60 @@
+// repositories/postgres/sla_notification_repository.go
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/matthewmc1/buganizer/models"
+	"github.com/matthewmc1/buganizer/repositories"
+)
+
+// SLANotificationRepository implements the SLANotificationRepository interface for PostgreSQL
+type SLANotificationRepository struct {
+	db *sql.DB
+}
+
+// NewSLANotificationRepository creates a new PostgreSQL SLA notification repository
+func NewSLANotificationRepository(db *sql.DB) *SLANotificationRepository {
+	return &SLANotificationRepository{
+		db: db,
+	}
+}
+
+// Claim records that a notification for a threshold is being sent, unless one
+// already was
+func (r *SLANotificationRepository) Claim(ctx context.Context, issueID uuid.UUID, target models.SLATarget, threshold int, at time.Time) (bool, error) {
+	query := `
+		INSERT INTO sla_notifications (
+			issue_id, target, threshold, sent_at
+		) VALUES (
+			$1, $2, $3, $4
+		)
+		ON CONFLICT (issue_id, target, threshold) DO NOTHING
+	`
+
+	result, err := r.db.ExecContext(ctx, query, issueID, target, threshold, at)
+	if err != nil {
+		return false, err
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return rows > 0, nil
+}
+
+// Release removes a claim whose notification could not be sent
+func (r *SLANotificationRepository) Release(ctx context.Context, issueID uuid.UUID, target models.SLATarget, threshold int) error {
+	query := `DELETE FROM sla_notifications WHERE issue_id = $1 AND target = $2 AND threshold = $3`
+	_, err := r.db.ExecContext(ctx, query, issueID, target, threshold)
+	return err
+}
+
+// Ensure SLANotificationRepository implements repositories.SLANotificationRepository
+var _ repositories.SLANotificationRepository = (*SLANotificationRepository)(nil)
@@ -0,0 +1,176 @@
+// repositories/postgres/sla_pause_repository.go
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+
+	"github.com/matthewmc1/buganizer/models"
+	"github.com/matthewmc1/buganizer/repositories"
+)
+
+// SLAPauseRepository implements the SLAPauseRepository interface for PostgreSQL
+type SLAPauseRepository struct {
+	db *sql.DB
+}
+
+// NewSLAPauseRepository creates a new PostgreSQL SLA pause repository
+func NewSLAPauseRepository(db *sql.DB) *SLAPauseRepository {
+	return &SLAPauseRepository{
+		db: db,
+	}
+}
+
+// Create starts a pause, unless the issue is already paused. It reports
+// whether the pause was started.
+func (r *SLAPauseRepository) Create(ctx context.Context, pause *models.SLAPause) (bool, error) {
+	query := `
+		INSERT INTO sla_pauses (id, issue_id, started_at, ended_at, reason)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (issue_id) WHERE ended_at IS NULL DO NOTHING
+	`
+
+	result, err := r.db.ExecContext(
+		ctx,
+		query,
+		pause.ID,
+		pause.IssueID,
+		pause.StartedAt,
+		pause.EndedAt,
+		pause.Reason,
+	)
+	if err != nil {
+		return false, err
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return rows > 0, nil
+}
+
+// GetOpen retrieves the pause an issue is currently in
+func (r *SLAPauseRepository) GetOpen(ctx context.Context, issueID uuid.UUID) (*models.SLAPause, error) {
+	query := `
+		SELECT id, issue_id, started_at, ended_at, reason
+		FROM sla_pauses
+		WHERE issue_id = $1 AND ended_at IS NULL
+	`
+	return scanSLAPause(r.db.QueryRowContext(ctx, query, issueID))
+}
+
+// End ends the current pause of an issue and returns it
+func (r *SLAPauseRepository) End(ctx context.Context, issueID uuid.UUID, at time.Time) (*models.SLAPause, error) {
+	query := `
+		UPDATE sla_pauses
+		SET ended_at = $1
+		WHERE issue_id = $2 AND ended_at IS NULL
+		RETURNING id, issue_id, started_at, ended_at, reason
+	`
+	return scanSLAPause(r.db.QueryRowContext(ctx, query, at, issueID))
+}
+
+// ListByIssue retrieves the pauses of an issue, oldest first
+func (r *SLAPauseRepository) ListByIssue(ctx context.Context, issueID uuid.UUID) ([]*models.SLAPause, error) {
+	query := `
+		SELECT id, issue_id, started_at, ended_at, reason
+		FROM sla_pauses
+		WHERE issue_id = $1
+		ORDER BY started_at
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, issueID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var pauses []*models.SLAPause
+	for rows.Next() {
+		pause, err := scanSLAPause(rows)
+		if err != nil {
+			return nil, err
+		}
+		pauses = append(pauses, pause)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return pauses, nil
+}
+
+// ListOpen retrieves the current pauses of the given issues, keyed by issue ID
+func (r *SLAPauseRepository) ListOpen(ctx context.Context, issueIDs []uuid.UUID) (map[uuid.UUID]*models.SLAPause, error) {
+	pauses := make(map[uuid.UUID]*models.SLAPause)
+	if len(issueIDs) == 0 {
+		return pauses, nil
+	}
+
+	ids := make([]string, len(issueIDs))
+	for i, id := range issueIDs {
+		ids[i] = id.String()
+	}
+
+	query := `
+		SELECT id, issue_id, started_at, ended_at, reason
+		FROM sla_pauses
+		WHERE issue_id = ANY($1::uuid[]) AND ended_at IS NULL
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, pq.Array(ids))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		pause, err := scanSLAPause(rows)
+		if err != nil {
+			return nil, err
+		}
+		pauses[pause.IssueID] = pause
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return pauses, nil
+}
+
+// scanSLAPause scans a pause row
+func scanSLAPause(row interface {
+	Scan(dest ...interface{}) error
+}) (*models.SLAPause, error) {
+	var pause models.SLAPause
+	var endedAt sql.NullTime
+
+	err := row.Scan(
+		&pause.ID,
+		&pause.IssueID,
+		&pause.StartedAt,
+		&endedAt,
+		&pause.Reason,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Handle optional fields
+	if endedAt.Valid {
+		pause.EndedAt = &endedAt.Time
+	}
+
+	return &pause, nil
+}
+
+// Ensure SLAPauseRepository implements repositories.SLAPauseRepository
+var _ repositories.SLAPauseRepository = (*SLAPauseRepository)(nil)
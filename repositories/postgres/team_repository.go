@@ -220,5 +220,14 @@ func (r *TeamRepository) RemoveMember(ctx context.Context, teamID, userID uuid.U
 	return err
 }
 
+// GetOrganizationID gets the organization a team belongs to
+func (r *TeamRepository) GetOrganizationID(ctx context.Context, teamID uuid.UUID) (uuid.UUID, error) {
+	query := `SELECT organization_id FROM teams WHERE id = $1`
+
+	var organizationID uuid.UUID
+	err := r.db.QueryRowContext(ctx, query, teamID).Scan(&organizationID)
+	return organizationID, err
+}
+
 // Ensure TeamRepository implements repositories.TeamRepository
 var _ repositories.TeamRepository = (*TeamRepository)(nil)
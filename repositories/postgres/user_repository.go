@@ -237,6 +237,30 @@ func (r *UserRepository) IsUserInTeam(ctx context.Context, userID, teamID uuid.U
 	return exists, err
 }
 
+// IsAdmin checks if a user holds the ADMIN role
+func (r *UserRepository) IsAdmin(ctx context.Context, userID uuid.UUID) (bool, error) {
+	query := `
+		SELECT EXISTS (
+			SELECT 1
+			FROM user_roles
+			WHERE user_id = $1 AND role = 'ADMIN'
+		)
+	`
+
+	var exists bool
+	err := r.db.QueryRowContext(ctx, query, userID).Scan(&exists)
+	return exists, err
+}
+
+// GetOrganizationID gets the organization a user belongs to
+func (r *UserRepository) GetOrganizationID(ctx context.Context, userID uuid.UUID) (uuid.UUID, error) {
+	query := `SELECT organization_id FROM users WHERE id = $1`
+
+	var organizationID uuid.UUID
+	err := r.db.QueryRowContext(ctx, query, userID).Scan(&organizationID)
+	return organizationID, err
+}
+
 // GetUserTeams gets the teams a user belongs to
 func (r *UserRepository) GetUserTeams(ctx context.Context, userID uuid.UUID) ([]*models.Team, error) {
 	query := `
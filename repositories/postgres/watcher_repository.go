@@ -0,0 +1,97 @@
+// repositories/postgres/watcher_repository.go
+package postgres
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/google/uuid"
+
+	"github.com/matthewmc1/buganizer/models"
+	"github.com/matthewmc1/buganizer/repositories"
+)
+
+// WatcherRepository implements the WatcherRepository interface for PostgreSQL
+type WatcherRepository struct {
+	db *sql.DB
+}
+
+// NewWatcherRepository creates a new PostgreSQL watcher repository
+func NewWatcherRepository(db *sql.DB) *WatcherRepository {
+	return &WatcherRepository{
+		db: db,
+	}
+}
+
+// Add adds a user to an issue's watchers, keeping the original reason if already watching
+func (r *WatcherRepository) Add(ctx context.Context, watcher *models.IssueWatcher) error {
+	query := `
+		INSERT INTO issue_watchers (
+			issue_id, user_id, reason, created_at
+		) VALUES (
+			$1, $2, $3, $4
+		)
+		ON CONFLICT (issue_id, user_id) DO NOTHING
+	`
+
+	_, err := r.db.ExecContext(
+		ctx,
+		query,
+		watcher.IssueID,
+		watcher.UserID,
+		watcher.Reason,
+		watcher.CreatedAt,
+	)
+
+	return err
+}
+
+// Remove removes a user from an issue's watchers
+func (r *WatcherRepository) Remove(ctx context.Context, issueID, userID uuid.UUID) error {
+	query := `DELETE FROM issue_watchers WHERE issue_id = $1 AND user_id = $2`
+	_, err := r.db.ExecContext(ctx, query, issueID, userID)
+	return err
+}
+
+// ListByIssue retrieves all watchers of an issue
+func (r *WatcherRepository) ListByIssue(ctx context.Context, issueID uuid.UUID) ([]*models.IssueWatcher, error) {
+	query := `
+		SELECT
+			issue_id, user_id, reason, created_at
+		FROM issue_watchers
+		WHERE issue_id = $1
+		ORDER BY created_at ASC
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, issueID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var watchers []*models.IssueWatcher
+	for rows.Next() {
+		var watcher models.IssueWatcher
+
+		err := rows.Scan(
+			&watcher.IssueID,
+			&watcher.UserID,
+			&watcher.Reason,
+			&watcher.CreatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		watchers = append(watchers, &watcher)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return watchers, nil
+}
+
+// Ensure WatcherRepository implements repositories.WatcherRepository
+var _ repositories.WatcherRepository = (*WatcherRepository)(nil)
@@ -0,0 +1,182 @@
+// repositories/postgres/webhook_delivery_repository.go
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+
+	"github.com/google/uuid"
+
+	"github.com/matthewmc1/buganizer/models"
+	"github.com/matthewmc1/buganizer/repositories"
+)
+
+// webhookDeliveryColumns lists the columns read by every delivery query
+const webhookDeliveryColumns = `
+			id, webhook_id, event_type, issue_id, request_headers, request_body,
+			response_status, response_headers, response_body, response_truncated,
+			latency_ms, error, success, redelivery_of_id, created_at`
+
+// WebhookDeliveryRepository implements the WebhookDeliveryRepository interface for PostgreSQL
+type WebhookDeliveryRepository struct {
+	db *sql.DB
+}
+
+// NewWebhookDeliveryRepository creates a new PostgreSQL webhook delivery repository
+func NewWebhookDeliveryRepository(db *sql.DB) *WebhookDeliveryRepository {
+	return &WebhookDeliveryRepository{
+		db: db,
+	}
+}
+
+// Create records a delivery attempt
+func (r *WebhookDeliveryRepository) Create(ctx context.Context, delivery *models.WebhookDelivery) error {
+	requestHeaders, err := json.Marshal(delivery.RequestHeaders)
+	if err != nil {
+		return err
+	}
+	responseHeaders, err := json.Marshal(delivery.ResponseHeaders)
+	if err != nil {
+		return err
+	}
+
+	query := `
+		INSERT INTO webhook_deliveries (
+			id, webhook_id, event_type, issue_id, request_headers, request_body,
+			response_status, response_headers, response_body, response_truncated,
+			latency_ms, error, success, redelivery_of_id, created_at
+		) VALUES (
+			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15
+		)
+	`
+
+	_, err = r.db.ExecContext(
+		ctx,
+		query,
+		delivery.ID,
+		delivery.WebhookID,
+		delivery.EventType,
+		delivery.IssueID,
+		string(requestHeaders),
+		delivery.RequestBody,
+		delivery.ResponseStatus,
+		string(responseHeaders),
+		delivery.ResponseBody,
+		delivery.ResponseTruncated,
+		delivery.LatencyMs,
+		delivery.Error,
+		delivery.Success,
+		delivery.RedeliveryOfID,
+		delivery.CreatedAt,
+	)
+
+	return err
+}
+
+// GetByID retrieves a delivery attempt by its ID
+func (r *WebhookDeliveryRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.WebhookDelivery, error) {
+	query := `SELECT ` + webhookDeliveryColumns + ` FROM webhook_deliveries WHERE id = $1`
+
+	return scanWebhookDelivery(r.db.QueryRowContext(ctx, query, id))
+}
+
+// ListByWebhook lists the delivery attempts of a webhook, newest first, with
+// the total count
+func (r *WebhookDeliveryRepository) ListByWebhook(ctx context.Context, webhookID uuid.UUID, failedOnly bool, limit, offset int) ([]*models.WebhookDelivery, int, error) {
+	where := `WHERE webhook_id = $1`
+	if failedOnly {
+		where += ` AND NOT success`
+	}
+
+	var total int
+	err := r.db.QueryRowContext(ctx, `SELECT COUNT(*) FROM webhook_deliveries `+where, webhookID).Scan(&total)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	query := `
+		SELECT ` + webhookDeliveryColumns + `
+		FROM webhook_deliveries
+		` + where + `
+		ORDER BY created_at DESC, id
+		LIMIT $2 OFFSET $3
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, webhookID, limit, offset)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	var deliveries []*models.WebhookDelivery
+	for rows.Next() {
+		delivery, err := scanWebhookDelivery(rows)
+		if err != nil {
+			return nil, 0, err
+		}
+		deliveries = append(deliveries, delivery)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, 0, err
+	}
+
+	return deliveries, total, nil
+}
+
+// scanWebhookDelivery scans a row selected with webhookDeliveryColumns
+func scanWebhookDelivery(row interface {
+	Scan(dest ...interface{}) error
+}) (*models.WebhookDelivery, error) {
+	var delivery models.WebhookDelivery
+	var issueID, redeliveryOfID sql.NullString
+	var requestHeaders, responseHeaders []byte
+
+	err := row.Scan(
+		&delivery.ID,
+		&delivery.WebhookID,
+		&delivery.EventType,
+		&issueID,
+		&requestHeaders,
+		&delivery.RequestBody,
+		&delivery.ResponseStatus,
+		&responseHeaders,
+		&delivery.ResponseBody,
+		&delivery.ResponseTruncated,
+		&delivery.LatencyMs,
+		&delivery.Error,
+		&delivery.Success,
+		&redeliveryOfID,
+		&delivery.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(requestHeaders, &delivery.RequestHeaders); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(responseHeaders, &delivery.ResponseHeaders); err != nil {
+		return nil, err
+	}
+
+	// Handle optional fields
+	if issueID.Valid {
+		id, err := uuid.Parse(issueID.String)
+		if err == nil {
+			delivery.IssueID = &id
+		}
+	}
+
+	if redeliveryOfID.Valid {
+		id, err := uuid.Parse(redeliveryOfID.String)
+		if err == nil {
+			delivery.RedeliveryOfID = &id
+		}
+	}
+
+	return &delivery, nil
+}
+
+var _ repositories.WebhookDeliveryRepository = (*WebhookDeliveryRepository)(nil)
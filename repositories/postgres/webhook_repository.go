@@ -30,9 +30,9 @@ func (r *WebhookRepository) Create(ctx context.Context, webhook *models.Webhook)
 	query := `
 		INSERT INTO webhooks (
 			id, url, description, secret, creator_id, event_types, created_at,
-			last_called_at, last_success
+			last_called_at, last_success, payload_format
 		) VALUES (
-			$1, $2, $3, $4, $5, $6, $7, $8, $9
+			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10
 		)
 	`
 
@@ -48,6 +48,7 @@ func (r *WebhookRepository) Create(ctx context.Context, webhook *models.Webhook)
 		webhook.CreatedAt,
 		webhook.LastCalledAt,
 		webhook.LastSuccess,
+		webhook.PayloadFormat,
 	)
 
 	return err
@@ -58,7 +59,7 @@ func (r *WebhookRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.
 	query := `
 		SELECT
 			id, url, description, secret, creator_id, event_types, created_at,
-			last_called_at, last_success
+			last_called_at, last_success, payload_format
 		FROM webhooks
 		WHERE id = $1
 	`
@@ -82,6 +83,7 @@ func (r *WebhookRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.
 		&webhook.CreatedAt,
 		&lastCalledAt,
 		&lastSuccess,
+		&webhook.PayloadFormat,
 	)
 
 	if err != nil {
@@ -111,8 +113,9 @@ func (r *WebhookRepository) Update(ctx context.Context, webhook *models.Webhook)
 			secret = $3,
 			event_types = $4,
 			last_called_at = $5,
-			last_success = $6
-		WHERE id = $7
+			last_success = $6,
+			payload_format = $7
+		WHERE id = $8
 	`
 
 	_, err := r.db.ExecContext(
@@ -124,6 +127,7 @@ func (r *WebhookRepository) Update(ctx context.Context, webhook *models.Webhook)
 		pq.Array(webhook.EventTypes),
 		webhook.LastCalledAt,
 		webhook.LastSuccess,
+		webhook.PayloadFormat,
 		webhook.ID,
 	)
 
@@ -151,23 +155,59 @@ func (r *WebhookRepository) UpdateStatus(ctx context.Context, id uuid.UUID, last
 	return err
 }
 
-// ListByEventType lists webhooks subscribed to a specific event type
-func (r *WebhookRepository) ListByEventType(ctx context.Context, eventType string) ([]models.Webhook, error) {
+// ListByEventType lists the webhooks created by users of an organization
+// that are subscribed to a specific event type
+func (r *WebhookRepository) ListByEventType(ctx context.Context, organizationID uuid.UUID, eventType string) ([]models.Webhook, error) {
 	query := `
 		SELECT
 			id, url, description, secret, creator_id, event_types, created_at,
-			last_called_at, last_success
+			last_called_at, last_success, payload_format
 		FROM webhooks
-		WHERE $1 = ANY(event_types)
+		WHERE creator_id IN (SELECT id FROM users WHERE organization_id = $1)
+			AND $2 = ANY(event_types)
 		ORDER BY created_at DESC
 	`
 
-	rows, err := r.db.QueryContext(ctx, query, eventType)
+	rows, err := r.db.QueryContext(ctx, query, organizationID, eventType)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
 
+	return scanWebhooks(rows)
+}
+
+// List lists the webhooks created by users of an organization, or only those
+// created by one user when creatorID is set
+func (r *WebhookRepository) List(ctx context.Context, organizationID uuid.UUID, creatorID *uuid.UUID) ([]models.Webhook, error) {
+	query := `
+		SELECT
+			id, url, description, secret, creator_id, event_types, created_at,
+			last_called_at, last_success, payload_format
+		FROM webhooks
+		WHERE creator_id IN (SELECT id FROM users WHERE organization_id = $1)
+			AND ($2::uuid IS NULL OR creator_id = $2)
+		ORDER BY created_at DESC
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, organizationID, creatorID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return scanWebhooks(rows)
+}
+
+// Delete removes a webhook
+func (r *WebhookRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	query := `DELETE FROM webhooks WHERE id = $1`
+	_, err := r.db.ExecContext(ctx, query, id)
+	return err
+}
+
+// scanWebhooks scans the rows of a webhook query
+func scanWebhooks(rows *sql.Rows) ([]models.Webhook, error) {
 	var webhooks []models.Webhook
 	for rows.Next() {
 		var webhook models.Webhook
@@ -185,6 +225,7 @@ func (r *WebhookRepository) ListByEventType(ctx context.Context, eventType strin
 			&webhook.CreatedAt,
 			&lastCalledAt,
 			&lastSuccess,
+			&webhook.PayloadFormat,
 		)
 		if err != nil {
 			return nil, err
@@ -210,12 +251,5 @@ func (r *WebhookRepository) ListByEventType(ctx context.Context, eventType strin
 	return webhooks, nil
 }
 
-// Delete removes a webhook
-func (r *WebhookRepository) Delete(ctx context.Context, id uuid.UUID) error {
-	query := `DELETE FROM webhooks WHERE id = $1`
-	_, err := r.db.ExecContext(ctx, query, id)
-	return err
-}
-
 // Ensure WebhookRepository implements repositories.WebhookRepository
 var _ repositories.WebhookRepository = (*WebhookRepository)(nil)
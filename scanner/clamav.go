@@ -0,0 +1,141 @@
+// scanner/clamav.go
+package scanner
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+	"time"
+)
+
+// clamAVChunkSize is the size of the chunks streamed to clamd. It must stay
+// below clamd's StreamMaxLength.
+const clamAVChunkSize = 64 * 1024
+
+// ClamAVScanner scans content with clamd's INSTREAM command. The address is
+// either host:port or a unix socket path prefixed with "unix:", so a fake
+// clamd listening locally can stand in for a real one.
+type ClamAVScanner struct {
+	address string
+	timeout time.Duration
+}
+
+// NewClamAVScanner creates a scanner for the clamd daemon at address
+func NewClamAVScanner(address string, timeout time.Duration) *ClamAVScanner {
+	return &ClamAVScanner{
+		address: address,
+		timeout: timeout,
+	}
+}
+
+// Scan streams r to clamd and parses its verdict
+func (c *ClamAVScanner) Scan(ctx context.Context, r io.Reader) (*Result, error) {
+	conn, err := c.dial(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	// Replies are null-terminated with the "z" prefix
+	if _, err := conn.Write([]byte("zINSTREAM\x00")); err != nil {
+		return nil, fmt.Errorf("clamav: failed to send command: %w", err)
+	}
+
+	// Each chunk is prefixed with its length as a big-endian uint32; a
+	// zero-length chunk ends the stream
+	buf := make([]byte, 4+clamAVChunkSize)
+	for {
+		n, readErr := io.ReadFull(r, buf[4:])
+		if n > 0 {
+			binary.BigEndian.PutUint32(buf[:4], uint32(n))
+			if _, err := conn.Write(buf[:4+n]); err != nil {
+				// clamd closes the connection when the stream is too large;
+				// its reply explains why
+				break
+			}
+		}
+		if readErr == io.EOF || readErr == io.ErrUnexpectedEOF {
+			break
+		}
+		if readErr != nil {
+			return nil, fmt.Errorf("clamav: failed to read content: %w", readErr)
+		}
+	}
+	_, _ = conn.Write([]byte{0, 0, 0, 0})
+
+	reply, err := bufio.NewReader(conn).ReadBytes(0)
+	if err != nil && len(reply) == 0 {
+		return nil, fmt.Errorf("clamav: failed to read reply: %w", err)
+	}
+
+	return parseReply(string(bytes.TrimRight(reply, "\x00")))
+}
+
+// Ping checks that clamd is reachable
+func (c *ClamAVScanner) Ping(ctx context.Context) error {
+	conn, err := c.dial(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	if _, err := conn.Write([]byte("zPING\x00")); err != nil {
+		return fmt.Errorf("clamav: failed to send command: %w", err)
+	}
+
+	reply, err := bufio.NewReader(conn).ReadString(0)
+	if err != nil {
+		return fmt.Errorf("clamav: failed to read reply: %w", err)
+	}
+	if strings.TrimRight(reply, "\x00") != "PONG" {
+		return fmt.Errorf("clamav: unexpected reply to PING: %q", reply)
+	}
+	return nil
+}
+
+// dial connects to clamd, applying the scan timeout as the connection deadline
+func (c *ClamAVScanner) dial(ctx context.Context) (net.Conn, error) {
+	network, address := "tcp", c.address
+	if path, ok := strings.CutPrefix(c.address, "unix:"); ok {
+		network, address = "unix", path
+	}
+
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, network, address)
+	if err != nil {
+		return nil, fmt.Errorf("clamav: failed to connect: %w", err)
+	}
+
+	deadline, ok := ctx.Deadline()
+	if c.timeout > 0 && (!ok || time.Until(deadline) > c.timeout) {
+		deadline, ok = time.Now().Add(c.timeout), true
+	}
+	if ok {
+		_ = conn.SetDeadline(deadline)
+	}
+
+	return conn, nil
+}
+
+// parseReply interprets clamd replies such as "stream: OK",
+// "stream: Eicar-Signature FOUND" and "INSTREAM size limit exceeded. ERROR"
+func parseReply(reply string) (*Result, error) {
+	reply = strings.TrimSpace(reply)
+	switch {
+	case strings.HasSuffix(reply, " OK"):
+		return &Result{Clean: true}, nil
+	case strings.HasSuffix(reply, " FOUND"):
+		signature := strings.TrimSuffix(reply, " FOUND")
+		if i := strings.Index(signature, ": "); i >= 0 {
+			signature = signature[i+2:]
+		}
+		return &Result{Clean: false, Signature: signature}, nil
+	default:
+		return nil, fmt.Errorf("clamav: scan failed: %s", reply)
+	}
+}
@@ -0,0 +1,200 @@
+// scanner/clamav_test.go
+package scanner
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/binary"
+	"io"
+	"net"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+// eicar is the standard antivirus test string
+const eicar = `X5O!P%@AP[4\PZX54(P^)7CC)7}$EICAR-STANDARD-ANTIVIRUS-TEST-FILE!$H+H*`
+
+// fakeClamd speaks enough of the clamd protocol to answer PING and INSTREAM
+type fakeClamd struct {
+	listener  net.Listener
+	maxStream int // INSTREAM size limit; 0 for none
+	received  chan []byte
+}
+
+func startFakeClamd(t *testing.T, network, address string, maxStream int) *fakeClamd {
+	t.Helper()
+
+	listener, err := net.Listen(network, address)
+	if err != nil {
+		t.Fatalf("failed to listen: %v", err)
+	}
+	t.Cleanup(func() { listener.Close() })
+
+	f := &fakeClamd{listener: listener, maxStream: maxStream, received: make(chan []byte, 10)}
+	go f.serve()
+	return f
+}
+
+func (f *fakeClamd) serve() {
+	for {
+		conn, err := f.listener.Accept()
+		if err != nil {
+			return
+		}
+		go f.handle(conn)
+	}
+}
+
+func (f *fakeClamd) handle(conn net.Conn) {
+	defer conn.Close()
+	r := bufio.NewReader(conn)
+
+	command, err := r.ReadString(0)
+	if err != nil {
+		return
+	}
+
+	switch command {
+	case "zPING\x00":
+		conn.Write([]byte("PONG\x00"))
+	case "zINSTREAM\x00":
+		var content bytes.Buffer
+		for {
+			var size uint32
+			if err := binary.Read(r, binary.BigEndian, &size); err != nil {
+				return
+			}
+			if size == 0 {
+				break
+			}
+			if _, err := io.CopyN(&content, r, int64(size)); err != nil {
+				return
+			}
+			if f.maxStream > 0 && content.Len() > f.maxStream {
+				conn.Write([]byte("INSTREAM size limit exceeded. ERROR\x00"))
+				// Drain the rest so closing does not reset the connection
+				// before the client reads the reply
+				io.Copy(io.Discard, r)
+				return
+			}
+		}
+		f.received <- content.Bytes()
+
+		if bytes.Contains(content.Bytes(), []byte(eicar)) {
+			conn.Write([]byte("stream: Eicar-Test-Signature FOUND\x00"))
+		} else {
+			conn.Write([]byte("stream: OK\x00"))
+		}
+	default:
+		conn.Write([]byte("UNKNOWN COMMAND\x00"))
+	}
+}
+
+func TestClamAVScanner(t *testing.T) {
+	clamd := startFakeClamd(t, "tcp", "127.0.0.1:0", 0)
+	s := NewClamAVScanner(clamd.listener.Addr().String(), 5*time.Second)
+	ctx := context.Background()
+
+	if err := s.Ping(ctx); err != nil {
+		t.Fatalf("Ping: %v", err)
+	}
+
+	result, err := s.Scan(ctx, strings.NewReader("just a log file"))
+	if err != nil {
+		t.Fatalf("Scan: %v", err)
+	}
+	if !result.Clean {
+		t.Errorf("clean content reported infected: %+v", result)
+	}
+	if got := string(<-clamd.received); got != "just a log file" {
+		t.Errorf("clamd received %q", got)
+	}
+
+	result, err = s.Scan(ctx, strings.NewReader("prefix "+eicar))
+	if err != nil {
+		t.Fatalf("Scan: %v", err)
+	}
+	if result.Clean || result.Signature != "Eicar-Test-Signature" {
+		t.Errorf("infected content result = %+v", result)
+	}
+	<-clamd.received
+}
+
+func TestClamAVScannerStreamsInChunks(t *testing.T) {
+	clamd := startFakeClamd(t, "tcp", "127.0.0.1:0", 0)
+	s := NewClamAVScanner(clamd.listener.Addr().String(), 5*time.Second)
+
+	// Larger than one chunk, with the signature straddling a chunk boundary
+	content := strings.Repeat("a", clamAVChunkSize-10) + eicar + strings.Repeat("b", clamAVChunkSize)
+	result, err := s.Scan(context.Background(), strings.NewReader(content))
+	if err != nil {
+		t.Fatalf("Scan: %v", err)
+	}
+	if result.Clean {
+		t.Error("signature split across chunks was not found")
+	}
+	if got := <-clamd.received; len(got) != len(content) {
+		t.Errorf("clamd received %d bytes, want %d", len(got), len(content))
+	}
+}
+
+func TestClamAVScannerSizeLimit(t *testing.T) {
+	clamd := startFakeClamd(t, "tcp", "127.0.0.1:0", 1024)
+	s := NewClamAVScanner(clamd.listener.Addr().String(), 5*time.Second)
+
+	_, err := s.Scan(context.Background(), strings.NewReader(strings.Repeat("x", 3*clamAVChunkSize)))
+	if err == nil || !strings.Contains(err.Error(), "size limit exceeded") {
+		t.Errorf("Scan of oversized content: %v, want a size limit error", err)
+	}
+}
+
+func TestClamAVScannerUnixSocket(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "clamd.sock")
+	startFakeClamd(t, "unix", path, 0)
+	s := NewClamAVScanner("unix:"+path, 5*time.Second)
+
+	if err := s.Ping(context.Background()); err != nil {
+		t.Errorf("Ping over unix socket: %v", err)
+	}
+}
+
+func TestClamAVScannerUnreachable(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	address := listener.Addr().String()
+	listener.Close()
+
+	s := NewClamAVScanner(address, time.Second)
+	if _, err := s.Scan(context.Background(), strings.NewReader("x")); err == nil {
+		t.Error("expected an error when clamd is unreachable")
+	}
+}
+
+func TestParseReply(t *testing.T) {
+	tests := []struct {
+		reply     string
+		clean     bool
+		signature string
+		err       bool
+	}{
+		{"stream: OK", true, "", false},
+		{"stream: Win.Test.EICAR_HDB-1 FOUND", false, "Win.Test.EICAR_HDB-1", false},
+		{"INSTREAM size limit exceeded. ERROR", false, "", true},
+		{"", false, "", true},
+	}
+	for _, tt := range tests {
+		result, err := parseReply(tt.reply)
+		if (err != nil) != tt.err {
+			t.Errorf("parseReply(%q) error = %v", tt.reply, err)
+			continue
+		}
+		if err == nil && (result.Clean != tt.clean || result.Signature != tt.signature) {
+			t.Errorf("parseReply(%q) = %+v", tt.reply, result)
+		}
+	}
+}
@@ -0,0 +1,46 @@
+// scanner/scanner.go
+package scanner
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/matthewmc1/buganizer/config"
+)
+
+// Result is the outcome of scanning content
+type Result struct {
+	Clean     bool
+	Signature string // Name of the detected threat when not clean
+}
+
+// Scanner inspects attachment content for malware
+type Scanner interface {
+	// Scan reads r to the end and reports whether the content is clean
+	Scan(ctx context.Context, r io.Reader) (*Result, error)
+}
+
+// New creates the scanner selected by the configuration
+func New(cfg config.ScannerConfig) (Scanner, error) {
+	switch cfg.Provider {
+	case "", "none":
+		return NopScanner{}, nil
+	case "clamav":
+		return NewClamAVScanner(cfg.Address, time.Duration(cfg.TimeoutSeconds)*time.Second), nil
+	default:
+		return nil, fmt.Errorf("unknown scanner provider: %s", cfg.Provider)
+	}
+}
+
+// NopScanner reports all content as clean
+type NopScanner struct{}
+
+// Scan drains r and reports it clean
+func (NopScanner) Scan(ctx context.Context, r io.Reader) (*Result, error) {
+	if _, err := io.Copy(io.Discard, r); err != nil {
+		return nil, err
+	}
+	return &Result{Clean: true}, nil
+}
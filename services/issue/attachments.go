@@ -0,0 +1,838 @@
+// services/issue/attachments.go
+package issue
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/matthewmc1/buganizer/models"
+	"github.com/matthewmc1/buganizer/preview"
+	pb "github.com/matthewmc1/buganizer/proto"
+	"github.com/matthewmc1/buganizer/scanner"
+	"github.com/matthewmc1/buganizer/storage"
+)
+
+const (
+	// defaultPreviewLines is the number of head and tail lines previewed when none are requested
+	defaultPreviewLines = 50
+
+	// maxPreviewLines is the largest number of head or tail lines that can be requested
+	maxPreviewLines = 1000
+)
+
+// UploadAttachment receives an attachment in chunks. Received bytes are staged
+// in storage as parts so an interrupted upload can be resumed from
+// GetUploadStatus' received_bytes by re-sending the same upload ID.
+func (s *Service) UploadAttachment(stream pb.IssueService_UploadAttachmentServer) error {
+	ctx := stream.Context()
+
+	// Get user ID from context
+	userID, ok := ctx.Value("user_id").(string)
+	if !ok {
+		return status.Error(codes.Unauthenticated, "user not authenticated")
+	}
+
+	first, err := stream.Recv()
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "failed to receive upload metadata: %v", err)
+	}
+	md := first.GetMetadata()
+	if md == nil {
+		return status.Error(codes.InvalidArgument, "the first message must contain upload metadata")
+	}
+
+	upload, err := s.startUpload(ctx, md, uuid.MustParse(userID))
+	if err != nil {
+		return err
+	}
+
+	// Buffer chunks and persist a part every UploadPartSize bytes
+	buf := make([]byte, 0, s.storageConfig.UploadPartSize)
+	flush := func(ctx context.Context) error {
+		if len(buf) == 0 {
+			return nil
+		}
+		if err := s.storeUploadPart(ctx, upload, buf); err != nil {
+			return err
+		}
+		buf = buf[:0]
+		return nil
+	}
+
+	for {
+		req, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			// Keep what was received so the client can resume
+			_ = flush(context.WithoutCancel(ctx))
+			return err
+		}
+
+		chunk := req.GetChunk()
+		received := upload.ReceivedBytes + int64(len(buf)) + int64(len(chunk))
+		if received > s.storageConfig.MaxUploadBytes {
+			// An oversized upload cannot be resumed, so discard what was received
+			s.discardUpload(ctx, upload, uploadPartKeys(upload))
+			return status.Errorf(codes.InvalidArgument, "attachment exceeds the maximum size of %d bytes", s.storageConfig.MaxUploadBytes)
+		}
+		if upload.TotalSize > 0 && received > upload.TotalSize {
+			s.discardUpload(ctx, upload, uploadPartKeys(upload))
+			return status.Errorf(codes.InvalidArgument, "received more than the declared size of %d bytes", upload.TotalSize)
+		}
+
+		buf = append(buf, chunk...)
+		if len(buf) >= s.storageConfig.UploadPartSize {
+			if err := flush(ctx); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := flush(ctx); err != nil {
+		return err
+	}
+
+	if upload.ReceivedBytes == 0 {
+		return status.Error(codes.InvalidArgument, "attachment content is required")
+	}
+	if upload.TotalSize > 0 && upload.ReceivedBytes < upload.TotalSize {
+		return status.Errorf(codes.Aborted, "upload incomplete: received %d of %d bytes", upload.ReceivedBytes, upload.TotalSize)
+	}
+
+	attachment, err := s.completeUpload(ctx, upload)
+	if err != nil {
+		return err
+	}
+
+	return stream.SendAndClose(s.attachmentToProto(attachment))
+}
+
+// ListAttachments lists the attachments of an issue
+func (s *Service) ListAttachments(ctx context.Context, req *pb.ListAttachmentsRequest) (*pb.ListAttachmentsResponse, error) {
+	if req.IssueId == "" {
+		return nil, status.Error(codes.InvalidArgument, "issue ID is required")
+	}
+
+	// Get user ID from context
+	if _, ok := ctx.Value("user_id").(string); !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not authenticated")
+	}
+
+	issueID, err := uuid.Parse(req.IssueId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid issue ID format")
+	}
+
+	// Make sure the issue exists
+	_, err = s.issueRepo.GetByID(ctx, issueID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Error(codes.NotFound, "issue not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get issue: %v", err)
+	}
+
+	attachments, err := s.attachmentRepo.GetIssueAttachments(ctx, issueID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list attachments: %v", err)
+	}
+
+	response := &pb.ListAttachmentsResponse{
+		Attachments: make([]*pb.Attachment, 0, len(attachments)),
+	}
+	for _, attachment := range attachments {
+		response.Attachments = append(response.Attachments, s.attachmentToProto(attachment))
+	}
+
+	return response, nil
+}
+
+// DeleteAttachment removes an attachment and its stored content. Only the
+// uploader or an admin may delete an attachment.
+func (s *Service) DeleteAttachment(ctx context.Context, req *pb.DeleteAttachmentRequest) (*emptypb.Empty, error) {
+	if req.AttachmentId == "" {
+		return nil, status.Error(codes.InvalidArgument, "attachment ID is required")
+	}
+
+	// Get user ID from context
+	userID, ok := ctx.Value("user_id").(string)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not authenticated")
+	}
+
+	attachmentID, err := uuid.Parse(req.AttachmentId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid attachment ID format")
+	}
+
+	attachment, err := s.attachmentRepo.GetByID(ctx, attachmentID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Error(codes.NotFound, "attachment not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get attachment: %v", err)
+	}
+
+	if attachment.UploaderID.String() != userID {
+		isAdmin, err := s.userRepo.IsAdmin(ctx, uuid.MustParse(userID))
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to check permissions: %v", err)
+		}
+		if !isAdmin {
+			return nil, status.Error(codes.PermissionDenied, "only the uploader or an admin can delete an attachment")
+		}
+	}
+
+	// Remove the content first so a failure leaves the record in place to retry
+	if attachment.StorageKey != "" {
+		if err := s.store.Delete(ctx, attachment.StorageKey); err != nil && err != storage.ErrNotFound {
+			return nil, status.Errorf(codes.Internal, "failed to delete attachment content: %v", err)
+		}
+	}
+	if attachment.ThumbnailKey != "" {
+		_ = s.store.Delete(ctx, attachment.ThumbnailKey)
+	}
+
+	if err := s.attachmentRepo.Delete(ctx, attachmentID); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete attachment: %v", err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// GetAttachmentPreview returns the numbered first and last lines of a text
+// attachment, so logs can be inspected without downloading them
+func (s *Service) GetAttachmentPreview(ctx context.Context, req *pb.GetAttachmentPreviewRequest) (*pb.AttachmentPreview, error) {
+	if req.AttachmentId == "" {
+		return nil, status.Error(codes.InvalidArgument, "attachment ID is required")
+	}
+	if req.HeadLines < 0 || req.TailLines < 0 || req.HeadLines > maxPreviewLines || req.TailLines > maxPreviewLines {
+		return nil, status.Errorf(codes.InvalidArgument, "head and tail lines must be between 0 and %d", maxPreviewLines)
+	}
+
+	// Get user ID from context
+	if _, ok := ctx.Value("user_id").(string); !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not authenticated")
+	}
+
+	attachmentID, err := uuid.Parse(req.AttachmentId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid attachment ID format")
+	}
+
+	attachment, err := s.attachmentRepo.GetByID(ctx, attachmentID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Error(codes.NotFound, "attachment not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get attachment: %v", err)
+	}
+	if attachment.ScanStatus != models.ScanStatusClean {
+		return nil, status.Error(codes.FailedPrecondition, "attachment has not been scanned clean")
+	}
+	if !preview.IsText(attachment.ContentType) {
+		return nil, status.Errorf(codes.FailedPrecondition, "attachments of type %s cannot be previewed as text", attachment.ContentType)
+	}
+
+	headLines, tailLines := int(req.HeadLines), int(req.TailLines)
+	if headLines == 0 && tailLines == 0 {
+		headLines, tailLines = defaultPreviewLines, defaultPreviewLines
+	}
+
+	body, err := s.store.Get(ctx, attachment.StorageKey, 0, 0)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to read attachment: %v", err)
+	}
+	defer body.Close()
+
+	text, err := preview.TextPreview(body, headLines, tailLines)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to read attachment: %v", err)
+	}
+
+	return &pb.AttachmentPreview{
+		AttachmentId: attachment.ID.String(),
+		Filename:     attachment.Filename,
+		ContentType:  attachment.ContentType,
+		Head:         previewLinesToProto(text.Head),
+		Tail:         previewLinesToProto(text.Tail),
+		TotalLines:   text.TotalLines,
+		Truncated:    text.Truncated,
+	}, nil
+}
+
+// GetUploadStatus returns the progress of a chunked upload
+func (s *Service) GetUploadStatus(ctx context.Context, req *pb.GetUploadStatusRequest) (*pb.UploadStatus, error) {
+	if req.UploadId == "" {
+		return nil, status.Error(codes.InvalidArgument, "upload ID is required")
+	}
+
+	// Get user ID from context
+	userID, ok := ctx.Value("user_id").(string)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not authenticated")
+	}
+
+	uploadID, err := uuid.Parse(req.UploadId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid upload ID format")
+	}
+
+	upload, err := s.uploadRepo.GetByID(ctx, uploadID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Error(codes.NotFound, "upload not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get upload: %v", err)
+	}
+
+	// Uploads are only visible to the user who started them
+	if upload.UploaderID.String() != userID {
+		return nil, status.Error(codes.NotFound, "upload not found")
+	}
+
+	return &pb.UploadStatus{
+		UploadId:      upload.ID.String(),
+		IssueId:       upload.IssueID.String(),
+		Filename:      upload.Filename,
+		TotalSize:     upload.TotalSize,
+		ReceivedBytes: upload.ReceivedBytes,
+		CreatedAt:     timestamppb.New(upload.CreatedAt),
+		UpdatedAt:     timestamppb.New(upload.UpdatedAt),
+	}, nil
+}
+
+// DownloadAttachment streams an attachment's content or thumbnail, optionally
+// limited to a byte range
+func (s *Service) DownloadAttachment(req *pb.DownloadAttachmentRequest, stream pb.IssueService_DownloadAttachmentServer) error {
+	ctx := stream.Context()
+
+	if req.AttachmentId == "" {
+		return status.Error(codes.InvalidArgument, "attachment ID is required")
+	}
+	if req.Offset < 0 || req.Length < 0 {
+		return status.Error(codes.InvalidArgument, "offset and length must not be negative")
+	}
+
+	attachmentID, err := uuid.Parse(req.AttachmentId)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, "invalid attachment ID format")
+	}
+
+	resource := storage.ResourceDownload
+	if req.Thumbnail {
+		resource = storage.ResourceThumbnail
+	}
+
+	// A signed URL stands in for authentication
+	if req.Signature != "" {
+		if !s.urlSigner.Verify(attachmentID, resource, req.Expires, req.Signature) {
+			return status.Error(codes.PermissionDenied, "download link is invalid or has expired")
+		}
+	} else if _, ok := ctx.Value("user_id").(string); !ok {
+		return status.Error(codes.Unauthenticated, "user not authenticated")
+	}
+
+	attachment, err := s.attachmentRepo.GetByID(ctx, attachmentID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return status.Error(codes.NotFound, "attachment not found")
+		}
+		return status.Errorf(codes.Internal, "failed to get attachment: %v", err)
+	}
+	if attachment.StorageKey == "" {
+		return status.Error(codes.FailedPrecondition, "attachment content is not available")
+	}
+	switch attachment.ScanStatus {
+	case models.ScanStatusClean:
+	case models.ScanStatusPending:
+		return status.Error(codes.FailedPrecondition, "attachment is waiting for a malware scan")
+	case models.ScanStatusInfected:
+		return status.Error(codes.FailedPrecondition, "attachment has been quarantined")
+	default:
+		return status.Error(codes.FailedPrecondition, "attachment could not be scanned for malware")
+	}
+
+	size, filename, contentType := attachment.FileSize, attachment.Filename, attachment.ContentType
+
+	// Thumbnails are small, so they are read whole to learn their size
+	var thumbnail []byte
+	if req.Thumbnail {
+		if attachment.ThumbnailKey == "" {
+			return status.Error(codes.NotFound, "attachment has no thumbnail")
+		}
+		thumbnail, err = s.readObject(ctx, attachment.ThumbnailKey, 0, 0)
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to read thumbnail: %v", err)
+		}
+		size = int64(len(thumbnail))
+		filename = strings.TrimSuffix(filename, path.Ext(filename)) + ".thumbnail.png"
+		contentType = preview.ThumbnailContentType
+	}
+
+	if req.Offset > size {
+		return status.Errorf(codes.OutOfRange, "offset %d is beyond the attachment size of %d bytes", req.Offset, size)
+	}
+
+	length := size - req.Offset
+	if req.Length > 0 && req.Length < length {
+		length = req.Length
+	}
+
+	first := &pb.AttachmentChunk{
+		Offset:      req.Offset,
+		TotalSize:   size,
+		Filename:    filename,
+		ContentType: contentType,
+	}
+
+	// Nothing to read; still send the metadata
+	if length == 0 {
+		return stream.Send(first)
+	}
+
+	var body io.ReadCloser
+	if thumbnail != nil {
+		body = io.NopCloser(bytes.NewReader(thumbnail[req.Offset : req.Offset+length]))
+	} else {
+		body, err = s.store.Get(ctx, attachment.StorageKey, req.Offset, length)
+		if err != nil {
+			if err == storage.ErrNotFound {
+				return status.Error(codes.NotFound, "attachment content not found")
+			}
+			return status.Errorf(codes.Internal, "failed to read attachment: %v", err)
+		}
+	}
+	defer body.Close()
+
+	buf := make([]byte, s.storageConfig.ChunkSize)
+	chunk := first
+	offset := req.Offset
+	for {
+		n, err := io.ReadFull(body, buf)
+		if n > 0 {
+			if chunk == nil {
+				chunk = &pb.AttachmentChunk{Offset: offset}
+			}
+			chunk.Data = buf[:n]
+			if err := stream.Send(chunk); err != nil {
+				return err
+			}
+			chunk = nil
+			offset += int64(n)
+		}
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			return nil
+		}
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to read attachment: %v", err)
+		}
+	}
+}
+
+// startUpload creates an upload session or validates the resumption of an existing one
+func (s *Service) startUpload(ctx context.Context, md *pb.UploadMetadata, uploaderID uuid.UUID) (*models.AttachmentUpload, error) {
+	if md.UploadId == "" {
+		return nil, status.Error(codes.InvalidArgument, "upload ID is required")
+	}
+	if md.IssueId == "" {
+		return nil, status.Error(codes.InvalidArgument, "issue ID is required")
+	}
+	if md.Filename == "" {
+		return nil, status.Error(codes.InvalidArgument, "filename is required")
+	}
+	if md.TotalSize < 0 || md.Offset < 0 {
+		return nil, status.Error(codes.InvalidArgument, "total size and offset must not be negative")
+	}
+	if md.TotalSize > s.storageConfig.MaxUploadBytes {
+		return nil, status.Errorf(codes.InvalidArgument, "attachment exceeds the maximum size of %d bytes", s.storageConfig.MaxUploadBytes)
+	}
+
+	uploadID, err := uuid.Parse(md.UploadId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid upload ID format")
+	}
+
+	issueID, err := uuid.Parse(md.IssueId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid issue ID format")
+	}
+
+	upload, err := s.uploadRepo.GetByID(ctx, uploadID)
+	if err == nil {
+		// Resuming an existing upload
+		if upload.UploaderID != uploaderID || upload.IssueID != issueID {
+			return nil, status.Error(codes.PermissionDenied, "upload belongs to a different user or issue")
+		}
+		if md.Offset != upload.ReceivedBytes {
+			return nil, status.Errorf(codes.FailedPrecondition, "offset %d does not match the %d bytes already received", md.Offset, upload.ReceivedBytes)
+		}
+		return upload, nil
+	}
+	if err != sql.ErrNoRows {
+		return nil, status.Errorf(codes.Internal, "failed to get upload: %v", err)
+	}
+
+	if md.Offset != 0 {
+		return nil, status.Errorf(codes.FailedPrecondition, "offset %d does not match the 0 bytes already received", md.Offset)
+	}
+
+	// Make sure the issue exists
+	_, err = s.issueRepo.GetByID(ctx, issueID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Error(codes.NotFound, "issue not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get issue: %v", err)
+	}
+
+	// Reject uploads that are known up front not to fit
+	if err := s.checkQuota(ctx, issueID, md.TotalSize); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	upload = &models.AttachmentUpload{
+		ID:         uploadID,
+		IssueID:    issueID,
+		UploaderID: uploaderID,
+		Filename:   md.Filename,
+		TotalSize:  md.TotalSize,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}
+
+	if err := s.uploadRepo.Create(ctx, upload); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create upload: %v", err)
+	}
+
+	return upload, nil
+}
+
+// storeUploadPart persists a part of an upload and records the progress
+func (s *Service) storeUploadPart(ctx context.Context, upload *models.AttachmentUpload, data []byte) error {
+	key := storage.UploadPartKey(upload.ID, upload.ReceivedBytes)
+	if _, err := s.store.Put(ctx, key, bytes.NewReader(data), int64(len(data)), "application/octet-stream"); err != nil {
+		return status.Errorf(codes.Internal, "failed to store upload part: %v", err)
+	}
+
+	upload.PartOffsets = append(upload.PartOffsets, upload.ReceivedBytes)
+	upload.ReceivedBytes += int64(len(data))
+	upload.UpdatedAt = time.Now()
+
+	if err := s.uploadRepo.UpdateProgress(ctx, upload); err != nil {
+		return status.Errorf(codes.Internal, "failed to update upload: %v", err)
+	}
+
+	return nil
+}
+
+// completeUpload assembles the staged parts into the final attachment
+func (s *Service) completeUpload(ctx context.Context, upload *models.AttachmentUpload) (*models.Attachment, error) {
+	partKeys := uploadPartKeys(upload)
+
+	// Sniff the content type from the start of the first part
+	head, err := s.readObject(ctx, partKeys[0], 0, 512)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to read upload: %v", err)
+	}
+	contentType := storage.DetectContentType(upload.Filename, head)
+
+	// A rejected upload cannot be resumed, so discard what was received
+	if err := s.checkAttachmentPolicy(ctx, upload.IssueID, contentType, upload.ReceivedBytes); err != nil {
+		s.discardUpload(ctx, upload, partKeys)
+		return nil, err
+	}
+
+	attachmentID := uuid.New()
+	key := storage.AttachmentKey(upload.IssueID, attachmentID, upload.Filename)
+	hash := sha256.New()
+	parts := &partsReader{ctx: ctx, store: s.store, keys: partKeys}
+	defer parts.Close()
+
+	fileURL, err := s.store.Put(ctx, key, io.TeeReader(parts, hash), upload.ReceivedBytes, contentType)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to store attachment: %v", err)
+	}
+
+	attachment := &models.Attachment{
+		ID:          attachmentID,
+		IssueID:     upload.IssueID,
+		UploaderID:  upload.UploaderID,
+		Filename:    upload.Filename,
+		FileURL:     fileURL,
+		StorageKey:  key,
+		FileSize:    upload.ReceivedBytes,
+		ContentType: contentType,
+		SHA256:      hex.EncodeToString(hash.Sum(nil)),
+		ScanStatus:  models.ScanStatusPending,
+		CreatedAt:   time.Now(),
+	}
+
+	// Save attachment to database, removing the stored content if that fails
+	if err := s.attachmentRepo.Create(ctx, attachment); err != nil {
+		_ = s.store.Delete(ctx, key)
+		return nil, status.Errorf(codes.Internal, "failed to create attachment: %v", err)
+	}
+
+	// The staged parts are no longer needed
+	s.discardUpload(ctx, upload, partKeys)
+
+	// The attachment becomes downloadable once the scan finds it clean; the
+	// ScanSweeper retries the scan if it is lost
+	go s.scanAttachment(*attachment)
+
+	return attachment, nil
+}
+
+// uploadPartKeys lists the object keys of the parts staged for an upload
+func uploadPartKeys(upload *models.AttachmentUpload) []string {
+	partKeys := make([]string, len(upload.PartOffsets))
+	for i, offset := range upload.PartOffsets {
+		partKeys[i] = storage.UploadPartKey(upload.ID, offset)
+	}
+	return partKeys
+}
+
+// discardUpload removes an upload session and its staged parts
+func (s *Service) discardUpload(ctx context.Context, upload *models.AttachmentUpload, partKeys []string) {
+	for _, partKey := range partKeys {
+		_ = s.store.Delete(ctx, partKey)
+	}
+	_ = s.uploadRepo.Delete(ctx, upload.ID)
+}
+
+// checkAttachmentPolicy enforces the content type lists and storage quotas
+func (s *Service) checkAttachmentPolicy(ctx context.Context, issueID uuid.UUID, contentType string, size int64) error {
+	if !storage.ContentTypeAllowed(contentType, s.storageConfig.AllowedTypes, s.storageConfig.DeniedTypes) {
+		return status.Errorf(codes.InvalidArgument, "attachments of type %s are not allowed", contentType)
+	}
+	return s.checkQuota(ctx, issueID, size)
+}
+
+// checkQuota checks that size more bytes fit in the issue and organization quotas
+func (s *Service) checkQuota(ctx context.Context, issueID uuid.UUID, size int64) error {
+	if s.storageConfig.MaxIssueBytes <= 0 && s.storageConfig.MaxOrgBytes <= 0 {
+		return nil
+	}
+
+	issueBytes, orgBytes, err := s.attachmentRepo.GetStorageUsage(ctx, issueID)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get storage usage: %v", err)
+	}
+
+	if s.storageConfig.MaxIssueBytes > 0 && issueBytes+size > s.storageConfig.MaxIssueBytes {
+		return status.Errorf(codes.ResourceExhausted, "issue attachment quota of %d bytes exceeded (%d bytes used)", s.storageConfig.MaxIssueBytes, issueBytes)
+	}
+	if s.storageConfig.MaxOrgBytes > 0 && orgBytes+size > s.storageConfig.MaxOrgBytes {
+		return status.Errorf(codes.ResourceExhausted, "organization attachment quota of %d bytes exceeded (%d bytes used)", s.storageConfig.MaxOrgBytes, orgBytes)
+	}
+
+	return nil
+}
+
+// scanAttachment scans stored content for malware and records the verdict.
+// Infected content is moved under the quarantine prefix so it is never served.
+func (s *Service) scanAttachment(attachment models.Attachment) {
+	ctx := context.Background()
+
+	result, err := s.scanContent(ctx, attachment.StorageKey)
+	switch {
+	case err != nil:
+		fmt.Printf("Error scanning attachment %s: %v\n", attachment.ID, err)
+		attachment.ScanStatus = models.ScanStatusError
+		attachment.ScanResult = err.Error()
+	case result.Clean:
+		attachment.ScanStatus = models.ScanStatusClean
+		if preview.CanThumbnail(attachment.ContentType) {
+			s.generateThumbnail(ctx, &attachment)
+		}
+	default:
+		attachment.ScanStatus = models.ScanStatusInfected
+		attachment.ScanResult = result.Signature
+		if err := s.quarantine(ctx, &attachment); err != nil {
+			fmt.Printf("Error quarantining attachment %s: %v\n", attachment.ID, err)
+		}
+	}
+
+	if err := s.attachmentRepo.UpdateScanResult(ctx, &attachment); err != nil {
+		fmt.Printf("Error recording scan result for attachment %s: %v\n", attachment.ID, err)
+	}
+}
+
+// generateThumbnail stores a thumbnail of an image attachment alongside the
+// original. Failures are logged; the attachment is usable without one.
+func (s *Service) generateThumbnail(ctx context.Context, attachment *models.Attachment) {
+	r, err := s.store.Get(ctx, attachment.StorageKey, 0, 0)
+	if err != nil {
+		fmt.Printf("Error reading attachment %s for thumbnail: %v\n", attachment.ID, err)
+		return
+	}
+	defer r.Close()
+
+	thumbnail, err := preview.Thumbnail(r, s.storageConfig.ThumbnailSize)
+	if err != nil {
+		fmt.Printf("Error generating thumbnail for attachment %s: %v\n", attachment.ID, err)
+		return
+	}
+
+	key := storage.ThumbnailKey(attachment.StorageKey)
+	if _, err := s.store.Put(ctx, key, bytes.NewReader(thumbnail), int64(len(thumbnail)), preview.ThumbnailContentType); err != nil {
+		fmt.Printf("Error storing thumbnail for attachment %s: %v\n", attachment.ID, err)
+		return
+	}
+
+	if err := s.attachmentRepo.SetThumbnail(ctx, attachment.ID, key); err != nil {
+		_ = s.store.Delete(ctx, key)
+		fmt.Printf("Error recording thumbnail for attachment %s: %v\n", attachment.ID, err)
+		return
+	}
+	attachment.ThumbnailKey = key
+}
+
+// scanContent runs the scanner over the content stored under key
+func (s *Service) scanContent(ctx context.Context, key string) (*scanner.Result, error) {
+	r, err := s.store.Get(ctx, key, 0, 0)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	return s.scanner.Scan(ctx, r)
+}
+
+// quarantine moves an attachment's content under the quarantine prefix
+func (s *Service) quarantine(ctx context.Context, attachment *models.Attachment) error {
+	r, err := s.store.Get(ctx, attachment.StorageKey, 0, 0)
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+
+	key := storage.QuarantineKey(attachment.StorageKey)
+	fileURL, err := s.store.Put(ctx, key, r, attachment.FileSize, attachment.ContentType)
+	if err != nil {
+		return err
+	}
+
+	if err := s.store.Delete(ctx, attachment.StorageKey); err != nil {
+		return err
+	}
+
+	attachment.StorageKey = key
+	attachment.FileURL = fileURL
+	return nil
+}
+
+// attachmentToProto converts a model.Attachment to a protobuf Attachment with a
+// freshly signed download URL
+func (s *Service) attachmentToProto(attachment *models.Attachment) *pb.Attachment {
+	protoAttachment := &pb.Attachment{
+		Id:          attachment.ID.String(),
+		IssueId:     attachment.IssueID.String(),
+		UploaderId:  attachment.UploaderID.String(),
+		Filename:    attachment.Filename,
+		FileSize:    attachment.FileSize,
+		ContentType: attachment.ContentType,
+		Sha256:      attachment.SHA256,
+		ScanStatus:  pb.ScanStatus(pb.ScanStatus_value["SCAN_"+string(attachment.ScanStatus)]),
+		ScanResult:  attachment.ScanResult,
+		CreatedAt:   timestamppb.New(attachment.CreatedAt),
+	}
+
+	// Never expose the storage location of stored content, and only link to
+	// content that has been scanned clean
+	if attachment.StorageKey != "" && attachment.ScanStatus == models.ScanStatusClean {
+		url, expiresAt := s.urlSigner.SignedURL(attachment.ID, storage.ResourceDownload)
+		protoAttachment.FileUrl = url
+		protoAttachment.UrlExpiresAt = timestamppb.New(expiresAt)
+
+		if attachment.ThumbnailKey != "" {
+			protoAttachment.ThumbnailUrl, _ = s.urlSigner.SignedURL(attachment.ID, storage.ResourceThumbnail)
+		}
+	}
+
+	return protoAttachment
+}
+
+// readObject reads a range of a stored object into memory
+func (s *Service) readObject(ctx context.Context, key string, offset, length int64) ([]byte, error) {
+	r, err := s.store.Get(ctx, key, offset, length)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	return io.ReadAll(r)
+}
+
+// previewLinesToProto converts preview lines to protobuf PreviewLines
+func previewLinesToProto(lines []preview.Line) []*pb.PreviewLine {
+	protoLines := make([]*pb.PreviewLine, 0, len(lines))
+	for _, line := range lines {
+		protoLines = append(protoLines, &pb.PreviewLine{
+			Number: line.Number,
+			Text:   line.Text,
+		})
+	}
+	return protoLines
+}
+
+// partsReader reads a sequence of stored parts as one stream, opening each part only when needed
+type partsReader struct {
+	ctx     context.Context
+	store   storage.Storage
+	keys    []string
+	current io.ReadCloser
+}
+
+func (p *partsReader) Read(b []byte) (int, error) {
+	for {
+		if p.current == nil {
+			if len(p.keys) == 0 {
+				return 0, io.EOF
+			}
+			r, err := p.store.Get(p.ctx, p.keys[0], 0, 0)
+			if err != nil {
+				return 0, err
+			}
+			p.current = r
+			p.keys = p.keys[1:]
+		}
+
+		n, err := p.current.Read(b)
+		if err == io.EOF {
+			p.current.Close()
+			p.current = nil
+			if n > 0 {
+				return n, nil
+			}
+			continue
+		}
+		return n, err
+	}
+}
+
+// Close closes the part currently being read
+func (p *partsReader) Close() error {
+	if p.current == nil {
+		return nil
+	}
+	err := p.current.Close()
+	p.current = nil
+	return err
+}
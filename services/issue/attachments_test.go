@@ -0,0 +1,151 @@
+// services/issue/attachments_test.go
+package issue
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/matthewmc1/buganizer/config"
+	"github.com/matthewmc1/buganizer/models"
+	pb "github.com/matthewmc1/buganizer/proto"
+	"github.com/matthewmc1/buganizer/storage"
+)
+
+// memoryUploadRepo is an in-memory AttachmentUploadRepository
+type memoryUploadRepo struct {
+	uploads map[uuid.UUID]*models.AttachmentUpload
+}
+
+func (r *memoryUploadRepo) Create(ctx context.Context, upload *models.AttachmentUpload) error {
+	r.uploads[upload.ID] = upload
+	return nil
+}
+
+func (r *memoryUploadRepo) GetByID(ctx context.Context, id uuid.UUID) (*models.AttachmentUpload, error) {
+	upload, ok := r.uploads[id]
+	if !ok {
+		return nil, sql.ErrNoRows
+	}
+	copied := *upload
+	copied.PartOffsets = append([]int64(nil), upload.PartOffsets...)
+	return &copied, nil
+}
+
+func (r *memoryUploadRepo) UpdateProgress(ctx context.Context, upload *models.AttachmentUpload) error {
+	r.uploads[upload.ID] = upload
+	return nil
+}
+
+func (r *memoryUploadRepo) Delete(ctx context.Context, id uuid.UUID) error {
+	delete(r.uploads, id)
+	return nil
+}
+
+// uploadStream feeds a fixed list of messages to UploadAttachment
+type uploadStream struct {
+	grpc.ServerStream
+	ctx      context.Context
+	messages []*pb.UploadAttachmentRequest
+}
+
+func (s *uploadStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *uploadStream) Recv() (*pb.UploadAttachmentRequest, error) {
+	if len(s.messages) == 0 {
+		return nil, io.EOF
+	}
+	message := s.messages[0]
+	s.messages = s.messages[1:]
+	return message, nil
+}
+
+func (s *uploadStream) SendAndClose(*pb.Attachment) error {
+	return nil
+}
+
+func TestUploadAttachmentDiscardsOversizedUpload(t *testing.T) {
+	tests := []struct {
+		name      string
+		totalSize int64
+		lastChunk int
+	}{
+		{"exceeds the maximum size", 0, 9},
+		{"exceeds the declared size", 12, 5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			store, err := storage.NewLocalStorage(dir)
+			if err != nil {
+				t.Fatal(err)
+			}
+			uploads := &memoryUploadRepo{uploads: make(map[uuid.UUID]*models.AttachmentUpload)}
+			s := &Service{
+				uploadRepo: uploads,
+				store:      store,
+				storageConfig: config.StorageConfig{
+					MaxUploadBytes: 16,
+					UploadPartSize: 4,
+				},
+			}
+
+			// An earlier session already staged the first part
+			userID := uuid.New()
+			upload := &models.AttachmentUpload{
+				ID:         uuid.New(),
+				IssueID:    uuid.New(),
+				UploaderID: userID,
+				Filename:   "big.bin",
+				TotalSize:  tt.totalSize,
+			}
+			uploads.Create(context.Background(), upload)
+			if err := s.storeUploadPart(context.Background(), upload, []byte("0123")); err != nil {
+				t.Fatal(err)
+			}
+
+			stream := &uploadStream{
+				ctx: context.WithValue(context.Background(), "user_id", userID.String()),
+				messages: []*pb.UploadAttachmentRequest{
+					{Data: &pb.UploadAttachmentRequest_Metadata{Metadata: &pb.UploadMetadata{
+						UploadId:  upload.ID.String(),
+						IssueId:   upload.IssueID.String(),
+						Filename:  upload.Filename,
+						TotalSize: tt.totalSize,
+						Offset:    4,
+					}}},
+					{Data: &pb.UploadAttachmentRequest_Chunk{Chunk: []byte("4567")}},
+					{Data: &pb.UploadAttachmentRequest_Chunk{Chunk: bytes.Repeat([]byte("x"), tt.lastChunk)}},
+				},
+			}
+
+			err = s.UploadAttachment(stream)
+			if status.Code(err) != codes.InvalidArgument {
+				t.Fatalf("UploadAttachment error = %v, want InvalidArgument", err)
+			}
+
+			if _, ok := uploads.uploads[upload.ID]; ok {
+				t.Error("upload session was kept")
+			}
+			parts, err := os.ReadDir(filepath.Join(dir, "uploads", upload.ID.String()))
+			if err != nil && !os.IsNotExist(err) {
+				t.Fatal(err)
+			}
+			if len(parts) != 0 {
+				t.Errorf("%d staged parts were kept", len(parts))
+			}
+		})
+	}
+}
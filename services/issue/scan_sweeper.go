@@ -0,0 +1,73 @@
+// services/issue/scan_sweeper.go
+package issue
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/matthewmc1/buganizer/config"
+)
+
+// scanSweepBatchSize is how many attachments are claimed for rescanning at once
+const scanSweepBatchSize = 20
+
+// ScanSweeper rescans attachments left pending because their scan never
+// finished, e.g. when the server restarted mid-scan. Such attachments would
+// otherwise never become downloadable.
+type ScanSweeper struct {
+	service    *Service
+	interval   time.Duration
+	retryAfter time.Duration
+}
+
+// NewScanSweeper creates a new scan sweeper
+func NewScanSweeper(service *Service, cfg config.ScannerConfig) *ScanSweeper {
+	return &ScanSweeper{
+		service:    service,
+		interval:   time.Duration(cfg.SweepIntervalMinutes) * time.Minute,
+		retryAfter: time.Duration(cfg.RetryAfterMinutes) * time.Minute,
+	}
+}
+
+// Run rescans lost scans at startup and then on every interval until ctx is
+// cancelled. It does nothing when the interval is not positive.
+func (w *ScanSweeper) Run(ctx context.Context) {
+	if w.interval <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		if err := w.sweep(ctx); err != nil {
+			fmt.Printf("Error rescanning attachments: %v\n", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// sweep claims and scans pending attachments in batches until none are left
+func (w *ScanSweeper) sweep(ctx context.Context) error {
+	for ctx.Err() == nil {
+		attachments, err := w.service.attachmentRepo.ClaimPendingScans(ctx, time.Now().Add(-w.retryAfter), scanSweepBatchSize)
+		if err != nil {
+			return err
+		}
+
+		for _, attachment := range attachments {
+			w.service.scanAttachment(*attachment)
+		}
+
+		if len(attachments) < scanSweepBatchSize {
+			return nil
+		}
+	}
+	return nil
+}
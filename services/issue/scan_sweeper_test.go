@@ -0,0 +1,147 @@
+// services/issue/scan_sweeper_test.go
+package issue
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/matthewmc1/buganizer/config"
+	"github.com/matthewmc1/buganizer/models"
+	"github.com/matthewmc1/buganizer/repositories"
+	"github.com/matthewmc1/buganizer/scanner"
+	"github.com/matthewmc1/buganizer/storage"
+)
+
+// pendingScanRepo is an AttachmentRepository holding attachments waiting for a scan
+type pendingScanRepo struct {
+	repositories.AttachmentRepository
+	pending     []*models.Attachment
+	staleBefore time.Time
+	results     map[uuid.UUID]models.Attachment
+}
+
+func (r *pendingScanRepo) ClaimPendingScans(ctx context.Context, staleBefore time.Time, limit int) ([]*models.Attachment, error) {
+	r.staleBefore = staleBefore
+	n := min(limit, len(r.pending))
+	claimed := r.pending[:n]
+	r.pending = r.pending[n:]
+	return claimed, nil
+}
+
+func (r *pendingScanRepo) UpdateScanResult(ctx context.Context, attachment *models.Attachment) error {
+	r.results[attachment.ID] = *attachment
+	return nil
+}
+
+// signatureScanner reports content containing a marker as infected
+type signatureScanner struct {
+	marker string
+}
+
+func (s signatureScanner) Scan(ctx context.Context, r io.Reader) (*scanner.Result, error) {
+	content, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	if strings.Contains(string(content), s.marker) {
+		return &scanner.Result{Clean: false, Signature: "Test-Signature"}, nil
+	}
+	return &scanner.Result{Clean: true}, nil
+}
+
+func TestScanSweeperRescansPendingAttachments(t *testing.T) {
+	store, err := storage.NewLocalStorage(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+
+	// More attachments than fit in one batch, every third one infected
+	repo := &pendingScanRepo{results: make(map[uuid.UUID]models.Attachment)}
+	for i := 0; i < scanSweepBatchSize+5; i++ {
+		content := "log line"
+		if i%3 == 0 {
+			content = "MALWARE"
+		}
+		attachment := &models.Attachment{
+			ID:          uuid.New(),
+			IssueID:     uuid.New(),
+			Filename:    "a.log",
+			FileSize:    int64(len(content)),
+			ContentType: "text/plain",
+			ScanStatus:  models.ScanStatusPending,
+		}
+		attachment.StorageKey = storage.AttachmentKey(attachment.IssueID, attachment.ID, attachment.Filename)
+		if _, err := store.Put(ctx, attachment.StorageKey, strings.NewReader(content), attachment.FileSize, attachment.ContentType); err != nil {
+			t.Fatal(err)
+		}
+		repo.pending = append(repo.pending, attachment)
+	}
+	all := append([]*models.Attachment(nil), repo.pending...)
+
+	s := &Service{
+		attachmentRepo: repo,
+		store:          store,
+		scanner:        signatureScanner{marker: "MALWARE"},
+	}
+	sweeper := NewScanSweeper(s, config.ScannerConfig{SweepIntervalMinutes: 5, RetryAfterMinutes: 15})
+
+	before := time.Now()
+	if err := sweeper.sweep(ctx); err != nil {
+		t.Fatalf("sweep: %v", err)
+	}
+
+	if len(repo.pending) != 0 {
+		t.Errorf("%d attachments were not claimed", len(repo.pending))
+	}
+	if drift := repo.staleBefore.Sub(before.Add(-15 * time.Minute)); drift < 0 || drift > time.Minute {
+		t.Errorf("claimed scans started before %s, want 15 minutes before %s", repo.staleBefore, before)
+	}
+
+	for i, attachment := range all {
+		result, ok := repo.results[attachment.ID]
+		if !ok {
+			t.Errorf("attachment %d was not rescanned", i)
+			continue
+		}
+
+		if i%3 != 0 {
+			if result.ScanStatus != models.ScanStatusClean {
+				t.Errorf("attachment %d: status %s, want CLEAN", i, result.ScanStatus)
+			}
+			continue
+		}
+
+		if result.ScanStatus != models.ScanStatusInfected || result.ScanResult != "Test-Signature" {
+			t.Errorf("attachment %d: status %s (%s), want INFECTED", i, result.ScanStatus, result.ScanResult)
+		}
+		if result.StorageKey != storage.QuarantineKey(attachment.StorageKey) {
+			t.Errorf("attachment %d: stored under %s, want the quarantine prefix", i, result.StorageKey)
+		}
+		if _, err := store.Get(ctx, attachment.StorageKey, 0, 0); !errors.Is(err, storage.ErrNotFound) {
+			t.Errorf("attachment %d: infected content still under its original key", i)
+		}
+	}
+}
+
+func TestScanSweeperDisabled(t *testing.T) {
+	sweeper := NewScanSweeper(&Service{}, config.ScannerConfig{SweepIntervalMinutes: 0})
+
+	done := make(chan struct{})
+	go func() {
+		sweeper.Run(context.Background())
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("Run did not return with sweeping disabled")
+	}
+}
@@ -2,19 +2,28 @@
 package issue
 
 import (
+	"bytes"
 	"context"
+	"crypto/sha256"
 	"database/sql"
+	"encoding/hex"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/matthewmc1/buganizer/config"
 	"github.com/matthewmc1/buganizer/models"
 	pb "github.com/matthewmc1/buganizer/proto"
 	"github.com/matthewmc1/buganizer/repositories"
+	"github.com/matthewmc1/buganizer/scanner"
+	"github.com/matthewmc1/buganizer/services/notification"
+	"github.com/matthewmc1/buganizer/storage"
 )
 
 // Service implements the IssueService gRPC interface
@@ -23,8 +32,15 @@ type Service struct {
 	issueRepo      repositories.IssueRepository
 	commentRepo    repositories.CommentRepository
 	attachmentRepo repositories.AttachmentRepository
+	uploadRepo     repositories.AttachmentUploadRepository
+	watcherRepo    repositories.WatcherRepository
+	historyRepo    repositories.IssueHistoryRepository
+	userRepo       repositories.UserRepository
+	store          storage.Storage
+	storageConfig  config.StorageConfig
+	urlSigner      *storage.URLSigner
+	scanner        scanner.Scanner
 	slaService     pb.SLAServiceClient
-	notifService   pb.NotificationServiceClient
 }
 
 // modelToProto converts a model.Issue to a protobuf Issue
@@ -49,6 +65,15 @@ func (s *Service) modelToProto(issue *models.Issue) *pb.Issue {
 	if issue.DueDate != nil {
 		protoIssue.DueDate = timestamppb.New(*issue.DueDate)
 	}
+	if issue.ResponseDueDate != nil {
+		protoIssue.ResponseDueDate = timestamppb.New(*issue.ResponseDueDate)
+	}
+	if issue.FirstResponseAt != nil {
+		protoIssue.FirstResponseAt = timestamppb.New(*issue.FirstResponseAt)
+	}
+	if issue.ResolvedAt != nil {
+		protoIssue.ResolvedAt = timestamppb.New(*issue.ResolvedAt)
+	}
 
 	// Map priority and severity
 	switch issue.Priority {
@@ -83,15 +108,29 @@ func NewService(
 	issueRepo repositories.IssueRepository,
 	commentRepo repositories.CommentRepository,
 	attachmentRepo repositories.AttachmentRepository,
+	uploadRepo repositories.AttachmentUploadRepository,
+	watcherRepo repositories.WatcherRepository,
+	historyRepo repositories.IssueHistoryRepository,
+	userRepo repositories.UserRepository,
+	store storage.Storage,
+	storageConfig config.StorageConfig,
+	urlSigner *storage.URLSigner,
+	scanner scanner.Scanner,
 	slaService pb.SLAServiceClient,
-	notifService pb.NotificationServiceClient,
 ) *Service {
 	return &Service{
 		issueRepo:      issueRepo,
 		commentRepo:    commentRepo,
 		attachmentRepo: attachmentRepo,
+		uploadRepo:     uploadRepo,
+		watcherRepo:    watcherRepo,
+		historyRepo:    historyRepo,
+		userRepo:       userRepo,
+		store:          store,
+		storageConfig:  storageConfig,
+		urlSigner:      urlSigner,
+		scanner:        scanner,
 		slaService:     slaService,
-		notifService:   notifService,
 	}
 }
 
@@ -137,18 +176,34 @@ func (s *Service) CreateIssue(ctx context.Context, req *pb.CreateIssueRequest) (
 		dueDate := slaResp.TargetDate.AsTime()
 		issue.DueDate = &dueDate
 	}
-
-	// Save issue to database
-	if err := s.issueRepo.Create(ctx, issue); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to create issue: %v", err)
+	if err == nil && slaResp != nil && slaResp.ResponseTargetDate != nil {
+		responseDueDate := slaResp.ResponseTargetDate.AsTime()
+		issue.ResponseDueDate = &responseDueDate
 	}
 
-	// Send notification
-	_, _ = s.notifService.SendSlackNotification(ctx, &pb.NotificationRequest{
+	// Notify about the issue once it is saved
+	messages, err := notifications(&pb.NotificationRequest{
 		IssueId: issue.ID.String(),
 		Type:    pb.NotificationRequest_ISSUE_CREATED,
 		Message: fmt.Sprintf("New issue created: %s", issue.Title),
+		ActorId: userID,
 	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create notification: %v", err)
+	}
+
+	// Save issue to database
+	if err := s.issueRepo.CreateWithOutbox(ctx, issue, messages); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create issue: %v", err)
+	}
+
+	// The reporter automatically watches the issue
+	s.addWatcher(ctx, issue.ID, issue.ReporterID, models.WatchReasonReporter)
+
+	// Labels may pause the SLA clock from the start
+	if len(issue.Labels) > 0 {
+		s.syncSLAClock(ctx, issue)
+	}
 
 	// Convert to protobuf response
 	return s.modelToProto(issue), nil
@@ -196,7 +251,8 @@ func (s *Service) UpdateIssue(ctx context.Context, req *pb.UpdateIssueRequest) (
 		return nil, status.Errorf(codes.Internal, "failed to get issue: %v", err)
 	}
 
-	// Check for status changes
+	// Keep the previous values for the issue history
+	before := *issue
 	oldStatus := issue.Status
 	statusChanged := false
 
@@ -217,13 +273,13 @@ func (s *Service) UpdateIssue(ctx context.Context, req *pb.UpdateIssueRequest) (
 		assigneeID := uuid.MustParse(req.AssigneeId)
 		issue.AssigneeID = &assigneeID
 	}
-	if req.Priority != pb.Priority_P0 {
+	if req.Priority != nil {
 		issue.Priority = models.Priority(req.Priority.String())
 	}
-	if req.Severity != pb.Severity_S0 {
+	if req.Severity != nil {
 		issue.Severity = models.Severity(req.Severity.String())
 	}
-	if req.Status != pb.Status_NEW {
+	if req.Status != nil {
 		newStatus := models.Status(req.Status.String())
 		if newStatus != issue.Status {
 			statusChanged = true
@@ -236,28 +292,79 @@ func (s *Service) UpdateIssue(ctx context.Context, req *pb.UpdateIssueRequest) (
 
 	issue.UpdatedAt = time.Now()
 
-	// Save updated issue to database
-	if err := s.issueRepo.Update(ctx, issue); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to update issue: %v", err)
-	}
+	// Notify about the changed fields once they are saved
+	actorID, _ := ctx.Value("user_id").(string)
+	changes := issueChanges(&before, issue)
 
-	// Send notification if status changed
+	var notificationReqs []*pb.NotificationRequest
 	if statusChanged {
-		_, _ = s.notifService.SendSlackNotification(ctx, &pb.NotificationRequest{
+		notificationReqs = append(notificationReqs, &pb.NotificationRequest{
 			IssueId: issue.ID.String(),
 			Type:    pb.NotificationRequest_ISSUE_UPDATED,
 			Message: fmt.Sprintf("Issue status changed from %s to %s: %s", oldStatus, issue.Status, issue.Title),
+			ActorId: actorID,
+			Changes: changes,
+		})
+	} else if hasFieldEdits(changes) {
+		// Assignee changes alone are covered by ISSUE_ASSIGNED
+		notificationReqs = append(notificationReqs, &pb.NotificationRequest{
+			IssueId: issue.ID.String(),
+			Type:    pb.NotificationRequest_ISSUE_UPDATED,
+			Message: fmt.Sprintf("Issue updated: %s", issue.Title),
+			ActorId: actorID,
+			Changes: changes,
 		})
 	}
-
-	// If assignee changed, send notification
 	if req.AssigneeId != "" {
-		_, _ = s.notifService.SendSlackNotification(ctx, &pb.NotificationRequest{
+		notificationReqs = append(notificationReqs, &pb.NotificationRequest{
 			IssueId: issue.ID.String(),
 			Type:    pb.NotificationRequest_ISSUE_ASSIGNED,
 			Message: fmt.Sprintf("Issue assigned: %s", issue.Title),
+			ActorId: actorID,
+			Changes: changes,
 		})
 	}
+	messages, err := notifications(notificationReqs...)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create notification: %v", err)
+	}
+
+	// Save updated issue to database
+	if err := s.issueRepo.UpdateWithOutbox(ctx, issue, messages); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update issue: %v", err)
+	}
+
+	s.recordHistory(ctx, &before, issue)
+
+	// The assignee automatically watches the issue
+	if req.AssigneeId != "" {
+		s.addWatcher(ctx, issue.ID, *issue.AssigneeID, models.WatchReasonAssignee)
+	}
+
+	// The SLA targets depend on priority, severity and component
+	if issue.Priority != before.Priority || issue.Severity != before.Severity || issue.ComponentID != before.ComponentID {
+		s.recalculateSLA(ctx, issue)
+	}
+
+	// Moving the issue out of NEW is its first response
+	if statusChanged && isNewStatus(oldStatus) {
+		s.recordFirstResponse(ctx, issue, issue.UpdatedAt)
+	}
+
+	// The first fix resolves the issue for its SLA statistics
+	if statusChanged && issue.Status.IsFixed() {
+		s.recordResolution(ctx, issue, issue.UpdatedAt)
+	}
+
+	// Waiting statuses and labels pause the SLA clock
+	if statusChanged || len(req.Labels) > 0 {
+		s.syncSLAClock(ctx, issue)
+	}
+
+	// Resolving the issue stops its escalation
+	if statusChanged && issue.Status.IsResolved() {
+		s.cancelEscalations(ctx, issue)
+	}
 
 	return s.modelToProto(issue), nil
 }
@@ -274,6 +381,11 @@ func (s *Service) ListIssues(ctx context.Context, req *pb.ListIssuesRequest) (*p
 		filter = "is:open" // Default filter shows open issues
 	}
 
+	// Resolve "cc:me" to the current user
+	if userID, ok := ctx.Value("user_id").(string); ok {
+		filter = models.ResolveCCMe(filter, userID)
+	}
+
 	// Parse the page token (offset)
 	offset := 0
 	if req.PageToken != "" {
@@ -327,7 +439,7 @@ func (s *Service) AddComment(ctx context.Context, req *pb.AddCommentRequest) (*p
 	}
 
 	// Make sure the issue exists
-	_, err = s.issueRepo.GetByID(ctx, issueID)
+	issue, err := s.issueRepo.GetByID(ctx, issueID)
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return nil, status.Error(codes.NotFound, "issue not found")
@@ -345,17 +457,31 @@ func (s *Service) AddComment(ctx context.Context, req *pb.AddCommentRequest) (*p
 		UpdatedAt: time.Now(),
 	}
 
+	// Notify about the comment once it is saved
+	messages, err := notifications(&pb.NotificationRequest{
+		IssueId:        req.IssueId,
+		Type:           pb.NotificationRequest_COMMENT_ADDED,
+		Message:        fmt.Sprintf("New comment added to issue"),
+		ActorId:        userID,
+		CommentId:      comment.ID.String(),
+		CommentContent: comment.Content,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create notification: %v", err)
+	}
+
 	// Save comment to database
-	if err := s.commentRepo.Create(ctx, comment); err != nil {
+	if err := s.commentRepo.CreateWithOutbox(ctx, comment, messages); err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to create comment: %v", err)
 	}
 
-	// Send notification
-	_, _ = s.notifService.SendSlackNotification(ctx, &pb.NotificationRequest{
-		IssueId: req.IssueId,
-		Type:    pb.NotificationRequest_COMMENT_ADDED,
-		Message: fmt.Sprintf("New comment added to issue"),
-	})
+	// Commenters automatically watch the issue
+	s.addWatcher(ctx, issueID, comment.AuthorID, models.WatchReasonCommenter)
+
+	// The assignee's first comment is the issue's first response
+	if issue.AssigneeID != nil && *issue.AssigneeID == comment.AuthorID {
+		s.recordFirstResponse(ctx, issue, comment.CreatedAt)
+	}
 
 	// Convert to protobuf response
 	return &pb.Comment{
@@ -379,6 +505,9 @@ func (s *Service) AddAttachment(ctx context.Context, req *pb.AddAttachmentReques
 	if len(req.Content) == 0 {
 		return nil, status.Error(codes.InvalidArgument, "attachment content is required")
 	}
+	if int64(len(req.Content)) > s.storageConfig.MaxUploadBytes {
+		return nil, status.Errorf(codes.InvalidArgument, "attachment exceeds the maximum size of %d bytes", s.storageConfig.MaxUploadBytes)
+	}
 
 	// Get user ID from context
 	userID, ok := ctx.Value("user_id").(string)
@@ -400,35 +529,481 @@ func (s *Service) AddAttachment(ctx context.Context, req *pb.AddAttachmentReques
 		return nil, status.Errorf(codes.Internal, "failed to get issue: %v", err)
 	}
 
-	// In a real implementation, we would store the file in a storage service
-	// and get back a URL. For this example, we'll simulate that.
-	fileID := uuid.New()
-	fileURL := fmt.Sprintf("https://storage.example.com/files/%s", fileID)
+	// Store the content and record its digest and type
+	attachmentID := uuid.New()
+	key := storage.AttachmentKey(issueID, attachmentID, req.Filename)
+	digest := sha256.Sum256(req.Content)
+	contentType := storage.DetectContentType(req.Filename, req.Content)
 
-	// Create attachment
-	attachment := &models.Attachment{
-		ID:         fileID,
-		IssueID:    issueID,
-		UploaderID: uuid.MustParse(userID),
-		Filename:   req.Filename,
-		FileURL:    fileURL,
-		FileSize:   int64(len(req.Content)),
-		CreatedAt:  time.Now(),
+	if err := s.checkAttachmentPolicy(ctx, issueID, contentType, int64(len(req.Content))); err != nil {
+		return nil, err
 	}
 
-	// Save attachment to database
+	fileURL, err := s.store.Put(ctx, key, bytes.NewReader(req.Content), int64(len(req.Content)), contentType)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to store attachment: %v", err)
+	}
+
+	// Create attachment
+	attachment := &models.Attachment{
+		ID:          attachmentID,
+		IssueID:     issueID,
+		UploaderID:  uuid.MustParse(userID),
+		Filename:    req.Filename,
+		FileURL:     fileURL,
+		StorageKey:  key,
+		FileSize:    int64(len(req.Content)),
+		ContentType: contentType,
+		SHA256:      hex.EncodeToString(digest[:]),
+		ScanStatus:  models.ScanStatusPending,
+		CreatedAt:   time.Now(),
+	}
+
+	// Save attachment to database, removing the stored content if that fails
 	if err := s.attachmentRepo.Create(ctx, attachment); err != nil {
+		_ = s.store.Delete(ctx, key)
 		return nil, status.Errorf(codes.Internal, "failed to create attachment: %v", err)
 	}
 
+	// The attachment becomes downloadable once the scan finds it clean; the
+	// ScanSweeper retries the scan if it is lost
+	go s.scanAttachment(*attachment)
+
 	// Convert to protobuf response
-	return &pb.Attachment{
-		Id:         attachment.ID.String(),
-		IssueId:    attachment.IssueID.String(),
-		UploaderId: attachment.UploaderID.String(),
-		Filename:   attachment.Filename,
-		FileUrl:    attachment.FileURL,
-		FileSize:   attachment.FileSize,
-		CreatedAt:  timestamppb.New(attachment.CreatedAt),
-	}, nil
+	return s.attachmentToProto(attachment), nil
+}
+
+// WatchIssue adds a user to an issue's watchers
+func (s *Service) WatchIssue(ctx context.Context, req *pb.WatchIssueRequest) (*pb.Watcher, error) {
+	if req.IssueId == "" {
+		return nil, status.Error(codes.InvalidArgument, "issue ID is required")
+	}
+
+	// Get user ID from context
+	currentUserID, ok := ctx.Value("user_id").(string)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not authenticated")
+	}
+
+	issueID, err := uuid.Parse(req.IssueId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid issue ID format")
+	}
+
+	// Default to watching as the current user
+	userID := currentUserID
+	if req.UserId != "" {
+		userID = req.UserId
+	}
+	watcherID, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user ID format")
+	}
+
+	issue, err := s.issueRepo.GetByID(ctx, issueID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Error(codes.NotFound, "issue not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get issue: %v", err)
+	}
+
+	// Only the reporter, the assignee or an admin may add someone else
+	if userID != currentUserID {
+		if err := s.checkWatcherManager(ctx, issue, currentUserID, "add other watchers"); err != nil {
+			return nil, err
+		}
+
+		if _, err := s.userRepo.GetByID(ctx, watcherID); err != nil {
+			if err == sql.ErrNoRows {
+				return nil, status.Error(codes.NotFound, "user not found")
+			}
+			return nil, status.Errorf(codes.Internal, "failed to get user: %v", err)
+		}
+	}
+
+	watcher := &models.IssueWatcher{
+		IssueID:   issueID,
+		UserID:    watcherID,
+		Reason:    models.WatchReasonCC,
+		CreatedAt: time.Now(),
+	}
+
+	if err := s.watcherRepo.Add(ctx, watcher); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to watch issue: %v", err)
+	}
+
+	return watcherToProto(watcher), nil
+}
+
+// UnwatchIssue removes a user from an issue's watchers
+func (s *Service) UnwatchIssue(ctx context.Context, req *pb.UnwatchIssueRequest) (*emptypb.Empty, error) {
+	if req.IssueId == "" {
+		return nil, status.Error(codes.InvalidArgument, "issue ID is required")
+	}
+
+	// Get user ID from context
+	currentUserID, ok := ctx.Value("user_id").(string)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not authenticated")
+	}
+
+	issueID, err := uuid.Parse(req.IssueId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid issue ID format")
+	}
+
+	userID := currentUserID
+	if req.UserId != "" {
+		userID = req.UserId
+	}
+	watcherID, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user ID format")
+	}
+
+	issue, err := s.issueRepo.GetByID(ctx, issueID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Error(codes.NotFound, "issue not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get issue: %v", err)
+	}
+
+	// Only the reporter, the assignee or an admin may remove someone else
+	if userID != currentUserID {
+		if err := s.checkWatcherManager(ctx, issue, currentUserID, "remove other watchers"); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.watcherRepo.Remove(ctx, issueID, watcherID); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to unwatch issue: %v", err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// checkWatcherManager checks that a user may change who else watches an
+// issue: its reporter, its assignee or an admin
+func (s *Service) checkWatcherManager(ctx context.Context, issue *models.Issue, userID, action string) error {
+	if issue.ReporterID.String() == userID || (issue.AssigneeID != nil && issue.AssigneeID.String() == userID) {
+		return nil
+	}
+
+	isAdmin, err := s.userRepo.IsAdmin(ctx, uuid.MustParse(userID))
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to check permissions: %v", err)
+	}
+	if !isAdmin {
+		return status.Errorf(codes.PermissionDenied, "only the reporter, the assignee or an admin can %s", action)
+	}
+
+	return nil
+}
+
+// ListWatchers lists the watchers of an issue
+func (s *Service) ListWatchers(ctx context.Context, req *pb.ListWatchersRequest) (*pb.ListWatchersResponse, error) {
+	if req.IssueId == "" {
+		return nil, status.Error(codes.InvalidArgument, "issue ID is required")
+	}
+
+	// Get user ID from context
+	if _, ok := ctx.Value("user_id").(string); !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not authenticated")
+	}
+
+	issueID, err := uuid.Parse(req.IssueId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid issue ID format")
+	}
+
+	// Make sure the issue exists
+	if _, err := s.issueRepo.GetByID(ctx, issueID); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Error(codes.NotFound, "issue not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get issue: %v", err)
+	}
+
+	watchers, err := s.watcherRepo.ListByIssue(ctx, issueID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list watchers: %v", err)
+	}
+
+	response := &pb.ListWatchersResponse{
+		Watchers: make([]*pb.Watcher, len(watchers)),
+	}
+
+	for i, watcher := range watchers {
+		response.Watchers[i] = watcherToProto(watcher)
+	}
+
+	return response, nil
+}
+
+// ListIssueHistory lists the changes made to an issue, newest first
+func (s *Service) ListIssueHistory(ctx context.Context, req *pb.ListIssueHistoryRequest) (*pb.ListIssueHistoryResponse, error) {
+	if req.IssueId == "" {
+		return nil, status.Error(codes.InvalidArgument, "issue ID is required")
+	}
+
+	issueID, err := uuid.Parse(req.IssueId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid issue ID format")
+	}
+
+	entries, err := s.historyRepo.ListByIssue(ctx, issueID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list issue history: %v", err)
+	}
+
+	response := &pb.ListIssueHistoryResponse{
+		Entries: make([]*pb.IssueHistoryEntry, len(entries)),
+	}
+
+	for i, entry := range entries {
+		response.Entries[i] = historyToProto(entry)
+	}
+
+	return response, nil
+}
+
+// Helper functions
+
+// addWatcher adds a user to an issue's watchers; failures don't block the calling operation
+func (s *Service) addWatcher(ctx context.Context, issueID, userID uuid.UUID, reason models.WatchReason) {
+	_ = s.watcherRepo.Add(ctx, &models.IssueWatcher{
+		IssueID:   issueID,
+		UserID:    userID,
+		Reason:    reason,
+		CreatedAt: time.Now(),
+	})
+}
+
+// recordFirstResponse records the first response to an issue for its response
+// SLA. Later responses are ignored.
+func (s *Service) recordFirstResponse(ctx context.Context, issue *models.Issue, at time.Time) {
+	if issue.FirstResponseAt != nil {
+		return
+	}
+
+	recorded, err := s.issueRepo.RecordFirstResponse(ctx, issue.ID, at)
+	if err != nil {
+		fmt.Printf("Error recording first response to issue %s: %v\n", issue.ID, err)
+		return
+	}
+	if recorded {
+		issue.FirstResponseAt = &at
+	}
+}
+
+// recordResolution records when an issue was first fixed, unless it already
+// has been
+func (s *Service) recordResolution(ctx context.Context, issue *models.Issue, at time.Time) {
+	if issue.ResolvedAt != nil {
+		return
+	}
+
+	recorded, err := s.issueRepo.RecordResolution(ctx, issue.ID, at)
+	if err != nil {
+		fmt.Printf("Error recording resolution of issue %s: %v\n", issue.ID, err)
+		return
+	}
+	if recorded {
+		issue.ResolvedAt = &at
+	}
+}
+
+// recordHistory records the fields changed by an update; failures don't block
+// the update
+func (s *Service) recordHistory(ctx context.Context, before, after *models.Issue) {
+	var actorID *uuid.UUID
+	if userID, ok := ctx.Value("user_id").(string); ok {
+		if id, err := uuid.Parse(userID); err == nil {
+			actorID = &id
+		}
+	}
+
+	changes := []struct {
+		field    string
+		old, new string
+	}{
+		{"priority", string(before.Priority), string(after.Priority)},
+		{"severity", string(before.Severity), string(after.Severity)},
+		{"status", string(before.Status), string(after.Status)},
+		{"component_id", before.ComponentID.String(), after.ComponentID.String()},
+		{"assignee_id", uuidString(before.AssigneeID), uuidString(after.AssigneeID)},
+	}
+
+	var entries []*models.IssueHistory
+	for _, c := range changes {
+		if c.old == c.new {
+			continue
+		}
+		entries = append(entries, &models.IssueHistory{
+			ID:        uuid.New(),
+			IssueID:   after.ID,
+			ActorID:   actorID,
+			Field:     c.field,
+			OldValue:  c.old,
+			NewValue:  c.new,
+			CreatedAt: after.UpdatedAt,
+		})
+	}
+
+	if err := s.historyRepo.Create(ctx, entries...); err != nil {
+		fmt.Printf("Error recording history for issue %s: %v\n", after.ID, err)
+	}
+}
+
+// recalculateSLA recalculates an issue's SLA deadlines after a change to its
+// priority, severity or component, picking up the new deadlines
+func (s *Service) recalculateSLA(ctx context.Context, issue *models.Issue) {
+	target, err := s.slaService.RecalculateSLA(ctx, &pb.RecalculateSLARequest{
+		IssueId: issue.ID.String(),
+	})
+	if err != nil {
+		fmt.Printf("Error recalculating SLA for issue %s: %v\n", issue.ID, err)
+		return
+	}
+
+	if target.TargetDate != nil {
+		dueDate := target.TargetDate.AsTime()
+		issue.DueDate = &dueDate
+	}
+	if target.ResponseTargetDate != nil {
+		responseDueDate := target.ResponseTargetDate.AsTime()
+		issue.ResponseDueDate = &responseDueDate
+	}
+}
+
+// cancelEscalations cancels the escalation steps still pending for a
+// resolved issue
+func (s *Service) cancelEscalations(ctx context.Context, issue *models.Issue) {
+	if _, err := s.slaService.CancelEscalations(ctx, &pb.CancelEscalationsRequest{
+		IssueId: issue.ID.String(),
+	}); err != nil {
+		fmt.Printf("Error cancelling escalations for issue %s: %v\n", issue.ID, err)
+	}
+}
+
+// syncSLAClock pauses or resumes an issue's SLA clock to match its status and
+// labels, picking up any deadlines moved by a resume
+func (s *Service) syncSLAClock(ctx context.Context, issue *models.Issue) {
+	clock, err := s.slaService.SyncSLAClock(ctx, &pb.SyncSLAClockRequest{
+		IssueId: issue.ID.String(),
+	})
+	if err != nil {
+		fmt.Printf("Error syncing SLA clock for issue %s: %v\n", issue.ID, err)
+		return
+	}
+
+	if clock.DueDate != nil {
+		dueDate := clock.DueDate.AsTime()
+		issue.DueDate = &dueDate
+	}
+	if clock.ResponseDueDate != nil {
+		responseDueDate := clock.ResponseDueDate.AsTime()
+		issue.ResponseDueDate = &responseDueDate
+	}
+}
+
+// issueChanges lists the fields an update changed, for notifications
+func issueChanges(before, after *models.Issue) []*pb.FieldChange {
+	fields := []struct {
+		field    string
+		old, new string
+	}{
+		{"title", before.Title, after.Title},
+		{"description", before.Description, after.Description},
+		{"reproduce_steps", before.ReproduceSteps, after.ReproduceSteps},
+		{"component_id", before.ComponentID.String(), after.ComponentID.String()},
+		{"assignee_id", uuidString(before.AssigneeID), uuidString(after.AssigneeID)},
+		{"priority", string(before.Priority), string(after.Priority)},
+		{"severity", string(before.Severity), string(after.Severity)},
+		{"status", string(before.Status), string(after.Status)},
+		{"labels", strings.Join(before.Labels, ","), strings.Join(after.Labels, ",")},
+	}
+
+	var changes []*pb.FieldChange
+	for _, f := range fields {
+		if f.old == f.new {
+			continue
+		}
+		changes = append(changes, &pb.FieldChange{
+			Field:    f.field,
+			OldValue: f.old,
+			NewValue: f.new,
+		})
+	}
+	return changes
+}
+
+// hasFieldEdits reports whether changes include fields other than the
+// assignee
+func hasFieldEdits(changes []*pb.FieldChange) bool {
+	for _, change := range changes {
+		if change.Field != "assignee_id" {
+			return true
+		}
+	}
+	return false
+}
+
+// notifications wraps notifications in outbox messages, to be saved with the
+// change they describe
+func notifications(reqs ...*pb.NotificationRequest) ([]*models.OutboxMessage, error) {
+	messages := make([]*models.OutboxMessage, 0, len(reqs))
+	for _, req := range reqs {
+		message, err := notification.NewNotificationMessage(req)
+		if err != nil {
+			return nil, err
+		}
+		messages = append(messages, message)
+	}
+	return messages, nil
+}
+
+// isNewStatus checks if a status is NEW. Issues are created with the model
+// value while updates store the protobuf enum name.
+func isNewStatus(s models.Status) bool {
+	return s == models.StatusNew || s == models.Status(pb.Status_NEW.String())
+}
+
+// watcherToProto converts a model.IssueWatcher to a protobuf Watcher
+func watcherToProto(watcher *models.IssueWatcher) *pb.Watcher {
+	return &pb.Watcher{
+		IssueId:   watcher.IssueID.String(),
+		UserId:    watcher.UserID.String(),
+		Reason:    string(watcher.Reason),
+		CreatedAt: timestamppb.New(watcher.CreatedAt),
+	}
+}
+
+// historyToProto converts a model.IssueHistory to a protobuf IssueHistoryEntry
+func historyToProto(entry *models.IssueHistory) *pb.IssueHistoryEntry {
+	protoEntry := &pb.IssueHistoryEntry{
+		Id:        entry.ID.String(),
+		IssueId:   entry.IssueID.String(),
+		Field:     entry.Field,
+		OldValue:  entry.OldValue,
+		NewValue:  entry.NewValue,
+		CreatedAt: timestamppb.New(entry.CreatedAt),
+	}
+
+	// Handle optional fields
+	if entry.ActorID != nil {
+		protoEntry.ActorId = entry.ActorID.String()
+	}
+
+	return protoEntry
+}
+
+// uuidString formats an optional ID, "" when unset
+func uuidString(id *uuid.UUID) string {
+	if id == nil {
+		return ""
+	}
+	return id.String()
 }
@@ -0,0 +1,61 @@
+// services/issue/service_test.go
+package issue
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/matthewmc1/buganizer/models"
+)
+
+func TestIssueChanges(t *testing.T) {
+	assignee := uuid.New()
+	before := models.Issue{
+		Title:       "Crash on save",
+		ComponentID: uuid.New(),
+		Priority:    models.PriorityP2,
+		Status:      models.StatusNew,
+		Labels:      []string{"crash"},
+	}
+
+	tests := []struct {
+		name      string
+		edit      func(issue *models.Issue)
+		fields    []string
+		fieldEdit bool
+	}{
+		{"no change", func(issue *models.Issue) {}, nil, false},
+		{"title", func(issue *models.Issue) { issue.Title = "Crash on save as" }, []string{"title"}, true},
+		{"priority and labels", func(issue *models.Issue) {
+			issue.Priority = models.PriorityP0
+			issue.Labels = []string{"crash", "data-loss"}
+		}, []string{"priority", "labels"}, true},
+		{"assignee only", func(issue *models.Issue) { issue.AssigneeID = &assignee }, []string{"assignee_id"}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			after := before
+			after.Labels = append([]string(nil), before.Labels...)
+			tt.edit(&after)
+
+			changes := issueChanges(&before, &after)
+			var fields []string
+			for _, change := range changes {
+				fields = append(fields, change.Field)
+			}
+			if len(fields) != len(tt.fields) {
+				t.Fatalf("changed fields = %v, want %v", fields, tt.fields)
+			}
+			for i := range fields {
+				if fields[i] != tt.fields[i] {
+					t.Fatalf("changed fields = %v, want %v", fields, tt.fields)
+				}
+			}
+			if got := hasFieldEdits(changes); got != tt.fieldEdit {
+				t.Errorf("hasFieldEdits = %v, want %v", got, tt.fieldEdit)
+			}
+		})
+	}
+}
@@ -0,0 +1,264 @@
+// services/notification/deliveries.go
+package notification
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/matthewmc1/buganizer/models"
+	pb "github.com/matthewmc1/buganizer/proto"
+	"github.com/matthewmc1/buganizer/webhooksig"
+)
+
+const (
+	// maxDeliveryResponseBytes is how much of a webhook's response is kept
+	// in the delivery log
+	maxDeliveryResponseBytes = 4096
+
+	// webhookTimeout bounds a webhook call
+	webhookTimeout = 10 * time.Second
+)
+
+// ListWebhookDeliveries lists the delivery attempts of a webhook, newest
+// first. Admins and the webhook's creator can list them.
+func (s *Service) ListWebhookDeliveries(ctx context.Context, req *pb.ListWebhookDeliveriesRequest) (*pb.ListWebhookDeliveriesResponse, error) {
+	webhook, err := s.getOwnWebhook(ctx, req.WebhookId)
+	if err != nil {
+		return nil, err
+	}
+
+	pageSize := 50
+	if req.PageSize > 0 && req.PageSize <= 200 {
+		pageSize = int(req.PageSize)
+	}
+
+	// Parse the page token (offset)
+	offset := 0
+	if req.PageToken != "" {
+		fmt.Sscanf(req.PageToken, "%d", &offset)
+	}
+
+	deliveries, total, err := s.deliveryRepo.ListByWebhook(ctx, webhook.ID, req.FailedOnly, pageSize, offset)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list webhook deliveries: %v", err)
+	}
+
+	response := &pb.ListWebhookDeliveriesResponse{
+		Deliveries: make([]*pb.WebhookDelivery, 0, len(deliveries)),
+		TotalSize:  int32(total),
+	}
+	for _, delivery := range deliveries {
+		response.Deliveries = append(response.Deliveries, deliveryToProto(delivery))
+	}
+
+	// Set next page token if there are more results
+	if offset+len(deliveries) < total {
+		response.NextPageToken = fmt.Sprintf("%d", offset+len(deliveries))
+	}
+
+	return response, nil
+}
+
+// RedeliverWebhook sends the payload of a past delivery to its webhook again,
+// signed with the webhook's current secret, and returns the new attempt.
+// Admins and the webhook's creator can redeliver.
+func (s *Service) RedeliverWebhook(ctx context.Context, req *pb.RedeliverWebhookRequest) (*pb.WebhookDelivery, error) {
+	deliveryID, err := uuid.Parse(req.DeliveryId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid delivery ID format")
+	}
+
+	delivery, err := s.deliveryRepo.GetByID(ctx, deliveryID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Error(codes.NotFound, "delivery not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get delivery: %v", err)
+	}
+
+	webhook, err := s.getOwnWebhook(ctx, delivery.WebhookID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	redelivery := s.sendWebhook(ctx, *webhook, delivery.EventType, delivery.IssueID, []byte(delivery.RequestBody), &delivery.ID)
+
+	return deliveryToProto(redelivery), nil
+}
+
+// sendWebhook posts a body to a webhook, signed with its secret, and records
+// the attempt in the delivery log and on the webhook. Failures are reported
+// in the returned delivery.
+func (s *Service) sendWebhook(ctx context.Context, wh models.Webhook, eventType string, issueID *uuid.UUID, body []byte, redeliveryOf *uuid.UUID) *models.WebhookDelivery {
+	delivery := &models.WebhookDelivery{
+		ID:             uuid.New(),
+		WebhookID:      wh.ID,
+		EventType:      eventType,
+		IssueID:        issueID,
+		RequestBody:    string(body),
+		RedeliveryOfID: redeliveryOf,
+		CreatedAt:      time.Now(),
+	}
+
+	delivery.RequestHeaders = map[string]string{
+		"Content-Type":             webhookContentType(wh),
+		"User-Agent":               "Buganizer-Webhook",
+		"X-Buganizer-Event":        eventType,
+		webhooksig.DeliveryHeader:  delivery.ID.String(),
+		webhooksig.TimestampHeader: strconv.FormatInt(delivery.CreatedAt.Unix(), 10),
+	}
+
+	// Sign the timestamp and body if a secret is set, so receivers can
+	// reject tampered or replayed deliveries
+	if wh.Secret != "" {
+		delivery.RequestHeaders[webhooksig.SignatureHeader] = webhooksig.Sign(wh.Secret, delivery.CreatedAt, body)
+	}
+
+	s.callWebhookURL(ctx, wh.URL, body, delivery)
+
+	// Update last called time and status
+	if err := s.webhookRepo.UpdateStatus(context.Background(), wh.ID, &delivery.CreatedAt, delivery.Success); err != nil {
+		fmt.Printf("Error updating webhook %s status: %v\n", wh.ID, err)
+	}
+
+	if err := s.deliveryRepo.Create(context.Background(), delivery); err != nil {
+		fmt.Printf("Error recording webhook delivery %s: %v\n", delivery.ID, err)
+	}
+
+	return delivery
+}
+
+// callWebhookURL makes the HTTP request of a delivery and fills in its
+// response, latency and outcome
+func (s *Service) callWebhookURL(ctx context.Context, url string, body []byte, delivery *models.WebhookDelivery) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		delivery.Error = err.Error()
+		return
+	}
+	for name, value := range delivery.RequestHeaders {
+		req.Header.Set(name, value)
+	}
+
+	start := time.Now()
+	resp, err := s.webhookClient.Do(req)
+	if err != nil {
+		delivery.LatencyMs = time.Since(start).Milliseconds()
+		delivery.Error = err.Error()
+		return
+	}
+	defer resp.Body.Close()
+
+	responseBody, err := io.ReadAll(io.LimitReader(resp.Body, maxDeliveryResponseBytes+1))
+	delivery.LatencyMs = time.Since(start).Milliseconds()
+	if len(responseBody) > maxDeliveryResponseBytes {
+		responseBody = responseBody[:maxDeliveryResponseBytes]
+		delivery.ResponseTruncated = true
+	}
+
+	delivery.ResponseStatus = resp.StatusCode
+	delivery.ResponseBody = strings.ToValidUTF8(string(responseBody), "�")
+	delivery.ResponseHeaders = make(map[string]string, len(resp.Header))
+	for name, values := range resp.Header {
+		delivery.ResponseHeaders[name] = strings.Join(values, ", ")
+	}
+
+	switch {
+	case resp.StatusCode < 200 || resp.StatusCode >= 300:
+		delivery.Error = fmt.Sprintf("webhook returned status %d", resp.StatusCode)
+	case err != nil:
+		delivery.Error = fmt.Sprintf("failed to read response: %v", err)
+	default:
+		delivery.Success = true
+	}
+}
+
+// getOwnWebhook loads a webhook the caller may manage: one they created, or
+// any created in their organization when they are an admin. Webhooks of other
+// organizations are not found.
+func (s *Service) getOwnWebhook(ctx context.Context, id string) (*models.Webhook, error) {
+	// Get user ID from context
+	userID, ok := ctx.Value("user_id").(string)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not authenticated")
+	}
+	uid := uuid.MustParse(userID)
+
+	webhookID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid webhook ID format")
+	}
+
+	webhook, err := s.webhookRepo.GetByID(ctx, webhookID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Error(codes.NotFound, "webhook not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get webhook: %v", err)
+	}
+
+	if webhook.CreatorID != uid {
+		organizationID, err := s.userRepo.GetOrganizationID(ctx, uid)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get organization: %v", err)
+		}
+		creatorOrganizationID, err := s.userRepo.GetOrganizationID(ctx, webhook.CreatorID)
+		if err != nil && err != sql.ErrNoRows {
+			return nil, status.Errorf(codes.Internal, "failed to get organization: %v", err)
+		}
+		if err != nil || creatorOrganizationID != organizationID {
+			return nil, status.Error(codes.NotFound, "webhook not found")
+		}
+
+		isAdmin, err := s.userRepo.IsAdmin(ctx, uid)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to check permissions: %v", err)
+		}
+		if !isAdmin {
+			return nil, status.Error(codes.PermissionDenied, "only an admin or the webhook's creator can manage it")
+		}
+	}
+
+	return webhook, nil
+}
+
+// deliveryToProto converts a model.WebhookDelivery to a protobuf WebhookDelivery
+func deliveryToProto(delivery *models.WebhookDelivery) *pb.WebhookDelivery {
+	pbDelivery := &pb.WebhookDelivery{
+		Id:                delivery.ID.String(),
+		WebhookId:         delivery.WebhookID.String(),
+		EventType:         delivery.EventType,
+		RequestHeaders:    delivery.RequestHeaders,
+		RequestBody:       delivery.RequestBody,
+		ResponseStatus:    int32(delivery.ResponseStatus),
+		ResponseHeaders:   delivery.ResponseHeaders,
+		ResponseBody:      delivery.ResponseBody,
+		ResponseTruncated: delivery.ResponseTruncated,
+		LatencyMs:         delivery.LatencyMs,
+		Error:             delivery.Error,
+		Success:           delivery.Success,
+		CreatedAt:         timestamppb.New(delivery.CreatedAt),
+	}
+
+	// Handle optional fields
+	if delivery.IssueID != nil {
+		pbDelivery.IssueId = delivery.IssueID.String()
+	}
+	if delivery.RedeliveryOfID != nil {
+		pbDelivery.RedeliveryOfId = delivery.RedeliveryOfID.String()
+	}
+
+	return pbDelivery
+}
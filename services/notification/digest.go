@@ -0,0 +1,295 @@
+// services/notification/digest.go
+package notification
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"net/mail"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/matthewmc1/buganizer/models"
+	pb "github.com/matthewmc1/buganizer/proto"
+)
+
+// maxDigestIssues bounds the issues listed one by one in a Slack digest, which
+// may have at most 50 blocks
+const maxDigestIssues = 40
+
+// DigestEntry is an event held for a user's next digest
+type DigestEntry struct {
+	Type       string    `json:"type"` // Notification type
+	IssueID    string    `json:"issue_id"`
+	IssueTitle string    `json:"issue_title"`
+	Priority   string    `json:"priority"`
+	Status     string    `json:"status"`
+	Actor      string    `json:"actor,omitempty"`
+	Text       string    `json:"text"`
+	At         time.Time `json:"at"`
+}
+
+// digestIssue is an issue in a digest, with the events about it in order
+type digestIssue struct {
+	Title    string
+	URL      string
+	Priority string
+	Status   string
+	Events   []digestEvent
+}
+
+// digestEvent is an event in a digest
+type digestEvent struct {
+	Time  string // When it happened, in the user's time zone
+	Actor string
+	Text  string
+}
+
+// digestData is what digest emails are rendered with
+type digestData struct {
+	Summary        string
+	Issues         []*digestIssue
+	PreferencesURL string
+	UnsubscribeURL string
+}
+
+// digestMessage creates an outbox message holding an event for a user's
+// digest, due when the digest is sent
+func digestMessage(userID uuid.UUID, req *pb.NotificationRequest, issue *models.Issue, actor, text string, at time.Time) (*models.OutboxMessage, error) {
+	payload, err := json.Marshal(DigestEntry{
+		Type:       req.Type.String(),
+		IssueID:    issue.ID.String(),
+		IssueTitle: issue.Title,
+		Priority:   string(issue.Priority),
+		Status:     string(issue.Status),
+		Actor:      actor,
+		Text:       text,
+		At:         time.Now(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	message := models.NewOutboxMessage(models.OutboxDigest, &issue.ID, payload)
+	message.UserID = &userID
+	message.NextAttemptAt = at
+	return message, nil
+}
+
+// sendDigest replaces the events due for a user's digest with one summary on
+// each channel they have enabled. Whichever of the events is delivered first
+// sends the digest for all of them.
+func (s *Service) sendDigest(ctx context.Context, message *models.OutboxMessage) error {
+	if message.UserID == nil {
+		return permanentError{fmt.Errorf("digest message has no user")}
+	}
+
+	now := time.Now()
+	items, err := s.outboxRepo.ListDigest(ctx, *message.UserID, now)
+	if err != nil {
+		return fmt.Errorf("failed to list digest: %v", err)
+	}
+	if len(items) == 0 {
+		// Sent with another digest
+		return nil
+	}
+
+	ids := make([]uuid.UUID, len(items))
+	for i, item := range items {
+		ids[i] = item.ID
+	}
+
+	user, err := s.userRepo.GetByID(ctx, *message.UserID)
+	if err == sql.ErrNoRows {
+		return s.outboxRepo.CompleteDigest(ctx, ids, nil, now)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get user: %v", err)
+	}
+
+	pref, err := s.getPreferences(ctx, user.ID)
+	if err != nil {
+		return fmt.Errorf("failed to get notification preferences: %v", err)
+	}
+
+	issues, count := s.groupDigest(items, pref.Location())
+	if count == 0 {
+		return s.outboxRepo.CompleteDigest(ctx, ids, nil, now)
+	}
+	summary := fmt.Sprintf("%s on %s", plural(count, "update"), plural(len(issues), "issue"))
+
+	var messages []*models.OutboxMessage
+	if pref.SlackNotifications && s.config.Slack.APIToken != "" && user.Email != "" {
+		payload, err := json.Marshal(SlackDirectMessage{
+			Email:  user.Email,
+			Text:   "Your Buganizer digest: " + summary,
+			Blocks: digestBlocks(summary, issues),
+		})
+		if err != nil {
+			return permanentError{err}
+		}
+		messages = append(messages, models.NewOutboxMessage(models.OutboxSlackDM, nil, payload))
+	}
+
+	if pref.EmailNotifications && s.mailer.Enabled() && user.Email != "" {
+		message, err := s.digestEmailMessage(user, summary, issues)
+		if err != nil {
+			return permanentError{err}
+		}
+		messages = append(messages, message)
+	}
+
+	return s.outboxRepo.CompleteDigest(ctx, ids, messages, now)
+}
+
+// groupDigest groups the events of a digest, oldest first, by issue, ordering
+// issues by their first event. It returns the issues and the number of events.
+func (s *Service) groupDigest(items []*models.OutboxMessage, loc *time.Location) ([]*digestIssue, int) {
+	var issues []*digestIssue
+	byID := make(map[string]*digestIssue)
+	count := 0
+
+	for _, item := range items {
+		var entry DigestEntry
+		if err := json.Unmarshal(item.Payload, &entry); err != nil {
+			fmt.Printf("Error decoding digest entry %s: %v\n", item.ID, err)
+			continue
+		}
+
+		issue, ok := byID[entry.IssueID]
+		if !ok {
+			issue = &digestIssue{
+				URL: fmt.Sprintf("%s/issues/%s", s.config.BaseURL, entry.IssueID),
+			}
+			byID[entry.IssueID] = issue
+			issues = append(issues, issue)
+		}
+
+		// The latest event has the issue as it is now
+		issue.Title = entry.IssueTitle
+		issue.Priority = entry.Priority
+		issue.Status = entry.Status
+		issue.Events = append(issue.Events, digestEvent{
+			Time:  entry.At.In(loc).Format("Jan 2 15:04"),
+			Actor: entry.Actor,
+			Text:  entry.Text,
+		})
+		count++
+	}
+
+	return issues, count
+}
+
+// digestEmailMessage creates an outbox message with a digest email to a user
+func (s *Service) digestEmailMessage(user *models.User, summary string, issues []*digestIssue) (*models.OutboxMessage, error) {
+	data := digestData{
+		Summary:        summary,
+		Issues:         issues,
+		PreferencesURL: s.config.BaseURL + "/settings",
+		UnsubscribeURL: s.unsubscribeURL(user.ID),
+	}
+
+	var text, html bytes.Buffer
+	if err := textTemplates.ExecuteTemplate(&text, "DIGEST", data); err != nil {
+		return nil, fmt.Errorf("failed to render digest: %v", err)
+	}
+	if err := htmlTemplates.ExecuteTemplate(&html, "DIGEST", data); err != nil {
+		return nil, fmt.Errorf("failed to render digest: %v", err)
+	}
+
+	payload, err := json.Marshal(&Email{
+		To:             (&mail.Address{Name: user.Name, Address: user.Email}).String(),
+		Subject:        "[Buganizer] Digest: " + summary,
+		Text:           text.String(),
+		HTML:           html.String(),
+		MessageID:      fmt.Sprintf("<digest-%s@%s>", uuid.New(), s.mailer.Domain()),
+		UnsubscribeURL: data.UnsubscribeURL,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return models.NewOutboxMessage(models.OutboxEmail, nil, payload), nil
+}
+
+// digestBlocks lays out a digest as Slack blocks, one section per issue
+func digestBlocks(summary string, issues []*digestIssue) []SlackBlock {
+	blocks := []SlackBlock{
+		{
+			Type: "header",
+			Text: &SlackText{
+				Type: "plain_text",
+				Text: "📬 Your Buganizer digest",
+			},
+		},
+		{
+			Type: "section",
+			Text: &SlackText{
+				Type: "mrkdwn",
+				Text: summary,
+			},
+		},
+	}
+
+	for i, issue := range issues {
+		if i == maxDigestIssues {
+			blocks = append(blocks, SlackBlock{
+				Type: "section",
+				Text: &SlackText{
+					Type: "mrkdwn",
+					Text: fmt.Sprintf("…and %s more", plural(len(issues)-i, "issue")),
+				},
+			})
+			break
+		}
+
+		var text strings.Builder
+		fmt.Fprintf(&text, "*<%s|%s>* (%s, %s)", issue.URL, issue.Title, issue.Priority, issue.Status)
+		for _, event := range issue.Events {
+			fmt.Fprintf(&text, "\n• %s ", event.Time)
+			if event.Actor != "" {
+				fmt.Fprintf(&text, "%s: ", event.Actor)
+			}
+			text.WriteString(event.Text)
+		}
+
+		blocks = append(blocks, SlackBlock{
+			Type: "section",
+			Text: &SlackText{
+				Type: "mrkdwn",
+				Text: truncateString(text.String(), 3000),
+			},
+		})
+	}
+
+	return blocks
+}
+
+// plural formats a count of things, e.g. "1 issue" or "3 issues"
+func plural(n int, noun string) string {
+	if n == 1 {
+		return fmt.Sprintf("1 %s", noun)
+	}
+	return fmt.Sprintf("%d %ss", n, noun)
+}
+
+// parseClock parses an "HH:MM" time of day as minutes after midnight
+func parseClock(value string, fallback int) (int, error) {
+	if value == "" {
+		return fallback, nil
+	}
+
+	var hours, minutes int
+	if _, err := fmt.Sscanf(value, "%d:%d", &hours, &minutes); err != nil {
+		return 0, fmt.Errorf("%q is not HH:MM", value)
+	}
+	if hours < 0 || hours > 23 || minutes < 0 || minutes > 59 {
+		return 0, fmt.Errorf("%q is out of range", value)
+	}
+
+	return hours*60 + minutes, nil
+}
@@ -0,0 +1,191 @@
+// services/notification/email.go
+package notification
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"embed"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	htmltemplate "html/template"
+	"net/mail"
+	"net/url"
+	"strings"
+	texttemplate "text/template"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/matthewmc1/buganizer/models"
+	pb "github.com/matthewmc1/buganizer/proto"
+)
+
+//go:embed templates
+var templateFS embed.FS
+
+// Email templates, with one template per notification type named after it,
+// a "default" for the others and "DIGEST" for digests
+var (
+	emailFuncs = map[string]interface{}{
+		"date": func(t *time.Time) string {
+			return t.UTC().Format("Jan 2, 2006 15:04 MST")
+		},
+	}
+
+	textTemplates = texttemplate.Must(texttemplate.New("email").Funcs(emailFuncs).ParseFS(templateFS, "templates/*.txt.tmpl"))
+	htmlTemplates = htmltemplate.Must(htmltemplate.New("email").Funcs(emailFuncs).ParseFS(templateFS, "templates/*.html.tmpl"))
+)
+
+// emailData is what email templates are rendered with
+type emailData struct {
+	Issue          *models.Issue
+	IssueURL       string
+	Actor          string // Who caused the event
+	Assignee       string
+	Message        string
+	Changes        []*pb.FieldChange
+	Comment        string
+	PreferencesURL string
+	UnsubscribeURL string
+}
+
+// newEmailData gathers what the emails about an event are rendered with, apart
+// from the recipient's unsubscribe link
+func (s *Service) newEmailData(ctx context.Context, req *pb.NotificationRequest, issue *models.Issue) *emailData {
+	data := &emailData{
+		Issue:          issue,
+		IssueURL:       fmt.Sprintf("%s/issues/%s", s.config.BaseURL, issue.ID.String()),
+		Actor:          "Someone",
+		Message:        req.Message,
+		Changes:        req.Changes,
+		Comment:        req.CommentContent,
+		PreferencesURL: s.config.BaseURL + "/settings",
+	}
+
+	// Handle optional fields
+	if actorID, err := uuid.Parse(req.ActorId); err == nil {
+		if actor, err := s.userRepo.GetByID(ctx, actorID); err == nil {
+			data.Actor = actor.Name
+		}
+	}
+
+	if issue.AssigneeID != nil {
+		if assignee, err := s.userRepo.GetByID(ctx, *issue.AssigneeID); err == nil {
+			data.Assignee = assignee.Name
+		}
+	}
+
+	return data
+}
+
+// emailMessage creates an outbox message with the email about an event to
+// one user
+func (s *Service) emailMessage(req *pb.NotificationRequest, issue *models.Issue, data emailData, user *models.User) (*models.OutboxMessage, error) {
+	data.UnsubscribeURL = s.unsubscribeURL(user.ID)
+
+	email, err := s.renderEmail(req.Type, issue, data)
+	if err != nil {
+		return nil, err
+	}
+	email.To = (&mail.Address{Name: user.Name, Address: user.Email}).String()
+
+	payload, err := json.Marshal(email)
+	if err != nil {
+		return nil, err
+	}
+
+	return models.NewOutboxMessage(models.OutboxEmail, &issue.ID, payload), nil
+}
+
+// renderEmail renders the email for a notification type. Every email about
+// an issue replies to the one announcing it, so mail clients group them into
+// one conversation.
+func (s *Service) renderEmail(notificationType pb.NotificationRequest_NotificationType, issue *models.Issue, data emailData) (*Email, error) {
+	name := notificationType.String()
+	if textTemplates.Lookup(name) == nil || htmlTemplates.Lookup(name) == nil {
+		name = "default"
+	}
+
+	var text, html bytes.Buffer
+	if err := textTemplates.ExecuteTemplate(&text, name, data); err != nil {
+		return nil, fmt.Errorf("failed to render email: %v", err)
+	}
+	if err := htmlTemplates.ExecuteTemplate(&html, name, data); err != nil {
+		return nil, fmt.Errorf("failed to render email: %v", err)
+	}
+
+	domain := s.mailer.Domain()
+	threadID := fmt.Sprintf("<issue-%s@%s>", issue.ID, domain)
+
+	email := &Email{
+		Subject:        fmt.Sprintf("[Buganizer] %s", issue.Title),
+		Text:           text.String(),
+		HTML:           html.String(),
+		MessageID:      threadID,
+		UnsubscribeURL: data.UnsubscribeURL,
+	}
+
+	if notificationType != pb.NotificationRequest_ISSUE_CREATED {
+		email.Subject = "Re: " + email.Subject
+		email.MessageID = fmt.Sprintf("<%s.issue-%s@%s>", uuid.New(), issue.ID, domain)
+		email.InReplyTo = threadID
+	}
+
+	return email, nil
+}
+
+// Unsubscribe turns off email notifications for the user of a signed
+// unsubscribe link. It needs no authentication; the signature authorizes it.
+func (s *Service) Unsubscribe(ctx context.Context, req *pb.UnsubscribeRequest) (*pb.UnsubscribeResponse, error) {
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user ID format")
+	}
+
+	expected := s.unsubscribeSignature(userID)
+	if !hmac.Equal([]byte(expected), []byte(req.Signature)) {
+		return nil, status.Error(codes.PermissionDenied, "invalid unsubscribe link")
+	}
+
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "user not found: %v", err)
+	}
+
+	pref, err := s.getPreferences(ctx, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get notification preferences: %v", err)
+	}
+
+	pref.EmailNotifications = false
+	pref.UpdatedAt = time.Now()
+
+	if err := s.preferenceRepo.Upsert(ctx, pref); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update notification preferences: %v", err)
+	}
+
+	return &pb.UnsubscribeResponse{
+		Email: user.Email,
+	}, nil
+}
+
+// unsubscribeURL returns the link that turns off a user's email notifications
+func (s *Service) unsubscribeURL(userID uuid.UUID) string {
+	query := url.Values{}
+	query.Set("user_id", userID.String())
+	query.Set("signature", s.unsubscribeSignature(userID))
+
+	return fmt.Sprintf("%s/api/v1/notifications/unsubscribe?%s", strings.TrimRight(s.config.BaseURL, "/"), query.Encode())
+}
+
+// unsubscribeSignature computes the signature of a user's unsubscribe link
+func (s *Service) unsubscribeSignature(userID uuid.UUID) string {
+	mac := hmac.New(sha256.New, []byte(s.config.Email.UnsubscribeSecret))
+	fmt.Fprintf(mac, "unsubscribe:%s", userID)
+	return hex.EncodeToString(mac.Sum(nil))
+}
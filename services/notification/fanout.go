@@ -0,0 +1,328 @@
+// services/notification/fanout.go
+package notification
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/matthewmc1/buganizer/models"
+	pb "github.com/matthewmc1/buganizer/proto"
+)
+
+// mentionPattern matches @mentions of users by email address, or by the part
+// of it before the @ for users in the same domain as the author, e.g.
+// "@alice@example.com" or "@alice"
+var mentionPattern = regexp.MustCompile(`(?:^|[^\w.@])@([\w.+-]*\w(?:@[\w-]+(?:\.[\w-]+)+)?)`)
+
+// teamEvents are the notification types sent to every member of the team
+// owning the issue's component, on top of the people involved with the issue
+var teamEvents = map[pb.NotificationRequest_NotificationType]bool{
+	pb.NotificationRequest_ISSUE_CREATED:   true,
+	pb.NotificationRequest_SLA_AT_RISK:     true,
+	pb.NotificationRequest_SLA_BREACHED:    true,
+	pb.NotificationRequest_ISSUE_ESCALATED: true,
+}
+
+// SlackDirectMessage is a Slack message to one user, addressed by email
+type SlackDirectMessage struct {
+	Email  string       `json:"email"`
+	Text   string       `json:"text"`
+	Blocks []SlackBlock `json:"blocks,omitempty"`
+}
+
+// fanOut creates the messages notifying users about an event. Every user the
+// event concerns, other than the one who caused it, gets it in their in-app
+// inbox and a message on each channel they have enabled, unless they have
+// unsubscribed from its type.
+// Users who get the type in digests, or are in their quiet hours, get it held
+// for their next digest instead; notifications for given users, such as
+// escalations, are never held.
+func (s *Service) fanOut(ctx context.Context, req *pb.NotificationRequest, issue *models.Issue, text string, blocks []SlackBlock) ([]*models.OutboxMessage, error) {
+	userIDs, err := s.recipients(ctx, req, issue)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	direct := len(req.RecipientIds) > 0
+	urgent := issue.Priority == models.PriorityP0
+
+	var data *emailData
+	var messages []*models.OutboxMessage
+	for _, userID := range userIDs {
+		// Never notify users of their own changes
+		if userID.String() == req.ActorId {
+			continue
+		}
+
+		pref, err := s.getPreferences(ctx, userID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get notification preferences for %s: %v", userID, err)
+		}
+		if !isSubscribed(pref, req.Type) {
+			continue
+		}
+
+		user, err := s.userRepo.GetByID(ctx, userID)
+		if err == sql.ErrNoRows {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to get user %s: %v", userID, err)
+		}
+
+		// The in-app inbox gets everything as it happens
+		message, err := inboxMessage(userID, req, issue, text)
+		if err != nil {
+			return nil, err
+		}
+		messages = append(messages, message)
+
+		if user.Email == "" {
+			continue
+		}
+
+		slack := pref.SlackNotifications && s.config.Slack.APIToken != ""
+		email := pref.EmailNotifications && s.mailer.Enabled()
+		if !slack && !email {
+			continue
+		}
+
+		if data == nil {
+			data = s.newEmailData(ctx, req, issue)
+		}
+
+		if !direct {
+			if at := pref.DeliveryTime(req.Type.String(), urgent, now); at.After(now) {
+				// System events have no actor
+				actor := ""
+				if req.ActorId != "" {
+					actor = data.Actor
+				}
+
+				message, err := digestMessage(userID, req, issue, actor, text, at)
+				if err != nil {
+					return nil, err
+				}
+				messages = append(messages, message)
+				continue
+			}
+		}
+
+		// Slack DMs are addressed by Slack user ID, which is looked up by
+		// email when the message is sent
+		if slack {
+			payload, err := json.Marshal(SlackDirectMessage{
+				Email:  user.Email,
+				Text:   text,
+				Blocks: blocks,
+			})
+			if err != nil {
+				return nil, err
+			}
+			messages = append(messages, models.NewOutboxMessage(models.OutboxSlackDM, &issue.ID, payload))
+		}
+
+		if email {
+			message, err := s.emailMessage(req, issue, *data, user)
+			if err != nil {
+				return nil, err
+			}
+			messages = append(messages, message)
+		}
+	}
+
+	return messages, nil
+}
+
+// recipients lists the users an event concerns, each once. Notifications for
+// given users go to them alone. Others go to the issue's assignee, reporter
+// and watchers, the users mentioned by the change and, for teamEvents, the
+// team owning the issue's component.
+func (s *Service) recipients(ctx context.Context, req *pb.NotificationRequest, issue *models.Issue) ([]uuid.UUID, error) {
+	if len(req.RecipientIds) > 0 {
+		return parseRecipients(req)
+	}
+
+	var userIDs []uuid.UUID
+	seen := make(map[uuid.UUID]bool)
+	add := func(ids ...uuid.UUID) {
+		for _, id := range ids {
+			if id != uuid.Nil && !seen[id] {
+				seen[id] = true
+				userIDs = append(userIDs, id)
+			}
+		}
+	}
+
+	if issue.AssigneeID != nil {
+		add(*issue.AssigneeID)
+	}
+	add(issue.ReporterID)
+
+	watcherIDs, err := s.watcherIDs(ctx, issue)
+	if err != nil {
+		return nil, err
+	}
+	add(watcherIDs...)
+
+	add(s.mentionedUsers(ctx, req, issue)...)
+
+	if teamEvents[req.Type] {
+		memberIDs, err := s.teamMemberIDs(ctx, issue)
+		if err != nil {
+			return nil, err
+		}
+		add(memberIDs...)
+	}
+
+	return userIDs, nil
+}
+
+// parseRecipients parses the users a notification is addressed to
+func parseRecipients(req *pb.NotificationRequest) ([]uuid.UUID, error) {
+	userIDs := make([]uuid.UUID, 0, len(req.RecipientIds))
+	for _, id := range req.RecipientIds {
+		userID, err := uuid.Parse(id)
+		if err != nil {
+			return nil, fmt.Errorf("invalid recipient ID %q: %v", id, err)
+		}
+		userIDs = append(userIDs, userID)
+	}
+	return userIDs, nil
+}
+
+// teamMemberIDs lists the members of the team owning an issue's component
+func (s *Service) teamMemberIDs(ctx context.Context, issue *models.Issue) ([]uuid.UUID, error) {
+	component, err := s.componentRepo.GetByID(ctx, issue.ComponentID)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get component: %v", err)
+	}
+	if component.TeamID == uuid.Nil {
+		return nil, nil
+	}
+
+	members, err := s.teamRepo.GetTeamMembers(ctx, component.TeamID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get team members: %v", err)
+	}
+
+	userIDs := make([]uuid.UUID, len(members))
+	for i, member := range members {
+		userIDs[i] = member.ID
+	}
+	return userIDs, nil
+}
+
+// mentionedUsers finds the users @mentioned by an event: in the description
+// of a new issue, in a comment, or newly in an updated description. Mentions
+// that match no user in the author's organization are ignored.
+func (s *Service) mentionedUsers(ctx context.Context, req *pb.NotificationRequest, issue *models.Issue) []uuid.UUID {
+	var handles []string
+	switch req.Type {
+	case pb.NotificationRequest_ISSUE_CREATED:
+		handles = mentions(issue.Description)
+	case pb.NotificationRequest_COMMENT_ADDED:
+		handles = mentions(req.CommentContent)
+	}
+	for _, change := range req.Changes {
+		if change.Field != "description" {
+			continue
+		}
+		previous := make(map[string]bool)
+		for _, handle := range mentions(change.OldValue) {
+			previous[strings.ToLower(handle)] = true
+		}
+		for _, handle := range mentions(change.NewValue) {
+			if !previous[strings.ToLower(handle)] {
+				handles = append(handles, handle)
+			}
+		}
+	}
+	if len(handles) == 0 {
+		return nil
+	}
+
+	authorID := issue.ReporterID
+	if actorID, err := uuid.Parse(req.ActorId); err == nil {
+		authorID = actorID
+	}
+	author, err := s.userRepo.GetByID(ctx, authorID)
+	if err != nil {
+		fmt.Printf("Error getting author %s of mentions: %v\n", authorID, err)
+		return nil
+	}
+	organizationID, err := s.userRepo.GetOrganizationID(ctx, authorID)
+	if err != nil {
+		fmt.Printf("Error getting organization of mention author %s: %v\n", authorID, err)
+		return nil
+	}
+
+	// Mentions without a domain are in the author's domain
+	_, domain, _ := strings.Cut(author.Email, "@")
+
+	var userIDs []uuid.UUID
+	for _, handle := range handles {
+		email := handle
+		if !strings.Contains(handle, "@") {
+			if domain == "" {
+				continue
+			}
+			email = handle + "@" + domain
+		}
+
+		// Mentions only reach users the author shares an organization with
+		user, err := s.userRepo.GetByEmail(ctx, email)
+		if err != nil {
+			continue
+		}
+		if userOrganizationID, err := s.userRepo.GetOrganizationID(ctx, user.ID); err != nil || userOrganizationID != organizationID {
+			continue
+		}
+		userIDs = append(userIDs, user.ID)
+	}
+	return userIDs
+}
+
+// mentions lists the distinct @mentions in a text, ignoring case
+func mentions(text string) []string {
+	var handles []string
+	seen := make(map[string]bool)
+	for _, match := range mentionPattern.FindAllStringSubmatch(text, -1) {
+		handle := match[1]
+		if key := strings.ToLower(handle); !seen[key] {
+			seen[key] = true
+			handles = append(handles, handle)
+		}
+	}
+	return handles
+}
+
+// sendSlackDirectMessage sends a Slack direct message to the user with its
+// email address
+func (s *Service) sendSlackDirectMessage(payload []byte) error {
+	var message SlackDirectMessage
+	if err := json.Unmarshal(payload, &message); err != nil {
+		return permanentError{fmt.Errorf("invalid Slack direct message: %v", err)}
+	}
+
+	slackUserID, err := s.slackClient.LookupUserByEmail(message.Email)
+	if err == errSlackUserNotFound {
+		return permanentError{fmt.Errorf("no Slack user for %s", message.Email)}
+	}
+	if err != nil {
+		return fmt.Errorf("failed to look up Slack user for %s: %v", message.Email, err)
+	}
+
+	return s.slackClient.SendMessage(slackUserID, message.Text, message.Blocks)
+}
@@ -0,0 +1,106 @@
+// services/notification/fanout_test.go
+package notification
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/matthewmc1/buganizer/models"
+	pb "github.com/matthewmc1/buganizer/proto"
+	"github.com/matthewmc1/buganizer/repositories"
+)
+
+// orgUserRepo is a UserRepository of users in organizations
+type orgUserRepo struct {
+	repositories.UserRepository
+	users         []*models.User
+	organizations map[uuid.UUID]uuid.UUID
+	admins        map[uuid.UUID]bool
+}
+
+func (r *orgUserRepo) add(email string, organizationID uuid.UUID) *models.User {
+	user := &models.User{ID: uuid.New(), Email: email}
+	r.users = append(r.users, user)
+	r.organizations[user.ID] = organizationID
+	return user
+}
+
+func (r *orgUserRepo) GetByID(ctx context.Context, id uuid.UUID) (*models.User, error) {
+	for _, user := range r.users {
+		if user.ID == id {
+			return user, nil
+		}
+	}
+	return nil, sql.ErrNoRows
+}
+
+func (r *orgUserRepo) GetByEmail(ctx context.Context, email string) (*models.User, error) {
+	for _, user := range r.users {
+		if strings.EqualFold(user.Email, email) {
+			return user, nil
+		}
+	}
+	return nil, sql.ErrNoRows
+}
+
+func (r *orgUserRepo) GetOrganizationID(ctx context.Context, userID uuid.UUID) (uuid.UUID, error) {
+	organizationID, ok := r.organizations[userID]
+	if !ok {
+		return uuid.Nil, sql.ErrNoRows
+	}
+	return organizationID, nil
+}
+
+func (r *orgUserRepo) IsAdmin(ctx context.Context, userID uuid.UUID) (bool, error) {
+	return r.admins[userID], nil
+}
+
+func TestMentionedUsersStayInAuthorsOrganization(t *testing.T) {
+	acme, other := uuid.New(), uuid.New()
+	users := &orgUserRepo{organizations: make(map[uuid.UUID]uuid.UUID)}
+	author := users.add("alex@acme.com", acme)
+	colleague := users.add("sam@acme.com", acme)
+	contractor := users.add("kim@contractors.io", acme)
+	users.add("lee@othercorp.com", other)
+	users.add("jo@acme.com", other) // Same domain, different organization
+
+	s := &Service{userRepo: users}
+	req := &pb.NotificationRequest{
+		Type:           pb.NotificationRequest_COMMENT_ADDED,
+		ActorId:        author.ID.String(),
+		CommentContent: "@sam @kim@contractors.io @lee@othercorp.com @jo @nobody please look",
+	}
+
+	got := s.mentionedUsers(context.Background(), req, &models.Issue{ReporterID: author.ID})
+	want := []uuid.UUID{colleague.ID, contractor.ID}
+	if len(got) != len(want) {
+		t.Fatalf("mentioned users = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("mentioned users = %v, want %v", got, want)
+		}
+	}
+}
+
+func TestMentionedUsersWithoutAuthorOrganization(t *testing.T) {
+	users := &orgUserRepo{organizations: make(map[uuid.UUID]uuid.UUID)}
+	author := &models.User{ID: uuid.New(), Email: "alex@acme.com"}
+	users.users = append(users.users, author)
+	users.add("sam@acme.com", uuid.New())
+
+	s := &Service{userRepo: users}
+	req := &pb.NotificationRequest{
+		Type:           pb.NotificationRequest_COMMENT_ADDED,
+		ActorId:        author.ID.String(),
+		CommentContent: "@sam",
+	}
+
+	if got := s.mentionedUsers(context.Background(), req, &models.Issue{ReporterID: author.ID}); len(got) != 0 {
+		t.Errorf("mentioned users = %v, want none when the author's organization is unknown", got)
+	}
+}
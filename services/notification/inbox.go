@@ -0,0 +1,261 @@
+// services/notification/inbox.go
+package notification
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/matthewmc1/buganizer/config"
+	"github.com/matthewmc1/buganizer/models"
+	pb "github.com/matthewmc1/buganizer/proto"
+)
+
+// pruneBatchSize is how many old notifications are deleted per statement
+const pruneBatchSize = 1000
+
+// inboxMessage creates an outbox message adding a notification to a user's
+// in-app inbox
+func inboxMessage(userID uuid.UUID, req *pb.NotificationRequest, issue *models.Issue, text string) (*models.OutboxMessage, error) {
+	notification := models.Notification{
+		ID:        uuid.New(),
+		UserID:    userID,
+		Type:      req.Type.String(),
+		IssueID:   &issue.ID,
+		Title:     issue.Title,
+		Message:   text,
+		CreatedAt: time.Now(),
+	}
+
+	// Handle optional fields
+	if actorID, err := uuid.Parse(req.ActorId); err == nil {
+		notification.ActorID = &actorID
+	}
+
+	payload, err := json.Marshal(notification)
+	if err != nil {
+		return nil, err
+	}
+
+	message := models.NewOutboxMessage(models.OutboxInbox, &issue.ID, payload)
+	message.UserID = &userID
+	return message, nil
+}
+
+// addToInbox stores the notification of an INBOX message
+func (s *Service) addToInbox(ctx context.Context, payload []byte) error {
+	var notification models.Notification
+	if err := json.Unmarshal(payload, &notification); err != nil {
+		return permanentError{fmt.Errorf("invalid inbox notification: %v", err)}
+	}
+
+	return s.notificationRepo.Create(ctx, &notification)
+}
+
+// ListNotifications lists the current user's in-app notifications, newest
+// first
+func (s *Service) ListNotifications(ctx context.Context, req *pb.ListNotificationsRequest) (*pb.ListNotificationsResponse, error) {
+	userIDStr, ok := ctx.Value("user_id").(string)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not authenticated")
+	}
+	userID := uuid.MustParse(userIDStr)
+
+	pageSize := 50
+	if req.PageSize > 0 && req.PageSize <= 200 {
+		pageSize = int(req.PageSize)
+	}
+
+	// Parse the page token (offset)
+	offset := 0
+	if req.PageToken != "" {
+		fmt.Sscanf(req.PageToken, "%d", &offset)
+	}
+
+	notifications, total, err := s.notificationRepo.ListByUser(ctx, userID, req.UnreadOnly, pageSize, offset)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list notifications: %v", err)
+	}
+
+	unread, err := s.notificationRepo.CountUnread(ctx, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to count unread notifications: %v", err)
+	}
+
+	response := &pb.ListNotificationsResponse{
+		Notifications: make([]*pb.Notification, 0, len(notifications)),
+		TotalSize:     int32(total),
+		UnreadCount:   int32(unread),
+	}
+	for _, notification := range notifications {
+		response.Notifications = append(response.Notifications, notificationToProto(notification))
+	}
+
+	// Set next page token if there are more results
+	if offset+len(notifications) < total {
+		response.NextPageToken = fmt.Sprintf("%d", offset+len(notifications))
+	}
+
+	return response, nil
+}
+
+// GetUnreadCount counts the current user's unread notifications
+func (s *Service) GetUnreadCount(ctx context.Context, req *pb.GetUnreadCountRequest) (*pb.GetUnreadCountResponse, error) {
+	userIDStr, ok := ctx.Value("user_id").(string)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not authenticated")
+	}
+	userID := uuid.MustParse(userIDStr)
+
+	unread, err := s.notificationRepo.CountUnread(ctx, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to count unread notifications: %v", err)
+	}
+
+	return &pb.GetUnreadCountResponse{
+		UnreadCount: int32(unread),
+	}, nil
+}
+
+// MarkNotificationRead marks one of the current user's notifications read
+func (s *Service) MarkNotificationRead(ctx context.Context, req *pb.MarkNotificationReadRequest) (*pb.Notification, error) {
+	userIDStr, ok := ctx.Value("user_id").(string)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not authenticated")
+	}
+	userID := uuid.MustParse(userIDStr)
+
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid notification ID format")
+	}
+
+	notification, err := s.notificationRepo.GetByID(ctx, id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Error(codes.NotFound, "notification not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get notification: %v", err)
+	}
+
+	// Other users' notifications are reported as missing
+	if notification.UserID != userID {
+		return nil, status.Error(codes.NotFound, "notification not found")
+	}
+
+	if notification.ReadAt == nil {
+		now := time.Now()
+		if err := s.notificationRepo.MarkRead(ctx, id, now); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to mark notification read: %v", err)
+		}
+		notification.ReadAt = &now
+	}
+
+	return notificationToProto(notification), nil
+}
+
+// MarkAllRead marks all of the current user's notifications read
+func (s *Service) MarkAllRead(ctx context.Context, req *pb.MarkAllReadRequest) (*pb.MarkAllReadResponse, error) {
+	userIDStr, ok := ctx.Value("user_id").(string)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not authenticated")
+	}
+	userID := uuid.MustParse(userIDStr)
+
+	updated, err := s.notificationRepo.MarkAllRead(ctx, userID, time.Now())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to mark notifications read: %v", err)
+	}
+
+	return &pb.MarkAllReadResponse{
+		Updated: int32(updated),
+	}, nil
+}
+
+// notificationToProto converts a model.Notification to a protobuf Notification
+func notificationToProto(notification *models.Notification) *pb.Notification {
+	pbNotification := &pb.Notification{
+		Id:        notification.ID.String(),
+		UserId:    notification.UserID.String(),
+		Type:      pb.NotificationRequest_NotificationType(pb.NotificationRequest_NotificationType_value[notification.Type]),
+		Title:     notification.Title,
+		Message:   notification.Message,
+		Read:      notification.ReadAt != nil,
+		CreatedAt: timestamppb.New(notification.CreatedAt),
+	}
+
+	// Handle optional fields
+	if notification.IssueID != nil {
+		pbNotification.IssueId = notification.IssueID.String()
+	}
+
+	if notification.ActorID != nil {
+		pbNotification.ActorId = notification.ActorID.String()
+	}
+
+	if notification.ReadAt != nil {
+		pbNotification.ReadAt = timestamppb.New(*notification.ReadAt)
+	}
+
+	return pbNotification
+}
+
+// InboxPruner deletes in-app notifications older than the retention period
+type InboxPruner struct {
+	service   *Service
+	retention time.Duration
+	interval  time.Duration
+}
+
+// NewInboxPruner creates a new inbox pruner
+func NewInboxPruner(service *Service, cfg config.InboxConfig) *InboxPruner {
+	return &InboxPruner{
+		service:   service,
+		retention: time.Duration(cfg.RetentionDays) * 24 * time.Hour,
+		interval:  time.Duration(cfg.PruneIntervalMinutes) * time.Minute,
+	}
+}
+
+// Run prunes old notifications on every interval until ctx is cancelled. It
+// does nothing when the interval or the retention period is not positive.
+func (p *InboxPruner) Run(ctx context.Context) {
+	if p.interval <= 0 || p.retention <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	for {
+		if err := p.prune(ctx, time.Now().Add(-p.retention)); err != nil {
+			fmt.Printf("Error pruning notifications: %v\n", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// prune deletes the notifications created before a time, in batches
+func (p *InboxPruner) prune(ctx context.Context, before time.Time) error {
+	for ctx.Err() == nil {
+		deleted, err := p.service.notificationRepo.DeleteOlderThan(ctx, before, pruneBatchSize)
+		if err != nil {
+			return err
+		}
+		if deleted < pruneBatchSize {
+			return nil
+		}
+	}
+	return nil
+}
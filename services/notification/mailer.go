@@ -0,0 +1,226 @@
+// services/notification/mailer.go
+package notification
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"net/textproto"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/matthewmc1/buganizer/config"
+)
+
+// smtpTimeout bounds a whole SMTP session
+const smtpTimeout = 30 * time.Second
+
+// Email is a rendered email to one recipient, stored in the outbox until it
+// is sent
+type Email struct {
+	To             string `json:"to"`
+	Subject        string `json:"subject"`
+	Text           string `json:"text"`
+	HTML           string `json:"html"`
+	MessageID      string `json:"message_id"`
+	InReplyTo      string `json:"in_reply_to,omitempty"` // The first email about the issue, for threading
+	UnsubscribeURL string `json:"unsubscribe_url,omitempty"`
+}
+
+// Mailer sends email over SMTP
+type Mailer struct {
+	cfg  config.EmailConfig
+	from *mail.Address
+}
+
+// NewMailer creates a new mailer. It is disabled when no SMTP host is set or
+// the sender address is invalid.
+func NewMailer(cfg config.EmailConfig) *Mailer {
+	m := &Mailer{cfg: cfg}
+	if cfg.SMTPHost == "" {
+		return m
+	}
+
+	from, err := mail.ParseAddress(cfg.From)
+	if err != nil {
+		fmt.Printf("Error parsing email sender %q, email notifications are disabled: %v\n", cfg.From, err)
+		return m
+	}
+	m.from = from
+
+	return m
+}
+
+// Enabled reports whether the mailer can send email
+func (m *Mailer) Enabled() bool {
+	return m.from != nil
+}
+
+// Domain is the domain of the sender address, used in message IDs
+func (m *Mailer) Domain() string {
+	if m.from == nil {
+		return "localhost"
+	}
+	_, domain, found := strings.Cut(m.from.Address, "@")
+	if !found {
+		return "localhost"
+	}
+	return domain
+}
+
+// Send sends an email. Rejections by the server (5xx replies) are permanent
+// errors; anything else may succeed when retried.
+func (m *Mailer) Send(ctx context.Context, email *Email) error {
+	if !m.Enabled() {
+		return permanentError{errors.New("email is not configured")}
+	}
+
+	to, err := mail.ParseAddress(email.To)
+	if err != nil {
+		return permanentError{fmt.Errorf("invalid recipient %q: %v", email.To, err)}
+	}
+
+	message, err := m.compose(email)
+	if err != nil {
+		return permanentError{err}
+	}
+
+	err = m.send(ctx, to.Address, message)
+	var smtpErr *textproto.Error
+	if errors.As(err, &smtpErr) && smtpErr.Code >= 500 {
+		return permanentError{err}
+	}
+	return err
+}
+
+// send runs an SMTP session delivering a message to one recipient
+func (m *Mailer) send(ctx context.Context, to string, message []byte) error {
+	ctx, cancel := context.WithTimeout(ctx, smtpTimeout)
+	defer cancel()
+
+	dialer := &net.Dialer{}
+	conn, err := dialer.DialContext(ctx, "tcp", net.JoinHostPort(m.cfg.SMTPHost, strconv.Itoa(m.cfg.SMTPPort)))
+	if err != nil {
+		return fmt.Errorf("failed to connect to SMTP server: %v", err)
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		conn.SetDeadline(deadline)
+	}
+
+	client, err := smtp.NewClient(conn, m.cfg.SMTPHost)
+	if err != nil {
+		conn.Close()
+		return err
+	}
+	defer client.Close()
+
+	if m.cfg.StartTLS {
+		if ok, _ := client.Extension("STARTTLS"); !ok {
+			return errors.New("SMTP server does not support STARTTLS")
+		}
+		if err := client.StartTLS(&tls.Config{ServerName: m.cfg.SMTPHost}); err != nil {
+			return fmt.Errorf("failed to start TLS: %v", err)
+		}
+	}
+
+	if m.cfg.Username != "" {
+		auth := smtp.PlainAuth("", m.cfg.Username, m.cfg.Password, m.cfg.SMTPHost)
+		if err := client.Auth(auth); err != nil {
+			return fmt.Errorf("failed to authenticate: %w", err)
+		}
+	}
+
+	if err := client.Mail(m.from.Address); err != nil {
+		return err
+	}
+	if err := client.Rcpt(to); err != nil {
+		return err
+	}
+
+	w, err := client.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(message); err != nil {
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+
+	return client.Quit()
+}
+
+// compose builds the MIME message of an email, with plain-text and HTML
+// alternatives
+func (m *Mailer) compose(email *Email) ([]byte, error) {
+	var body bytes.Buffer
+	parts := multipart.NewWriter(&body)
+
+	for _, alternative := range []struct {
+		contentType, content string
+	}{
+		{"text/plain; charset=utf-8", email.Text},
+		{"text/html; charset=utf-8", email.HTML},
+	} {
+		part, err := parts.CreatePart(textproto.MIMEHeader{
+			"Content-Type":              {alternative.contentType},
+			"Content-Transfer-Encoding": {"quoted-printable"},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		qp := quotedprintable.NewWriter(part)
+		if _, err := qp.Write([]byte(alternative.content)); err != nil {
+			return nil, err
+		}
+		if err := qp.Close(); err != nil {
+			return nil, err
+		}
+	}
+	if err := parts.Close(); err != nil {
+		return nil, err
+	}
+
+	var message bytes.Buffer
+	header := func(name, value string) {
+		fmt.Fprintf(&message, "%s: %s\r\n", name, value)
+	}
+
+	header("From", m.from.String())
+	header("To", email.To)
+	header("Subject", mime.QEncoding.Encode("utf-8", email.Subject))
+	header("Date", time.Now().Format(time.RFC1123Z))
+	header("Message-ID", email.MessageID)
+
+	// Replies to the first email about the issue keep every email about it in
+	// one conversation
+	if email.InReplyTo != "" {
+		header("In-Reply-To", email.InReplyTo)
+		header("References", email.InReplyTo)
+	}
+
+	// One-click unsubscribe (RFC 8058)
+	if email.UnsubscribeURL != "" {
+		header("List-Unsubscribe", "<"+email.UnsubscribeURL+">")
+		header("List-Unsubscribe-Post", "List-Unsubscribe=One-Click")
+	}
+
+	header("Auto-Submitted", "auto-generated")
+	header("MIME-Version", "1.0")
+	header("Content-Type", "multipart/alternative; boundary="+parts.Boundary())
+	message.WriteString("\r\n")
+	message.Write(body.Bytes())
+
+	return message.Bytes(), nil
+}
@@ -0,0 +1,341 @@
+// services/notification/mailer_test.go
+package notification
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/base64"
+	"errors"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net"
+	"net/mail"
+	"net/textproto"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/matthewmc1/buganizer/config"
+)
+
+func testEmail() *Email {
+	return &Email{
+		To:             "Dana <dana@example.com>",
+		Subject:        "[P1] Crash on save – Issue assigned",
+		Text:           "Issue assigned to you.\nhttps://bugs.example.com/issues/1",
+		HTML:           `<p>Issue assigned to <b>you</b>.</p>`,
+		MessageID:      "<issue-1.2@bugs.example.com>",
+		InReplyTo:      "<issue-1@bugs.example.com>",
+		UnsubscribeURL: "https://bugs.example.com/unsubscribe?token=abc",
+	}
+}
+
+func TestComposeHeaders(t *testing.T) {
+	m := NewMailer(config.EmailConfig{SMTPHost: "smtp.example.com", From: "Buganizer <bugs@example.com>"})
+
+	raw, err := m.compose(testEmail())
+	if err != nil {
+		t.Fatalf("compose: %v", err)
+	}
+	msg, err := mail.ReadMessage(bytes.NewReader(raw))
+	if err != nil {
+		t.Fatalf("compose produced an unparseable message: %v", err)
+	}
+
+	subject, err := new(mime.WordDecoder).DecodeHeader(msg.Header.Get("Subject"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if subject != "[P1] Crash on save – Issue assigned" {
+		t.Errorf("Subject = %q", subject)
+	}
+
+	headers := map[string]string{
+		"From":                  `"Buganizer" <bugs@example.com>`,
+		"To":                    "Dana <dana@example.com>",
+		"Message-ID":            "<issue-1.2@bugs.example.com>",
+		"In-Reply-To":           "<issue-1@bugs.example.com>",
+		"References":            "<issue-1@bugs.example.com>",
+		"List-Unsubscribe":      "<https://bugs.example.com/unsubscribe?token=abc>",
+		"List-Unsubscribe-Post": "List-Unsubscribe=One-Click",
+		"Auto-Submitted":        "auto-generated",
+		"MIME-Version":          "1.0",
+	}
+	for name, want := range headers {
+		if got := msg.Header.Get(name); got != want {
+			t.Errorf("%s = %q, want %q", name, got, want)
+		}
+	}
+	if _, err := msg.Header.Date(); err != nil {
+		t.Errorf("Date: %v", err)
+	}
+}
+
+func TestComposeWithoutThreadingOrUnsubscribe(t *testing.T) {
+	m := NewMailer(config.EmailConfig{SMTPHost: "smtp.example.com", From: "bugs@example.com"})
+
+	email := testEmail()
+	email.InReplyTo = ""
+	email.UnsubscribeURL = ""
+	raw, err := m.compose(email)
+	if err != nil {
+		t.Fatalf("compose: %v", err)
+	}
+	msg, err := mail.ReadMessage(bytes.NewReader(raw))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The first email about an issue starts the thread
+	for _, name := range []string{"In-Reply-To", "References", "List-Unsubscribe", "List-Unsubscribe-Post"} {
+		if got := msg.Header.Get(name); got != "" {
+			t.Errorf("%s = %q, want no header", name, got)
+		}
+	}
+}
+
+func TestComposeAlternatives(t *testing.T) {
+	m := NewMailer(config.EmailConfig{SMTPHost: "smtp.example.com", From: "bugs@example.com"})
+
+	email := testEmail()
+	email.Text = strings.Repeat("a long line ", 20) + "ends with é"
+	raw, err := m.compose(email)
+	if err != nil {
+		t.Fatalf("compose: %v", err)
+	}
+	msg, err := mail.ReadMessage(bytes.NewReader(raw))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	mediaType, params, err := mime.ParseMediaType(msg.Header.Get("Content-Type"))
+	if err != nil || mediaType != "multipart/alternative" {
+		t.Fatalf("Content-Type = %q (%v)", msg.Header.Get("Content-Type"), err)
+	}
+
+	// Plain text first, so clients prefer the HTML part
+	want := []struct{ contentType, content string }{
+		{"text/plain; charset=utf-8", email.Text},
+		{"text/html; charset=utf-8", email.HTML},
+	}
+	parts := multipart.NewReader(msg.Body, params["boundary"])
+	for i, w := range want {
+		part, err := parts.NextPart()
+		if err != nil {
+			t.Fatalf("part %d: %v", i, err)
+		}
+		if got := part.Header.Get("Content-Type"); got != w.contentType {
+			t.Errorf("part %d Content-Type = %q, want %q", i, got, w.contentType)
+		}
+		// NextPart decodes quoted-printable and drops the header
+		content, err := io.ReadAll(part)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(content) != w.content {
+			t.Errorf("part %d = %q, want %q", i, content, w.content)
+		}
+	}
+	if _, err := parts.NextPart(); err != io.EOF {
+		t.Errorf("expected two parts, got another (%v)", err)
+	}
+
+	for _, line := range strings.Split(string(raw), "\r\n") {
+		if len(line) > 998 {
+			t.Errorf("line of %d bytes exceeds the SMTP limit", len(line))
+		}
+	}
+}
+
+// fakeSMTP is an SMTP server accepting one message per session. It rejects
+// recipients with rcptReply when set.
+type fakeSMTP struct {
+	listener  net.Listener
+	rcptReply string
+	sessions  chan smtpSession
+}
+
+// smtpSession is what the client sent in one session
+type smtpSession struct {
+	auth string
+	from string
+	to   []string
+	data string
+}
+
+func startFakeSMTP(t *testing.T, rcptReply string) *fakeSMTP {
+	t.Helper()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to listen: %v", err)
+	}
+	t.Cleanup(func() { listener.Close() })
+
+	f := &fakeSMTP{listener: listener, rcptReply: rcptReply, sessions: make(chan smtpSession, 10)}
+	go f.serve()
+	return f
+}
+
+func (f *fakeSMTP) config(username, password string) config.EmailConfig {
+	host, port, _ := net.SplitHostPort(f.listener.Addr().String())
+	portNumber, _ := strconv.Atoi(port)
+	return config.EmailConfig{
+		SMTPHost: host,
+		SMTPPort: portNumber,
+		Username: username,
+		Password: password,
+		From:     "Buganizer <bugs@example.com>",
+	}
+}
+
+func (f *fakeSMTP) serve() {
+	for {
+		conn, err := f.listener.Accept()
+		if err != nil {
+			return
+		}
+		go f.handle(conn)
+	}
+}
+
+func (f *fakeSMTP) handle(conn net.Conn) {
+	defer conn.Close()
+	text := textproto.NewConn(conn)
+
+	var session smtpSession
+	reply := func(line string) { text.PrintfLine("%s", line) }
+
+	reply("220 localhost fake SMTP")
+	for {
+		line, err := text.ReadLine()
+		if err != nil {
+			return
+		}
+		verb, arg, _ := strings.Cut(line, " ")
+
+		switch strings.ToUpper(verb) {
+		case "EHLO":
+			reply("250-localhost")
+			reply("250 AUTH PLAIN")
+		case "AUTH":
+			mechanism, initial, _ := strings.Cut(arg, " ")
+			decoded, err := base64.StdEncoding.DecodeString(initial)
+			if mechanism != "PLAIN" || err != nil {
+				reply("504 unsupported authentication")
+				continue
+			}
+			session.auth = string(decoded)
+			reply("235 authenticated")
+		case "MAIL":
+			session.from = strings.TrimPrefix(arg, "FROM:")
+			reply("250 OK")
+		case "RCPT":
+			if f.rcptReply != "" {
+				reply(f.rcptReply)
+				continue
+			}
+			session.to = append(session.to, strings.TrimPrefix(arg, "TO:"))
+			reply("250 OK")
+		case "DATA":
+			reply("354 go ahead")
+			data, err := io.ReadAll(text.DotReader())
+			if err != nil {
+				return
+			}
+			session.data = string(data)
+			reply("250 queued")
+		case "QUIT":
+			reply("221 bye")
+			f.sessions <- session
+			return
+		default:
+			reply("502 not implemented")
+		}
+	}
+}
+
+func TestMailerSend(t *testing.T) {
+	server := startFakeSMTP(t, "")
+	m := NewMailer(server.config("", ""))
+
+	if err := m.Send(context.Background(), testEmail()); err != nil {
+		t.Fatalf("Send: %v", err)
+	}
+
+	session := <-server.sessions
+	if session.auth != "" {
+		t.Errorf("authenticated without credentials: %q", session.auth)
+	}
+	if session.from != "<bugs@example.com>" {
+		t.Errorf("MAIL FROM = %q", session.from)
+	}
+	if len(session.to) != 1 || session.to[0] != "<dana@example.com>" {
+		t.Errorf("RCPT TO = %q", session.to)
+	}
+
+	msg, err := mail.ReadMessage(bufio.NewReader(strings.NewReader(session.data)))
+	if err != nil {
+		t.Fatalf("delivered message is unparseable: %v", err)
+	}
+	if got := msg.Header.Get("Message-ID"); got != "<issue-1.2@bugs.example.com>" {
+		t.Errorf("delivered Message-ID = %q", got)
+	}
+}
+
+func TestMailerSendAuthenticates(t *testing.T) {
+	server := startFakeSMTP(t, "")
+	m := NewMailer(server.config("bugs", "hunter2"))
+
+	if err := m.Send(context.Background(), testEmail()); err != nil {
+		t.Fatalf("Send: %v", err)
+	}
+	if session := <-server.sessions; session.auth != "\x00bugs\x00hunter2" {
+		t.Errorf("AUTH PLAIN = %q", session.auth)
+	}
+}
+
+func TestMailerSendErrors(t *testing.T) {
+	tests := []struct {
+		name      string
+		rcptReply string
+		permanent bool
+	}{
+		{"rejected recipient", "550 no such user", true},
+		{"mailbox busy", "450 try again later", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := startFakeSMTP(t, tt.rcptReply)
+			m := NewMailer(server.config("", ""))
+
+			err := m.Send(context.Background(), testEmail())
+			if err == nil {
+				t.Fatal("Send succeeded, want an error")
+			}
+			var permanent permanentError
+			if errors.As(err, &permanent) != tt.permanent {
+				t.Errorf("Send error %v: permanent = %v, want %v", err, !tt.permanent, tt.permanent)
+			}
+		})
+	}
+}
+
+func TestMailerSendPermanentErrors(t *testing.T) {
+	var permanent permanentError
+
+	disabled := NewMailer(config.EmailConfig{})
+	if err := disabled.Send(context.Background(), testEmail()); !errors.As(err, &permanent) {
+		t.Errorf("Send without SMTP host: %v, want a permanent error", err)
+	}
+
+	m := NewMailer(config.EmailConfig{SMTPHost: "smtp.example.com", From: "bugs@example.com"})
+	email := testEmail()
+	email.To = "not an address"
+	if err := m.Send(context.Background(), email); !errors.As(err, &permanent) {
+		t.Errorf("Send to an invalid address: %v, want a permanent error", err)
+	}
+}
@@ -0,0 +1,429 @@
+// services/notification/outbox.go
+package notification
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"expvar"
+	"fmt"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/matthewmc1/buganizer/config"
+	"github.com/matthewmc1/buganizer/models"
+	pb "github.com/matthewmc1/buganizer/proto"
+)
+
+const (
+	// outboxLease is how long a worker holds a message before another may
+	// deliver it
+	outboxLease = 2 * time.Minute
+)
+
+// Outbox metrics, served with the other expvars at /debug/vars on the admin server
+var (
+	outboxMetrics = expvar.NewMap("notification_outbox")
+	outboxLatency = expvar.NewMap("notification_outbox_latency_seconds")
+
+	// latencyBuckets are the upper bounds, in seconds, of the delivery
+	// latency histogram
+	latencyBuckets = []float64{1, 5, 30, 60, 300, 900, 3600}
+)
+
+// permanentError marks a delivery that cannot succeed by retrying, such as
+// one for a deleted webhook
+type permanentError struct {
+	err error
+}
+
+func (e permanentError) Error() string { return e.err.Error() }
+
+// NewNotificationMessage wraps a notification in an outbox message. The
+// caller writes it together with the change it describes, and the outbox
+// worker sends it once that change is committed.
+func NewNotificationMessage(req *pb.NotificationRequest) (*models.OutboxMessage, error) {
+	issueID, err := uuid.Parse(req.IssueId)
+	if err != nil {
+		return nil, fmt.Errorf("invalid issue ID: %v", err)
+	}
+
+	payload, err := protojson.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+
+	return models.NewOutboxMessage(models.OutboxNotification, &issueID, payload), nil
+}
+
+// OutboxWorker delivers the messages of the notification outbox with a pool
+// of workers. Messages are leased while they are delivered, so every replica
+// can run a worker. Failed deliveries are retried with exponential backoff
+// and jitter until they are dead-lettered.
+type OutboxWorker struct {
+	service     *Service
+	workers     int
+	interval    time.Duration
+	maxAttempts int
+	baseBackoff time.Duration
+	maxBackoff  time.Duration
+}
+
+// NewOutboxWorker creates a new outbox worker
+func NewOutboxWorker(service *Service, cfg config.OutboxConfig) *OutboxWorker {
+	workers := cfg.Workers
+	if workers < 1 {
+		workers = 1
+	}
+
+	return &OutboxWorker{
+		service:     service,
+		workers:     workers,
+		interval:    time.Duration(cfg.PollSeconds) * time.Second,
+		maxAttempts: cfg.MaxAttempts,
+		baseBackoff: time.Duration(cfg.BaseBackoffSeconds) * time.Second,
+		maxBackoff:  time.Duration(cfg.MaxBackoffSeconds) * time.Second,
+	}
+}
+
+// Run delivers due messages on every interval until ctx is cancelled. It does
+// nothing when the interval is not positive.
+func (w *OutboxWorker) Run(ctx context.Context) {
+	if w.interval <= 0 {
+		return
+	}
+
+	jobs := make(chan *models.OutboxMessage)
+	var wg sync.WaitGroup
+	for i := 0; i < w.workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for message := range jobs {
+				w.deliver(ctx, message)
+			}
+		}()
+	}
+	defer func() {
+		close(jobs)
+		wg.Wait()
+	}()
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		if err := w.dispatch(ctx, jobs); err != nil {
+			fmt.Printf("Error delivering outbox messages: %v\n", err)
+		}
+		w.updateStats(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// dispatch claims due messages and hands them to the workers until none are
+// left
+func (w *OutboxWorker) dispatch(ctx context.Context, jobs chan<- *models.OutboxMessage) error {
+	batchSize := w.workers * 2
+	for {
+		messages, err := w.service.outboxRepo.ClaimDue(ctx, time.Now(), outboxLease, batchSize)
+		if err != nil {
+			return fmt.Errorf("failed to claim outbox messages: %v", err)
+		}
+
+		for _, message := range messages {
+			select {
+			case <-ctx.Done():
+				// Unsent messages are claimed again once their lease expires
+				return nil
+			case jobs <- message:
+			}
+		}
+
+		if len(messages) < batchSize {
+			return nil
+		}
+	}
+}
+
+// deliver delivers one message and records the outcome
+func (w *OutboxWorker) deliver(ctx context.Context, message *models.OutboxMessage) {
+	s := w.service
+
+	err := s.deliverOutbox(ctx, message)
+	if err == nil {
+		if message.Kind == models.OutboxNotification {
+			// Expanding the notification has already completed it
+			outboxMetrics.Add("expanded", 1)
+			return
+		}
+		if message.Kind == models.OutboxDigest {
+			// Sending the digest has already completed it
+			outboxMetrics.Add("digested", 1)
+			return
+		}
+
+		now := time.Now()
+		if err := s.outboxRepo.MarkDelivered(ctx, message.ID, now); err != nil {
+			fmt.Printf("Error marking outbox message %s delivered: %v\n", message.ID, err)
+			return
+		}
+		outboxMetrics.Add("delivered", 1)
+		observeLatency(now.Sub(message.CreatedAt))
+		return
+	}
+
+	var permanent permanentError
+	if errors.As(err, &permanent) || message.Attempts >= w.maxAttempts {
+		fmt.Printf("Error delivering outbox message %s, giving up after %d attempts: %v\n", message.ID, message.Attempts, err)
+		if err := s.outboxRepo.MarkDead(ctx, message.ID, err.Error()); err != nil {
+			fmt.Printf("Error dead-lettering outbox message %s: %v\n", message.ID, err)
+			return
+		}
+		outboxMetrics.Add("dead_lettered", 1)
+		return
+	}
+
+	if err := s.outboxRepo.Retry(ctx, message.ID, time.Now().Add(w.backoff(message.Attempts)), err.Error()); err != nil {
+		fmt.Printf("Error rescheduling outbox message %s: %v\n", message.ID, err)
+		return
+	}
+	outboxMetrics.Add("retried", 1)
+}
+
+// backoff returns the delay before retrying a message that has been attempted
+// the given number of times: the base backoff doubled for each attempt after
+// the first, capped at the maximum, with half of it randomized so retries of
+// messages that failed together spread out
+func (w *OutboxWorker) backoff(attempts int) time.Duration {
+	delay := w.baseBackoff
+	for i := 1; i < attempts && delay < w.maxBackoff; i++ {
+		delay *= 2
+	}
+	if delay > w.maxBackoff {
+		delay = w.maxBackoff
+	}
+	if delay <= 0 {
+		return 0
+	}
+
+	half := delay / 2
+	return half + time.Duration(rand.Int63n(int64(delay-half)+1))
+}
+
+// updateStats refreshes the queue depth metrics
+func (w *OutboxWorker) updateStats(ctx context.Context) {
+	stats, err := w.service.outboxRepo.GetStats(ctx)
+	if err != nil {
+		fmt.Printf("Error getting outbox stats: %v\n", err)
+		return
+	}
+
+	pending := new(expvar.Int)
+	pending.Set(int64(stats.Pending))
+	outboxMetrics.Set("pending", pending)
+
+	dead := new(expvar.Int)
+	dead.Set(int64(stats.Dead))
+	outboxMetrics.Set("dead", dead)
+
+	oldest := new(expvar.Float)
+	if stats.OldestPending != nil {
+		oldest.Set(time.Since(*stats.OldestPending).Seconds())
+	}
+	outboxMetrics.Set("oldest_pending_seconds", oldest)
+}
+
+// observeLatency adds the time from enqueueing a message to delivering it to
+// the latency histogram
+func observeLatency(latency time.Duration) {
+	seconds := latency.Seconds()
+	for _, bound := range latencyBuckets {
+		if seconds <= bound {
+			outboxLatency.Add(fmt.Sprintf("le_%g", bound), 1)
+		}
+	}
+	outboxLatency.Add("le_inf", 1)
+	outboxLatency.AddFloat("sum", seconds)
+	outboxLatency.Add("count", 1)
+}
+
+// deliverOutbox delivers a message according to its kind
+func (s *Service) deliverOutbox(ctx context.Context, message *models.OutboxMessage) error {
+	switch message.Kind {
+	case models.OutboxNotification:
+		return s.expandNotification(ctx, message)
+	case models.OutboxSlack:
+		var slackMessage SlackMessage
+		if err := json.Unmarshal(message.Payload, &slackMessage); err != nil {
+			return permanentError{fmt.Errorf("invalid Slack message: %v", err)}
+		}
+		return s.slackClient.SendMessage(slackMessage.Channel, slackMessage.Text, slackMessage.Blocks)
+	case models.OutboxWebhook:
+		return s.callWebhook(ctx, message)
+	case models.OutboxSlackDM:
+		return s.sendSlackDirectMessage(message.Payload)
+	case models.OutboxDigest:
+		return s.sendDigest(ctx, message)
+	case models.OutboxInbox:
+		return s.addToInbox(ctx, message.Payload)
+	case models.OutboxEmail:
+		var email Email
+		if err := json.Unmarshal(message.Payload, &email); err != nil {
+			return permanentError{fmt.Errorf("invalid email: %v", err)}
+		}
+		return s.mailer.Send(ctx, &email)
+	default:
+		return permanentError{fmt.Errorf("unknown outbox message kind %q", message.Kind)}
+	}
+}
+
+// expandNotification replaces a notification with the messages it is
+// delivered as
+func (s *Service) expandNotification(ctx context.Context, message *models.OutboxMessage) error {
+	var req pb.NotificationRequest
+	if err := protojson.Unmarshal(message.Payload, &req); err != nil {
+		return permanentError{fmt.Errorf("invalid notification: %v", err)}
+	}
+
+	issueID, err := uuid.Parse(req.IssueId)
+	if err != nil {
+		return permanentError{fmt.Errorf("invalid issue ID: %v", err)}
+	}
+
+	issue, err := s.issueRepo.GetByID(ctx, issueID)
+	if err == sql.ErrNoRows {
+		return permanentError{fmt.Errorf("issue %s not found", issueID)}
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get issue: %v", err)
+	}
+
+	if _, err := parseRecipients(&req); err != nil {
+		return permanentError{err}
+	}
+
+	children, err := s.notificationMessages(ctx, &req, issue)
+	if err != nil {
+		return err
+	}
+
+	return s.outboxRepo.Expand(ctx, message.ID, children, time.Now())
+}
+
+// notificationMessages creates the messages a notification is delivered as:
+// one for every user and channel it goes to, the Slack channel and every
+// webhook, so each is retried on its own
+func (s *Service) notificationMessages(ctx context.Context, req *pb.NotificationRequest, issue *models.Issue) ([]*models.OutboxMessage, error) {
+	channel, text, blocks := s.compose(req, issue)
+
+	// Notify the users the event concerns on their own channels
+	messages, err := s.fanOut(ctx, req, issue, text, blocks)
+	if err != nil {
+		return nil, err
+	}
+
+	// Notifications for given users go to them alone
+	if len(req.RecipientIds) > 0 {
+		return messages, nil
+	}
+
+	slackMessage, err := newSlackMessage(issue.ID, channel, text, blocks)
+	if err != nil {
+		return nil, permanentError{err}
+	}
+	messages = append(messages, slackMessage)
+
+	webhookMessages, err := s.webhookMessages(ctx, req, issue)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(messages, webhookMessages...), nil
+}
+
+// callWebhook posts the payload of a message to its webhook
+func (s *Service) callWebhook(ctx context.Context, message *models.OutboxMessage) error {
+	if message.WebhookID == nil {
+		return permanentError{fmt.Errorf("webhook message without a webhook")}
+	}
+
+	webhook, err := s.webhookRepo.GetByID(ctx, *message.WebhookID)
+	if err == sql.ErrNoRows {
+		return permanentError{fmt.Errorf("webhook %s not found", *message.WebhookID)}
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get webhook: %v", err)
+	}
+
+	eventType, err := payloadEventType(message.Payload)
+	if err != nil {
+		return permanentError{fmt.Errorf("invalid webhook payload: %v", err)}
+	}
+
+	// Send the stored payload as is, so retries match the first attempt
+	delivery := s.sendWebhook(ctx, *webhook, eventType, message.IssueID, message.Payload, nil)
+	if !delivery.Success {
+		return errors.New(delivery.Error)
+	}
+	return nil
+}
+
+// webhookMessages creates one outbox message for every webhook of the
+// issue's organization subscribed to a notification, with the payload in the
+// webhook's format
+func (s *Service) webhookMessages(ctx context.Context, req *pb.NotificationRequest, issue *models.Issue) ([]*models.OutboxMessage, error) {
+	organizationID, err := s.componentRepo.GetOrganizationID(ctx, issue.ComponentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get organization: %v", err)
+	}
+
+	webhooks, err := s.webhookRepo.ListByEventType(ctx, organizationID, req.Type.String())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get webhooks: %v", err)
+	}
+	if len(webhooks) == 0 {
+		return nil, nil
+	}
+
+	payload := s.webhookPayload(ctx, req, issue)
+
+	messages := make([]*models.OutboxMessage, 0, len(webhooks))
+	for _, webhook := range webhooks {
+		body, err := s.encodeWebhookPayload(webhook, payload)
+		if err != nil {
+			return nil, err
+		}
+
+		webhookID := webhook.ID
+		message := models.NewOutboxMessage(models.OutboxWebhook, &issue.ID, body)
+		message.WebhookID = &webhookID
+		messages = append(messages, message)
+	}
+
+	return messages, nil
+}
+
+// newSlackMessage creates an outbox message for a Slack channel
+func newSlackMessage(issueID uuid.UUID, channel, text string, blocks []SlackBlock) (*models.OutboxMessage, error) {
+	payload, err := json.Marshal(SlackMessage{
+		Channel: channel,
+		Text:    text,
+		Blocks:  blocks,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return models.NewOutboxMessage(models.OutboxSlack, &issueID, payload), nil
+}
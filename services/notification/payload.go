@@ -0,0 +1,259 @@
+// services/notification/payload.go
+package notification
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/matthewmc1/buganizer/models"
+	pb "github.com/matthewmc1/buganizer/proto"
+)
+
+const (
+	// webhookSchemaVersion is the version of WebhookPayload. It changes when
+	// fields are removed or change meaning, not when fields are added.
+	webhookSchemaVersion = 2
+
+	// cloudEventsContentType is the content type of CloudEvents deliveries
+	cloudEventsContentType = "application/cloudevents+json; charset=utf-8"
+)
+
+// WebhookPayload represents the payload sent to webhooks
+type WebhookPayload struct {
+	SchemaVersion int    `json:"schema_version"`
+	EventType     string `json:"event_type"`
+	Timestamp     int64  `json:"timestamp"`
+	Message       string `json:"message,omitempty"`
+
+	// Summary of the issue, as in schema version 1
+	IssueID  string `json:"issue_id,omitempty"`
+	Title    string `json:"title,omitempty"`
+	Status   string `json:"status,omitempty"`
+	Priority string `json:"priority,omitempty"`
+	Severity string `json:"severity,omitempty"`
+	URL      string `json:"url,omitempty"`
+
+	Issue   *WebhookIssue   `json:"issue,omitempty"`
+	Actor   *WebhookUser    `json:"actor,omitempty"`   // Missing for system events
+	Changes []WebhookChange `json:"changes,omitempty"` // For update events
+	Comment *WebhookComment `json:"comment,omitempty"` // For COMMENT_ADDED
+}
+
+// WebhookIssue is the issue an event is about, as it is when the event is
+// delivered. Deliveries are retried from the outbox, so it may include later
+// changes; Changes describes what the event itself changed.
+type WebhookIssue struct {
+	ID              string            `json:"id"`
+	Title           string            `json:"title"`
+	Description     string            `json:"description"`
+	ReproduceSteps  string            `json:"reproduce_steps"`
+	Status          string            `json:"status"`
+	Priority        string            `json:"priority"`
+	Severity        string            `json:"severity"`
+	Component       *WebhookComponent `json:"component"`
+	Reporter        *WebhookUser      `json:"reporter"`
+	Assignee        *WebhookUser      `json:"assignee"` // Null when unassigned
+	Labels          []string          `json:"labels"`
+	DueDate         *time.Time        `json:"due_date"`
+	ResponseDueDate *time.Time        `json:"response_due_date"`
+	ResolvedAt      *time.Time        `json:"resolved_at"`
+	CreatedAt       time.Time         `json:"created_at"`
+	UpdatedAt       time.Time         `json:"updated_at"`
+	URL             string            `json:"url"`
+}
+
+// WebhookUser is a user in a webhook payload
+type WebhookUser struct {
+	ID    string `json:"id"`
+	Name  string `json:"name,omitempty"`
+	Email string `json:"email,omitempty"`
+}
+
+// WebhookComponent is a component in a webhook payload
+type WebhookComponent struct {
+	ID   string `json:"id"`
+	Name string `json:"name,omitempty"`
+}
+
+// WebhookChange is a field changed by an update
+type WebhookChange struct {
+	Field string `json:"field"`
+	Old   string `json:"old"`
+	New   string `json:"new"`
+}
+
+// WebhookComment is the comment added by a COMMENT_ADDED event
+type WebhookComment struct {
+	ID   string `json:"id"`
+	Body string `json:"body"`
+}
+
+// CloudEvent is a CloudEvents 1.0 event in the structured JSON format
+type CloudEvent struct {
+	SpecVersion     string         `json:"specversion"`
+	ID              string         `json:"id"`
+	Source          string         `json:"source"`
+	Type            string         `json:"type"`
+	Subject         string         `json:"subject,omitempty"`
+	Time            time.Time      `json:"time"`
+	DataContentType string         `json:"datacontenttype"`
+	Data            WebhookPayload `json:"data"`
+}
+
+// webhookPayload creates the payload sent to webhooks for a notification
+// about an issue
+func (s *Service) webhookPayload(ctx context.Context, req *pb.NotificationRequest, issue *models.Issue) WebhookPayload {
+	issueURL := fmt.Sprintf("%s/issues/%s", s.config.BaseURL, issue.ID.String())
+
+	labels := issue.Labels
+	if labels == nil {
+		labels = []string{}
+	}
+
+	payload := WebhookPayload{
+		SchemaVersion: webhookSchemaVersion,
+		EventType:     req.Type.String(),
+		Timestamp:     time.Now().Unix(),
+		Message:       req.Message,
+		IssueID:       issue.ID.String(),
+		Title:         issue.Title,
+		Status:        string(issue.Status),
+		Priority:      string(issue.Priority),
+		Severity:      string(issue.Severity),
+		URL:           issueURL,
+		Issue: &WebhookIssue{
+			ID:              issue.ID.String(),
+			Title:           issue.Title,
+			Description:     issue.Description,
+			ReproduceSteps:  issue.ReproduceSteps,
+			Status:          string(issue.Status),
+			Priority:        string(issue.Priority),
+			Severity:        string(issue.Severity),
+			Component:       s.webhookComponent(ctx, issue.ComponentID),
+			Reporter:        s.webhookUser(ctx, issue.ReporterID),
+			Labels:          labels,
+			DueDate:         issue.DueDate,
+			ResponseDueDate: issue.ResponseDueDate,
+			ResolvedAt:      issue.ResolvedAt,
+			CreatedAt:       issue.CreatedAt,
+			UpdatedAt:       issue.UpdatedAt,
+			URL:             issueURL,
+		},
+	}
+
+	// Handle optional fields
+	if issue.AssigneeID != nil {
+		payload.Issue.Assignee = s.webhookUser(ctx, *issue.AssigneeID)
+	}
+
+	if actorID, err := uuid.Parse(req.ActorId); err == nil {
+		payload.Actor = s.webhookUser(ctx, actorID)
+	}
+
+	for _, change := range req.Changes {
+		payload.Changes = append(payload.Changes, WebhookChange{
+			Field: change.Field,
+			Old:   change.OldValue,
+			New:   change.NewValue,
+		})
+	}
+
+	if req.CommentId != "" {
+		payload.Comment = &WebhookComment{
+			ID:   req.CommentId,
+			Body: req.CommentContent,
+		}
+	}
+
+	return payload
+}
+
+// webhookUser looks up a user for a webhook payload, falling back to just
+// their ID
+func (s *Service) webhookUser(ctx context.Context, id uuid.UUID) *WebhookUser {
+	user, err := s.userRepo.GetByID(ctx, id)
+	if err != nil {
+		fmt.Printf("Error getting user %s for webhook payload: %v\n", id, err)
+		return &WebhookUser{ID: id.String()}
+	}
+
+	return &WebhookUser{
+		ID:    user.ID.String(),
+		Name:  user.Name,
+		Email: user.Email,
+	}
+}
+
+// webhookComponent looks up a component for a webhook payload, falling back
+// to just its ID
+func (s *Service) webhookComponent(ctx context.Context, id uuid.UUID) *WebhookComponent {
+	component, err := s.componentRepo.GetByID(ctx, id)
+	if err != nil {
+		fmt.Printf("Error getting component %s for webhook payload: %v\n", id, err)
+		return &WebhookComponent{ID: id.String()}
+	}
+
+	return &WebhookComponent{
+		ID:   component.ID.String(),
+		Name: component.Name,
+	}
+}
+
+// encodeWebhookPayload encodes a payload in a webhook's format
+func (s *Service) encodeWebhookPayload(wh models.Webhook, payload WebhookPayload) ([]byte, error) {
+	if wh.PayloadFormat != models.PayloadFormatCloudEvents {
+		return json.Marshal(payload)
+	}
+
+	event := CloudEvent{
+		SpecVersion:     "1.0",
+		ID:              uuid.New().String(),
+		Source:          s.config.BaseURL,
+		Type:            "com.buganizer." + strings.ToLower(payload.EventType),
+		Time:            time.Unix(payload.Timestamp, 0).UTC(),
+		DataContentType: "application/json",
+		Data:            payload,
+	}
+	if payload.IssueID != "" {
+		event.Subject = "issues/" + payload.IssueID
+	}
+
+	return json.Marshal(event)
+}
+
+// webhookContentType is the content type of a webhook's deliveries
+func webhookContentType(wh models.Webhook) string {
+	if wh.PayloadFormat == models.PayloadFormatCloudEvents {
+		return cloudEventsContentType
+	}
+	return "application/json"
+}
+
+// payloadEventType reads the event type of an encoded payload in either
+// format
+func payloadEventType(body []byte) (string, error) {
+	var payload struct {
+		EventType string `json:"event_type"`
+		Data      struct {
+			EventType string `json:"event_type"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return "", err
+	}
+
+	if payload.EventType != "" {
+		return payload.EventType, nil
+	}
+	return payload.Data.EventType, nil
+}
+
+// payloadFormatFromProto converts a protobuf payload format to the model
+func payloadFormatFromProto(format pb.Webhook_PayloadFormat) models.PayloadFormat {
+	return models.PayloadFormat(format.String())
+}
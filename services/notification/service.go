@@ -3,10 +3,9 @@ package notification
 
 import (
 	"context"
-	"crypto/hmac"
-	"crypto/sha256"
-	"encoding/hex"
+	"database/sql"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"strings"
@@ -26,12 +25,21 @@ import (
 // Service implements the NotificationService gRPC interface
 type Service struct {
 	pb.UnimplementedNotificationServiceServer
-	webhookRepo    repositories.WebhookRepository
-	userRepo       repositories.UserRepository
-	issueRepo      repositories.IssueRepository
-	preferenceRepo repositories.NotificationPreferenceRepository
-	slackClient    *SlackClient
-	config         *config.Config
+	webhookRepo      repositories.WebhookRepository
+	userRepo         repositories.UserRepository
+	issueRepo        repositories.IssueRepository
+	preferenceRepo   repositories.NotificationPreferenceRepository
+	watcherRepo      repositories.WatcherRepository
+	outboxRepo       repositories.OutboxRepository
+	deliveryRepo     repositories.WebhookDeliveryRepository
+	componentRepo    repositories.ComponentRepository
+	teamRepo         repositories.TeamRepository
+	notificationRepo repositories.NotificationRepository
+	slackClient      *SlackClient
+	mailer           *Mailer
+	targets          *targetGuard
+	webhookClient    *http.Client
+	config           *config.Config
 }
 
 // NewService creates a new notification service
@@ -40,19 +48,39 @@ func NewService(
 	userRepo repositories.UserRepository,
 	issueRepo repositories.IssueRepository,
 	preferenceRepo repositories.NotificationPreferenceRepository,
+	watcherRepo repositories.WatcherRepository,
+	outboxRepo repositories.OutboxRepository,
+	deliveryRepo repositories.WebhookDeliveryRepository,
+	componentRepo repositories.ComponentRepository,
+	teamRepo repositories.TeamRepository,
+	notificationRepo repositories.NotificationRepository,
 	config *config.Config,
 ) *Service {
+	targets := newTargetGuard(config.Webhook)
+
 	return &Service{
-		webhookRepo:    webhookRepo,
-		userRepo:       userRepo,
-		issueRepo:      issueRepo,
-		preferenceRepo: preferenceRepo,
-		slackClient:    NewSlackClient(config.Slack.APIToken),
-		config:         config,
+		webhookRepo:      webhookRepo,
+		userRepo:         userRepo,
+		issueRepo:        issueRepo,
+		preferenceRepo:   preferenceRepo,
+		watcherRepo:      watcherRepo,
+		outboxRepo:       outboxRepo,
+		deliveryRepo:     deliveryRepo,
+		componentRepo:    componentRepo,
+		teamRepo:         teamRepo,
+		notificationRepo: notificationRepo,
+		slackClient:      NewSlackClient(config.Slack.APIToken),
+		mailer:           NewMailer(config.Email),
+		targets:          targets,
+		webhookClient:    targets.client(webhookTimeout),
+		config:           config,
 	}
 }
 
-// SendSlackNotification sends a notification to Slack
+// SendSlackNotification sends a notification to Slack, to the users it
+// concerns and to the webhooks subscribed to it. Every message is queued in
+// the outbox in one transaction and delivered by the outbox worker, so the
+// notification is either queued in full or the call fails.
 func (s *Service) SendSlackNotification(ctx context.Context, req *pb.NotificationRequest) (*pb.NotificationResponse, error) {
 	if req.IssueId == "" {
 		return nil, status.Error(codes.InvalidArgument, "issue ID is required")
@@ -69,6 +97,191 @@ func (s *Service) SendSlackNotification(ctx context.Context, req *pb.Notificatio
 		return nil, status.Errorf(codes.Internal, "failed to get issue: %v", err)
 	}
 
+	if _, err := parseRecipients(req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	messages, err := s.notificationMessages(ctx, req, issue)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create notification: %v", err)
+	}
+
+	if err := s.outboxRepo.Enqueue(ctx, messages); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to queue notification: %v", err)
+	}
+
+	return &pb.NotificationResponse{
+		Success: true,
+		Message: "Notification queued successfully",
+	}, nil
+}
+
+// RegisterWebhook registers a webhook URL for notifications
+func (s *Service) RegisterWebhook(ctx context.Context, req *pb.RegisterWebhookRequest) (*pb.RegisterWebhookResponse, error) {
+	if req.Url == "" {
+		return nil, status.Error(codes.InvalidArgument, "webhook URL is required")
+	}
+
+	// Get user ID from context
+	userID, ok := ctx.Value("user_id").(string)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not authenticated")
+	}
+
+	if err := s.validateWebhookURL(ctx, req.Url); err != nil {
+		return nil, err
+	}
+	if err := validateEventTypes(req.EventTypes); err != nil {
+		return nil, err
+	}
+
+	secret := req.Secret
+	if secret == "" {
+		var err error
+		if secret, err = generateSecret(); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to generate secret: %v", err)
+		}
+	}
+
+	// Create webhook
+	webhook := &models.Webhook{
+		ID:            uuid.New(),
+		URL:           req.Url,
+		Description:   req.Description,
+		Secret:        secret,
+		CreatorID:     uuid.MustParse(userID),
+		EventTypes:    req.EventTypes,
+		CreatedAt:     time.Now(),
+		LastCalledAt:  nil,
+		PayloadFormat: payloadFormatFromProto(req.PayloadFormat),
+	}
+
+	// Save webhook to database
+	if err := s.webhookRepo.Create(ctx, webhook); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to register webhook: %v", err)
+	}
+
+	// The secret is only returned now, so the integrator can verify signatures
+	return &pb.RegisterWebhookResponse{
+		Id:      webhook.ID.String(),
+		Success: true,
+		Secret:  secret,
+	}, nil
+}
+
+// UpdateNotificationPreferences updates a user's notification preferences
+func (s *Service) UpdateNotificationPreferences(ctx context.Context, req *pb.UpdateNotificationPreferencesRequest) (*emptypb.Empty, error) {
+	// Get user ID from context or request
+	userID := req.UserId
+	if userID == "" {
+		var ok bool
+		userID, ok = ctx.Value("user_id").(string)
+		if !ok {
+			return nil, status.Error(codes.Unauthenticated, "user not authenticated")
+		}
+	}
+
+	// Check if user exists
+	_, err := s.userRepo.GetByID(ctx, uuid.MustParse(userID))
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "user not found: %v", err)
+	}
+
+	// Create or update preferences
+	pref := &models.NotificationPreference{
+		UserID:             uuid.MustParse(userID),
+		EmailNotifications: req.EmailNotifications,
+		SlackNotifications: req.SlackNotifications,
+		SubscribedEvents:   req.SubscribedEvents,
+		DigestFrequencies:  make(map[string]models.DigestFrequency),
+		TimeZone:           "UTC",
+		UpdatedAt:          time.Now(),
+	}
+
+	for notificationType, frequency := range req.DigestFrequencies {
+		if _, ok := pb.NotificationRequest_NotificationType_value[notificationType]; !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "unknown notification type %q", notificationType)
+		}
+		if frequency != pb.DigestFrequency_DIGEST_IMMEDIATE {
+			pref.DigestFrequencies[notificationType] = models.DigestFrequency(strings.TrimPrefix(frequency.String(), "DIGEST_"))
+		}
+	}
+
+	if req.TimeZone != "" {
+		if _, err := time.LoadLocation(req.TimeZone); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "unknown time zone %q", req.TimeZone)
+		}
+		pref.TimeZone = req.TimeZone
+	}
+
+	if (req.QuietHoursStart == "") != (req.QuietHoursEnd == "") {
+		return nil, status.Error(codes.InvalidArgument, "quiet hours need both a start and an end")
+	}
+	if pref.QuietHoursStart, err = parseClock(req.QuietHoursStart, 0); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid quiet hours start: %v", err)
+	}
+	if pref.QuietHoursEnd, err = parseClock(req.QuietHoursEnd, 0); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid quiet hours end: %v", err)
+	}
+	if pref.DailyDigestTime, err = parseClock(req.DailyDigestTime, models.DefaultDailyDigestTime); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid daily digest time: %v", err)
+	}
+
+	if err := s.preferenceRepo.Upsert(ctx, pref); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update notification preferences: %v", err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// Helper methods
+
+// PageWebhook calls one registered webhook about an issue and waits for its
+// answer, so the caller can retry when it fails. It is not part of the gRPC
+// API: the escalation scheduler calls it in-process. Webhooks of other
+// organizations than the issue's are not found.
+func (s *Service) PageWebhook(ctx context.Context, webhookID uuid.UUID, req *pb.NotificationRequest) error {
+	issueID, err := uuid.Parse(req.IssueId)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, "invalid issue ID format")
+	}
+
+	issue, err := s.issueRepo.GetByID(ctx, issueID)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get issue: %v", err)
+	}
+
+	webhook, err := s.webhookRepo.GetByID(ctx, webhookID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return status.Error(codes.NotFound, "webhook not found")
+		}
+		return status.Errorf(codes.Internal, "failed to get webhook: %v", err)
+	}
+
+	issueOrganizationID, err := s.componentRepo.GetOrganizationID(ctx, issue.ComponentID)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get organization: %v", err)
+	}
+	webhookOrganizationID, err := s.userRepo.GetOrganizationID(ctx, webhook.CreatorID)
+	if err != nil && err != sql.ErrNoRows {
+		return status.Errorf(codes.Internal, "failed to get organization: %v", err)
+	}
+	if err != nil || webhookOrganizationID != issueOrganizationID {
+		return status.Error(codes.NotFound, "webhook not found")
+	}
+
+	payload := s.webhookPayload(ctx, req, issue)
+
+	if err := s.postWebhook(ctx, *webhook, payload); err != nil {
+		return status.Errorf(codes.Unavailable, "failed to call webhook: %v", err)
+	}
+
+	return nil
+}
+
+// compose creates the Slack channel, text and blocks of a notification
+func (s *Service) compose(req *pb.NotificationRequest, issue *models.Issue) (string, string, []SlackBlock) {
 	// Determine which channel to send to (use default if not specified)
 	channel := req.Channel
 	if channel == "" {
@@ -92,6 +305,10 @@ func (s *Service) SendSlackNotification(ctx context.Context, req *pb.Notificatio
 			message = fmt.Sprintf("⚠️ SLA at risk for issue: %s", issue.Title)
 		case pb.NotificationRequest_SLA_BREACHED:
 			message = fmt.Sprintf("🚨 SLA breached for issue: %s", issue.Title)
+		case pb.NotificationRequest_SLA_DEADLINE_CHANGED:
+			message = fmt.Sprintf("⏰ SLA deadline moved earlier for issue: %s", issue.Title)
+		case pb.NotificationRequest_ISSUE_ESCALATED:
+			message = fmt.Sprintf("📈 Issue escalated: %s", issue.Title)
 		default:
 			message = fmt.Sprintf("Update on issue: %s", issue.Title)
 		}
@@ -151,161 +368,27 @@ func (s *Service) SendSlackNotification(ctx context.Context, req *pb.Notificatio
 		},
 	}
 
-	// Send notification to Slack
-	err = s.slackClient.SendMessage(channel, message, blocks)
-	if err != nil {
-		return &pb.NotificationResponse{
-			Success: false,
-			Message: fmt.Sprintf("Failed to send Slack notification: %v", err),
-		}, nil
-	}
-
-	// Also send notification to registered webhooks for this event type
-	go s.sendToWebhooks(ctx, req)
-
-	return &pb.NotificationResponse{
-		Success: true,
-		Message: "Notification sent successfully",
-	}, nil
+	return channel, message, blocks
 }
 
-// RegisterWebhook registers a webhook URL for notifications
-func (s *Service) RegisterWebhook(ctx context.Context, req *pb.RegisterWebhookRequest) (*pb.RegisterWebhookResponse, error) {
-	if req.Url == "" {
-		return nil, status.Error(codes.InvalidArgument, "webhook URL is required")
-	}
-
-	// Get user ID from context
-	userID, ok := ctx.Value("user_id").(string)
-	if !ok {
-		return nil, status.Error(codes.Unauthenticated, "user not authenticated")
-	}
-
-	// Create webhook
-	webhook := &models.Webhook{
-		ID:           uuid.New(),
-		URL:          req.Url,
-		Description:  req.Description,
-		Secret:       req.Secret,
-		CreatorID:    uuid.MustParse(userID),
-		EventTypes:   req.EventTypes,
-		CreatedAt:    time.Now(),
-		LastCalledAt: nil,
-	}
-
-	// Save webhook to database
-	if err := s.webhookRepo.Create(ctx, webhook); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to register webhook: %v", err)
-	}
-
-	return &pb.RegisterWebhookResponse{
-		Id:      webhook.ID.String(),
-		Success: true,
-	}, nil
-}
-
-// UpdateNotificationPreferences updates a user's notification preferences
-func (s *Service) UpdateNotificationPreferences(ctx context.Context, req *pb.UpdateNotificationPreferencesRequest) (*emptypb.Empty, error) {
-	// Get user ID from context or request
-	userID := req.UserId
-	if userID == "" {
-		var ok bool
-		userID, ok = ctx.Value("user_id").(string)
-		if !ok {
-			return nil, status.Error(codes.Unauthenticated, "user not authenticated")
-		}
-	}
-
-	// Check if user exists
-	_, err := s.userRepo.GetByID(ctx, uuid.MustParse(userID))
+// postWebhook posts a payload to a webhook, signed with its secret, and
+// records the attempt
+func (s *Service) postWebhook(ctx context.Context, wh models.Webhook, payload WebhookPayload) error {
+	body, err := s.encodeWebhookPayload(wh, payload)
 	if err != nil {
-		return nil, status.Errorf(codes.NotFound, "user not found: %v", err)
+		return err
 	}
 
-	// Create or update preferences
-	pref := &models.NotificationPreference{
-		UserID:             uuid.MustParse(userID),
-		EmailNotifications: req.EmailNotifications,
-		SlackNotifications: req.SlackNotifications,
-		SubscribedEvents:   req.SubscribedEvents,
-		UpdatedAt:          time.Now(),
+	var issueID *uuid.UUID
+	if id, err := uuid.Parse(payload.IssueID); err == nil {
+		issueID = &id
 	}
 
-	if err := s.preferenceRepo.Upsert(ctx, pref); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to update notification preferences: %v", err)
-	}
-
-	return &emptypb.Empty{}, nil
-}
-
-// Helper methods
-
-// sendToWebhooks sends a notification to all registered webhooks for the given event type
-func (s *Service) sendToWebhooks(ctx context.Context, req *pb.NotificationRequest) {
-	// Get all webhooks that are subscribed to this event type
-	eventType := req.Type.String()
-	webhooks, err := s.webhookRepo.ListByEventType(ctx, eventType)
-	if err != nil {
-		// Log error but don't fail the request
-		fmt.Printf("Error getting webhooks: %v\n", err)
-		return
-	}
-
-	// Get issue details for the payload
-	issueID, _ := uuid.Parse(req.IssueId)
-	issue, err := s.issueRepo.GetByID(ctx, issueID)
-	if err != nil {
-		fmt.Printf("Error getting issue: %v\n", err)
-		return
-	}
-
-	// Create webhook payload
-	payload := WebhookPayload{
-		EventType: eventType,
-		Timestamp: time.Now().Unix(),
-		IssueID:   issue.ID.String(),
-		Title:     issue.Title,
-		Status:    string(issue.Status),
-		Priority:  string(issue.Priority),
-		Severity:  string(issue.Severity),
-		URL:       fmt.Sprintf("%s/issues/%s", s.config.BaseURL, issue.ID.String()),
-	}
-
-	// Send to each webhook
-	for _, webhook := range webhooks {
-		go func(wh models.Webhook) {
-			payloadBytes, _ := json.Marshal(payload)
-
-			// Create signature if secret is provided
-			var signature string
-			if wh.Secret != "" {
-				h := hmac.New(sha256.New, []byte(wh.Secret))
-				h.Write(payloadBytes)
-				signature = hex.EncodeToString(h.Sum(nil))
-			}
-
-			// Create HTTP request
-			req, _ := http.NewRequest("POST", wh.URL, strings.NewReader(string(payloadBytes)))
-			req.Header.Set("Content-Type", "application/json")
-			req.Header.Set("User-Agent", "Buganizer-Webhook")
-			if signature != "" {
-				req.Header.Set("X-Buganizer-Signature", signature)
-			}
-
-			// Send request
-			client := &http.Client{Timeout: 10 * time.Second}
-			resp, err := client.Do(req)
-
-			// Update last called time and status
-			now := time.Now()
-			success := err == nil && resp != nil && resp.StatusCode >= 200 && resp.StatusCode < 300
-			s.webhookRepo.UpdateStatus(context.Background(), wh.ID, &now, success)
-
-			if resp != nil {
-				defer resp.Body.Close()
-			}
-		}(webhook)
+	delivery := s.sendWebhook(ctx, wh, payload.EventType, issueID, body, nil)
+	if !delivery.Success {
+		return errors.New(delivery.Error)
 	}
+	return nil
 }
 
 // SlackClient is a simple client for sending messages to Slack
@@ -350,18 +433,6 @@ type SlackMessage struct {
 	Blocks  []SlackBlock `json:"blocks,omitempty"`
 }
 
-// WebhookPayload represents the payload sent to webhooks
-type WebhookPayload struct {
-	EventType string `json:"event_type"`
-	Timestamp int64  `json:"timestamp"`
-	IssueID   string `json:"issue_id"`
-	Title     string `json:"title"`
-	Status    string `json:"status"`
-	Priority  string `json:"priority"`
-	Severity  string `json:"severity"`
-	URL       string `json:"url"`
-}
-
 // SendMessage sends a message to a Slack channel
 func (c *SlackClient) SendMessage(channel, text string, blocks []SlackBlock) error {
 	message := SlackMessage{
@@ -425,6 +496,10 @@ func getEmojiForNotificationType(notificationType pb.NotificationRequest_Notific
 		return "⚠️"
 	case pb.NotificationRequest_SLA_BREACHED:
 		return "🚨"
+	case pb.NotificationRequest_SLA_DEADLINE_CHANGED:
+		return "⏰"
+	case pb.NotificationRequest_ISSUE_ESCALATED:
+		return "📈"
 	default:
 		return "📣"
 	}
@@ -445,6 +520,10 @@ func getActionText(notificationType pb.NotificationRequest_NotificationType) str
 		return "SLA At Risk"
 	case pb.NotificationRequest_SLA_BREACHED:
 		return "SLA Breached"
+	case pb.NotificationRequest_SLA_DEADLINE_CHANGED:
+		return "SLA Deadline Moved Earlier"
+	case pb.NotificationRequest_ISSUE_ESCALATED:
+		return "Issue Escalated"
 	default:
 		return "Issue Update"
 	}
@@ -0,0 +1,143 @@
+// services/notification/service_test.go
+package notification
+
+import (
+	"context"
+	"database/sql"
+	"slices"
+	"testing"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/matthewmc1/buganizer/config"
+	"github.com/matthewmc1/buganizer/models"
+	pb "github.com/matthewmc1/buganizer/proto"
+	"github.com/matthewmc1/buganizer/repositories"
+)
+
+// memoryIssueRepo is an IssueRepository holding a fixed set of issues
+type memoryIssueRepo struct {
+	repositories.IssueRepository
+	issues []*models.Issue
+}
+
+func (r *memoryIssueRepo) GetByID(ctx context.Context, id uuid.UUID) (*models.Issue, error) {
+	for _, issue := range r.issues {
+		if issue.ID == id {
+			return issue, nil
+		}
+	}
+	return nil, sql.ErrNoRows
+}
+
+// orgComponentRepo is a ComponentRepository of components in organizations
+type orgComponentRepo struct {
+	repositories.ComponentRepository
+	organizations map[uuid.UUID]uuid.UUID
+}
+
+func (r *orgComponentRepo) GetByID(ctx context.Context, id uuid.UUID) (*models.Component, error) {
+	if _, ok := r.organizations[id]; !ok {
+		return nil, sql.ErrNoRows
+	}
+	return &models.Component{ID: id, Name: "Editor"}, nil
+}
+
+func (r *orgComponentRepo) GetOrganizationID(ctx context.Context, componentID uuid.UUID) (uuid.UUID, error) {
+	organizationID, ok := r.organizations[componentID]
+	if !ok {
+		return uuid.Nil, sql.ErrNoRows
+	}
+	return organizationID, nil
+}
+
+// memoryWebhookRepo is a WebhookRepository holding a fixed set of webhooks,
+// with the organizations of their creators
+type memoryWebhookRepo struct {
+	repositories.WebhookRepository
+	webhooks      []models.Webhook
+	organizations map[uuid.UUID]uuid.UUID
+}
+
+func (r *memoryWebhookRepo) ListByEventType(ctx context.Context, organizationID uuid.UUID, eventType string) ([]models.Webhook, error) {
+	var webhooks []models.Webhook
+	for _, webhook := range r.webhooks {
+		if r.organizations[webhook.CreatorID] == organizationID && slices.Contains(webhook.EventTypes, eventType) {
+			webhooks = append(webhooks, webhook)
+		}
+	}
+	return webhooks, nil
+}
+
+func (r *memoryWebhookRepo) GetByID(ctx context.Context, id uuid.UUID) (*models.Webhook, error) {
+	for i := range r.webhooks {
+		if r.webhooks[i].ID == id {
+			return &r.webhooks[i], nil
+		}
+	}
+	return nil, sql.ErrNoRows
+}
+
+func TestPageWebhookOfOtherOrganization(t *testing.T) {
+	ours, theirs := uuid.New(), uuid.New()
+	users := &orgUserRepo{organizations: make(map[uuid.UUID]uuid.UUID)}
+	outsider := users.add("lee@othercorp.com", theirs)
+
+	component := uuid.New()
+	issue := &models.Issue{ID: uuid.New(), ComponentID: component, Title: "Crash on save"}
+	webhook := models.Webhook{ID: uuid.New(), URL: "https://hooks.othercorp.com/page", CreatorID: outsider.ID}
+
+	s := &Service{
+		userRepo:      users,
+		issueRepo:     &memoryIssueRepo{issues: []*models.Issue{issue}},
+		componentRepo: &orgComponentRepo{organizations: map[uuid.UUID]uuid.UUID{component: ours}},
+		webhookRepo:   &memoryWebhookRepo{webhooks: []models.Webhook{webhook}},
+	}
+
+	err := s.PageWebhook(context.Background(), webhook.ID, &pb.NotificationRequest{
+		IssueId: issue.ID.String(),
+		Type:    pb.NotificationRequest_ISSUE_ESCALATED,
+	})
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("PageWebhook error = %v, want NotFound", err)
+	}
+}
+
+func TestWebhookMessagesStayInIssuesOrganization(t *testing.T) {
+	ours, theirs := uuid.New(), uuid.New()
+	users := &orgUserRepo{organizations: make(map[uuid.UUID]uuid.UUID)}
+	member := users.add("alex@acme.com", ours)
+	outsider := users.add("lee@othercorp.com", theirs)
+
+	component := uuid.New()
+	issue := &models.Issue{ID: uuid.New(), ComponentID: component, ReporterID: member.ID, Title: "Crash on save"}
+
+	eventTypes := []string{pb.NotificationRequest_COMMENT_ADDED.String()}
+	ownWebhook := models.Webhook{ID: uuid.New(), CreatorID: member.ID, EventTypes: eventTypes}
+	foreignWebhook := models.Webhook{ID: uuid.New(), CreatorID: outsider.ID, EventTypes: eventTypes}
+
+	s := &Service{
+		userRepo:      users,
+		componentRepo: &orgComponentRepo{organizations: map[uuid.UUID]uuid.UUID{component: ours}},
+		webhookRepo: &memoryWebhookRepo{
+			webhooks:      []models.Webhook{ownWebhook, foreignWebhook},
+			organizations: users.organizations,
+		},
+		config: &config.Config{},
+	}
+
+	messages, err := s.webhookMessages(context.Background(), &pb.NotificationRequest{
+		IssueId:        issue.ID.String(),
+		Type:           pb.NotificationRequest_COMMENT_ADDED,
+		CommentContent: "The stack trace points at the autosave timer",
+	}, issue)
+	if err != nil {
+		t.Fatalf("webhookMessages: %v", err)
+	}
+
+	if len(messages) != 1 || *messages[0].WebhookID != ownWebhook.ID {
+		t.Fatalf("got %d messages, want one for the webhook of the issue's organization", len(messages))
+	}
+}
@@ -0,0 +1,175 @@
+// services/notification/targets.go
+package notification
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/matthewmc1/buganizer/config"
+)
+
+// blockedNets are ranges webhooks may not call besides the private, loopback,
+// link-local, multicast and unspecified addresses net.IP recognizes
+var blockedNets = mustParseCIDRs(
+	"0.0.0.0/8",     // "This" network
+	"100.64.0.0/10", // Carrier-grade NAT
+	"192.0.0.0/24",  // IETF protocol assignments
+	"198.18.0.0/15", // Benchmarking
+	"240.0.0.0/4",   // Reserved
+	"64:ff9b::/96",  // NAT64, which can reach private IPv4 addresses
+)
+
+// targetGuard keeps webhooks from reaching internal services: targets that
+// resolve to private, loopback or link-local addresses are rejected unless
+// they are allowlisted. Targets are checked when a webhook is saved and
+// again on every connection, so DNS changes cannot get around the check.
+type targetGuard struct {
+	allowedHosts map[string]bool
+	allowedNets  []*net.IPNet
+	resolver     *net.Resolver
+}
+
+// newTargetGuard creates a guard allowing the configured targets
+func newTargetGuard(cfg config.WebhookConfig) *targetGuard {
+	g := &targetGuard{
+		allowedHosts: make(map[string]bool),
+		resolver:     net.DefaultResolver,
+	}
+
+	for _, target := range cfg.AllowedTargets {
+		if _, ipNet, err := net.ParseCIDR(target); err == nil {
+			g.allowedNets = append(g.allowedNets, ipNet)
+			continue
+		}
+		if ip := net.ParseIP(target); ip != nil {
+			bits := 8 * len(ip.To16())
+			if ip.To4() != nil {
+				ip, bits = ip.To4(), 32
+			}
+			g.allowedNets = append(g.allowedNets, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+			continue
+		}
+		g.allowedHosts[strings.ToLower(target)] = true
+	}
+
+	return g
+}
+
+// checkURL checks that a webhook URL is an absolute HTTP(S) URL whose host
+// resolves to addresses webhooks may call
+func (g *targetGuard) checkURL(ctx context.Context, rawURL string) error {
+	u, err := url.Parse(rawURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("invalid webhook URL %q, expected an http or https URL", rawURL)
+	}
+
+	host := u.Hostname()
+	if g.allowedHosts[strings.ToLower(host)] {
+		return nil
+	}
+
+	addrs, err := g.resolver.LookupIPAddr(ctx, host)
+	if err != nil {
+		return fmt.Errorf("failed to resolve webhook host %q: %v", host, err)
+	}
+	for _, addr := range addrs {
+		if err := g.checkIP(addr.IP); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkIP checks that webhooks may call an address
+func (g *targetGuard) checkIP(ip net.IP) error {
+	for _, ipNet := range g.allowedNets {
+		if ipNet.Contains(ip) {
+			return nil
+		}
+	}
+
+	if ip.IsPrivate() || ip.IsLoopback() || ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() ||
+		ip.IsInterfaceLocalMulticast() || ip.IsMulticast() || ip.IsUnspecified() {
+		return fmt.Errorf("webhook target %s is a private, loopback or link-local address", ip)
+	}
+	for _, ipNet := range blockedNets {
+		if ipNet.Contains(ip) {
+			return fmt.Errorf("webhook target %s is in the reserved range %s", ip, ipNet)
+		}
+	}
+	return nil
+}
+
+// client creates an HTTP client for calling webhooks that checks the address
+// of every connection it makes, including those of redirects. It never uses
+// a proxy, which would hide the address actually called.
+func (g *targetGuard) client(timeout time.Duration) *http.Client {
+	dialer := &net.Dialer{Timeout: timeout}
+
+	transport := &http.Transport{
+		Proxy: nil,
+		DialContext: func(ctx context.Context, network, address string) (net.Conn, error) {
+			host, port, err := net.SplitHostPort(address)
+			if err != nil {
+				return nil, err
+			}
+
+			// Allowlisted hosts are dialed as they are
+			if g.allowedHosts[strings.ToLower(host)] {
+				return dialer.DialContext(ctx, network, address)
+			}
+
+			// Otherwise resolve the host here and dial an address that
+			// passed the check, so it cannot change in between
+			addrs, err := g.resolver.LookupIPAddr(ctx, host)
+			if err != nil {
+				return nil, err
+			}
+
+			var lastErr error
+			for _, addr := range addrs {
+				if err := g.checkIP(addr.IP); err != nil {
+					lastErr = err
+					continue
+				}
+
+				conn, err := dialer.DialContext(ctx, network, net.JoinHostPort(addr.IP.String(), port))
+				if err == nil {
+					return conn, nil
+				}
+				lastErr = err
+			}
+			if lastErr == nil {
+				lastErr = fmt.Errorf("no addresses found for %s", host)
+			}
+			return nil, lastErr
+		},
+		TLSHandshakeTimeout:   timeout,
+		ResponseHeaderTimeout: timeout,
+		MaxIdleConnsPerHost:   2,
+		IdleConnTimeout:       90 * time.Second,
+	}
+
+	return &http.Client{
+		Timeout:   timeout,
+		Transport: transport,
+	}
+}
+
+// mustParseCIDRs parses CIDR ranges known to be valid
+func mustParseCIDRs(cidrs ...string) []*net.IPNet {
+	nets := make([]*net.IPNet, 0, len(cidrs))
+	for _, cidr := range cidrs {
+		_, ipNet, err := net.ParseCIDR(cidr)
+		if err != nil {
+			panic(err)
+		}
+		nets = append(nets, ipNet)
+	}
+	return nets
+}
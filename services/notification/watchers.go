@@ -0,0 +1,111 @@
+// services/notification/watchers.go
+package notification
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/matthewmc1/buganizer/models"
+	pb "github.com/matthewmc1/buganizer/proto"
+)
+
+// errSlackUserNotFound is returned when no Slack user has an email address
+var errSlackUserNotFound = errors.New("no Slack user with this email address")
+
+// watcherIDs lists the users watching an issue
+func (s *Service) watcherIDs(ctx context.Context, issue *models.Issue) ([]uuid.UUID, error) {
+	watchers, err := s.watcherRepo.ListByIssue(ctx, issue.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get watchers: %v", err)
+	}
+
+	userIDs := make([]uuid.UUID, len(watchers))
+	for i, watcher := range watchers {
+		userIDs[i] = watcher.UserID
+	}
+	return userIDs, nil
+}
+
+// getPreferences returns a user's notification preferences, falling back to
+// the defaults (Slack and email on, all events as they happen) when none have
+// been saved
+func (s *Service) getPreferences(ctx context.Context, userID uuid.UUID) (*models.NotificationPreference, error) {
+	pref, err := s.preferenceRepo.GetByUserID(ctx, userID)
+	if err == sql.ErrNoRows {
+		return &models.NotificationPreference{
+			UserID:             userID,
+			EmailNotifications: true,
+			SlackNotifications: true,
+			TimeZone:           "UTC",
+			DailyDigestTime:    models.DefaultDailyDigestTime,
+		}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return pref, nil
+}
+
+// isSubscribed checks whether the preferences include a notification type.
+// Preferences without any subscribed events receive everything.
+func isSubscribed(pref *models.NotificationPreference, notificationType pb.NotificationRequest_NotificationType) bool {
+	if len(pref.SubscribedEvents) == 0 {
+		return true
+	}
+	for _, event := range pref.SubscribedEvents {
+		if event == notificationType.String() {
+			return true
+		}
+	}
+	return false
+}
+
+// LookupUserByEmail finds the Slack user ID for an email address
+func (c *SlackClient) LookupUserByEmail(email string) (string, error) {
+	req, err := http.NewRequest("GET", "https://slack.com/api/users.lookupByEmail?email="+url.QueryEscape(email), nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to create request: %v", err)
+	}
+
+	req.Header.Set("Authorization", "Bearer "+c.APIToken)
+
+	client := &http.Client{Timeout: 10 * time.Second}
+	resp, err := client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to send request: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("slack API returned non-OK status: %d", resp.StatusCode)
+	}
+
+	var slackResponse struct {
+		OK    bool   `json:"ok"`
+		Error string `json:"error,omitempty"`
+		User  struct {
+			ID string `json:"id"`
+		} `json:"user"`
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(&slackResponse); err != nil {
+		return "", fmt.Errorf("failed to decode response: %v", err)
+	}
+
+	if slackResponse.Error == "users_not_found" {
+		return "", errSlackUserNotFound
+	}
+	if !slackResponse.OK {
+		return "", fmt.Errorf("slack API error: %s", slackResponse.Error)
+	}
+
+	return slackResponse.User.ID, nil
+}
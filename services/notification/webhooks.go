@@ -0,0 +1,224 @@
+// services/notification/webhooks.go
+package notification
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/matthewmc1/buganizer/models"
+	pb "github.com/matthewmc1/buganizer/proto"
+)
+
+// pingEventType is the event type of test deliveries
+const pingEventType = "PING"
+
+// ListWebhooks lists the caller's webhooks, or every webhook of their
+// organization for admins
+func (s *Service) ListWebhooks(ctx context.Context, req *pb.ListWebhooksRequest) (*pb.ListWebhooksResponse, error) {
+	// Get user ID from context
+	userID, ok := ctx.Value("user_id").(string)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not authenticated")
+	}
+	uid := uuid.MustParse(userID)
+
+	isAdmin, err := s.userRepo.IsAdmin(ctx, uid)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to check permissions: %v", err)
+	}
+
+	organizationID, err := s.userRepo.GetOrganizationID(ctx, uid)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get organization: %v", err)
+	}
+
+	creatorID := &uid
+	if isAdmin {
+		creatorID = nil
+	}
+
+	webhooks, err := s.webhookRepo.List(ctx, organizationID, creatorID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list webhooks: %v", err)
+	}
+
+	response := &pb.ListWebhooksResponse{
+		Webhooks: make([]*pb.Webhook, 0, len(webhooks)),
+	}
+	for i := range webhooks {
+		response.Webhooks = append(response.Webhooks, webhookToProto(&webhooks[i]))
+	}
+
+	return response, nil
+}
+
+// GetWebhook gets a webhook. Admins and the webhook's creator can get it.
+func (s *Service) GetWebhook(ctx context.Context, req *pb.GetWebhookRequest) (*pb.Webhook, error) {
+	webhook, err := s.getOwnWebhook(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return webhookToProto(webhook), nil
+}
+
+// UpdateWebhook updates a webhook's URL, description, events or payload
+// format. Admins and the webhook's creator can update it.
+func (s *Service) UpdateWebhook(ctx context.Context, req *pb.UpdateWebhookRequest) (*pb.Webhook, error) {
+	webhook, err := s.getOwnWebhook(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Update fields
+	if req.Url != nil {
+		if err := s.validateWebhookURL(ctx, *req.Url); err != nil {
+			return nil, err
+		}
+		webhook.URL = *req.Url
+	}
+	if req.Description != nil {
+		webhook.Description = *req.Description
+	}
+	if len(req.EventTypes) > 0 {
+		if err := validateEventTypes(req.EventTypes); err != nil {
+			return nil, err
+		}
+		webhook.EventTypes = req.EventTypes
+	}
+	if req.PayloadFormat != nil {
+		webhook.PayloadFormat = payloadFormatFromProto(*req.PayloadFormat)
+	}
+
+	if err := s.webhookRepo.Update(ctx, webhook); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update webhook: %v", err)
+	}
+
+	return webhookToProto(webhook), nil
+}
+
+// DeleteWebhook deletes a webhook with its delivery log and queued
+// deliveries. Admins and the webhook's creator can delete it.
+func (s *Service) DeleteWebhook(ctx context.Context, req *pb.DeleteWebhookRequest) (*emptypb.Empty, error) {
+	webhook, err := s.getOwnWebhook(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.webhookRepo.Delete(ctx, webhook.ID); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete webhook: %v", err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// TestWebhook sends a synthetic ping to a webhook and returns the attempt,
+// with the webhook's response. Admins and the webhook's creator can test it.
+func (s *Service) TestWebhook(ctx context.Context, req *pb.TestWebhookRequest) (*pb.WebhookDelivery, error) {
+	webhook, err := s.getOwnWebhook(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := s.encodeWebhookPayload(*webhook, WebhookPayload{
+		SchemaVersion: webhookSchemaVersion,
+		EventType:     pingEventType,
+		Timestamp:     time.Now().Unix(),
+		Message:       "Test delivery from Buganizer",
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create ping: %v", err)
+	}
+
+	delivery := s.sendWebhook(ctx, *webhook, pingEventType, nil, body, nil)
+
+	return deliveryToProto(delivery), nil
+}
+
+// RotateWebhookSecret replaces a webhook's signing secret and returns the new
+// one, which is not shown again. Admins and the webhook's creator can rotate
+// it.
+func (s *Service) RotateWebhookSecret(ctx context.Context, req *pb.RotateWebhookSecretRequest) (*pb.RotateWebhookSecretResponse, error) {
+	webhook, err := s.getOwnWebhook(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	secret := req.Secret
+	if secret == "" {
+		if secret, err = generateSecret(); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to generate secret: %v", err)
+		}
+	}
+	webhook.Secret = secret
+
+	if err := s.webhookRepo.Update(ctx, webhook); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to rotate webhook secret: %v", err)
+	}
+
+	return &pb.RotateWebhookSecretResponse{
+		Secret: secret,
+	}, nil
+}
+
+// validateWebhookURL checks that a webhook URL is an absolute HTTP(S) URL
+// whose host webhooks may call
+func (s *Service) validateWebhookURL(ctx context.Context, rawURL string) error {
+	if err := s.targets.checkURL(ctx, rawURL); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	return nil
+}
+
+// validateEventTypes checks that event types name notification types
+func validateEventTypes(eventTypes []string) error {
+	for _, eventType := range eventTypes {
+		if _, ok := pb.NotificationRequest_NotificationType_value[eventType]; !ok {
+			return status.Errorf(codes.InvalidArgument, "unknown event type %q", eventType)
+		}
+	}
+	return nil
+}
+
+// generateSecret creates a random signing secret
+func generateSecret() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return "whsec_" + hex.EncodeToString(b), nil
+}
+
+// webhookToProto converts a model.Webhook to a protobuf Webhook, leaving out
+// its secret
+func webhookToProto(webhook *models.Webhook) *pb.Webhook {
+	pbWebhook := &pb.Webhook{
+		Id:          webhook.ID.String(),
+		Url:         webhook.URL,
+		Description: webhook.Description,
+		CreatorId:   webhook.CreatorID.String(),
+		EventTypes:  webhook.EventTypes,
+		CreatedAt:   timestamppb.New(webhook.CreatedAt),
+		LastSuccess: webhook.LastSuccess,
+		HasSecret:   webhook.Secret != "",
+	}
+
+	if format, ok := pb.Webhook_PayloadFormat_value[string(webhook.PayloadFormat)]; ok {
+		pbWebhook.PayloadFormat = pb.Webhook_PayloadFormat(format)
+	}
+
+	// Handle optional fields
+	if webhook.LastCalledAt != nil {
+		pbWebhook.LastCalledAt = timestamppb.New(*webhook.LastCalledAt)
+	}
+
+	return pbWebhook
+}
@@ -0,0 +1,52 @@
+// services/notification/webhooks_test.go
+package notification
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/matthewmc1/buganizer/models"
+	pb "github.com/matthewmc1/buganizer/proto"
+)
+
+func TestWebhookAccessStaysInOrganization(t *testing.T) {
+	ours, theirs := uuid.New(), uuid.New()
+	users := &orgUserRepo{organizations: make(map[uuid.UUID]uuid.UUID), admins: make(map[uuid.UUID]bool)}
+	creator := users.add("alex@acme.com", ours)
+	admin := users.add("sam@acme.com", ours)
+	colleague := users.add("kim@acme.com", ours)
+	outsideAdmin := users.add("lee@othercorp.com", theirs)
+	users.admins[admin.ID] = true
+	users.admins[outsideAdmin.ID] = true
+
+	webhook := models.Webhook{ID: uuid.New(), URL: "https://hooks.acme.com/bugs", CreatorID: creator.ID}
+	s := &Service{
+		userRepo:    users,
+		webhookRepo: &memoryWebhookRepo{webhooks: []models.Webhook{webhook}},
+	}
+
+	tests := []struct {
+		name   string
+		caller uuid.UUID
+		code   codes.Code
+	}{
+		{"creator", creator.ID, codes.OK},
+		{"admin of the organization", admin.ID, codes.OK},
+		{"member of the organization", colleague.ID, codes.PermissionDenied},
+		{"admin of another organization", outsideAdmin.ID, codes.NotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.WithValue(context.Background(), "user_id", tt.caller.String())
+			_, err := s.GetWebhook(ctx, &pb.GetWebhookRequest{Id: webhook.ID.String()})
+			if status.Code(err) != tt.code {
+				t.Errorf("GetWebhook error = %v, want %s", err, tt.code)
+			}
+		})
+	}
+}
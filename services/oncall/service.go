@@ -0,0 +1,742 @@
+// services/oncall/service.go
+package oncall
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/matthewmc1/buganizer/models"
+	pb "github.com/matthewmc1/buganizer/proto"
+	"github.com/matthewmc1/buganizer/repositories"
+)
+
+const (
+	// defaultShiftRange is how far ahead ListShifts looks by default
+	defaultShiftRange = 28 * 24 * time.Hour
+
+	// maxShiftRange limits how far ListShifts looks
+	maxShiftRange = 366 * 24 * time.Hour
+)
+
+// Service implements the OnCallService gRPC interface
+type Service struct {
+	pb.UnimplementedOnCallServiceServer
+	onCallRepo repositories.OnCallRepository
+	teamRepo   repositories.TeamRepository
+	userRepo   repositories.UserRepository
+}
+
+// NewService creates a new on-call service
+func NewService(
+	onCallRepo repositories.OnCallRepository,
+	teamRepo repositories.TeamRepository,
+	userRepo repositories.UserRepository,
+) *Service {
+	return &Service{
+		onCallRepo: onCallRepo,
+		teamRepo:   teamRepo,
+		userRepo:   userRepo,
+	}
+}
+
+// CreateRotation creates the on-call rotation of a team. Admins and the team
+// lead can create it.
+func (s *Service) CreateRotation(ctx context.Context, req *pb.CreateRotationRequest) (*pb.Rotation, error) {
+	if req.Rotation == nil {
+		return nil, status.Error(codes.InvalidArgument, "rotation is required")
+	}
+
+	// Get user ID from context
+	userID, ok := ctx.Value("user_id").(string)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not authenticated")
+	}
+	uid := uuid.MustParse(userID)
+
+	teamID, err := uuid.Parse(req.Rotation.TeamId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid team ID format")
+	}
+
+	if err := s.checkTeamLead(ctx, uid, teamID); err != nil {
+		return nil, err
+	}
+
+	// A team has one rotation
+	if _, err := s.onCallRepo.GetRotationByTeam(ctx, teamID); err == nil {
+		return nil, status.Error(codes.AlreadyExists, "team already has a rotation")
+	} else if err != sql.ErrNoRows {
+		return nil, status.Errorf(codes.Internal, "failed to get rotation: %v", err)
+	}
+
+	organizationID, err := s.userRepo.GetOrganizationID(ctx, uid)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get organization: %v", err)
+	}
+
+	now := time.Now()
+	rotation := &models.OnCallRotation{
+		ID:             uuid.New(),
+		OrganizationID: organizationID,
+		TeamID:         teamID,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+		CreatedByID:    &uid,
+	}
+
+	if err := applyRotation(rotation, req.Rotation, now); err != nil {
+		return nil, err
+	}
+	if err := s.checkMembers(ctx, rotation); err != nil {
+		return nil, err
+	}
+
+	if err := s.onCallRepo.CreateRotation(ctx, rotation); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create rotation: %v", err)
+	}
+
+	return rotationToProto(rotation), nil
+}
+
+// GetRotation gets a rotation of the caller's organization
+func (s *Service) GetRotation(ctx context.Context, req *pb.GetRotationRequest) (*pb.Rotation, error) {
+	rotation, _, err := s.getRotation(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return rotationToProto(rotation), nil
+}
+
+// ListRotations lists the rotations of the caller's organization
+func (s *Service) ListRotations(ctx context.Context, req *pb.ListRotationsRequest) (*pb.ListRotationsResponse, error) {
+	rotations, _, err := s.listRotations(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &pb.ListRotationsResponse{
+		Rotations: make([]*pb.Rotation, 0, len(rotations)),
+	}
+	for _, rotation := range rotations {
+		response.Rotations = append(response.Rotations, rotationToProto(rotation))
+	}
+
+	return response, nil
+}
+
+// UpdateRotation replaces the settings and members of a rotation. Admins and
+// the team lead can update it.
+func (s *Service) UpdateRotation(ctx context.Context, req *pb.UpdateRotationRequest) (*pb.Rotation, error) {
+	if req.Rotation == nil {
+		return nil, status.Error(codes.InvalidArgument, "rotation is required")
+	}
+
+	rotation, uid, err := s.getRotation(ctx, req.Rotation.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.checkTeamLead(ctx, uid, rotation.TeamID); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	if err := applyRotation(rotation, req.Rotation, now); err != nil {
+		return nil, err
+	}
+	if err := s.checkMembers(ctx, rotation); err != nil {
+		return nil, err
+	}
+	rotation.UpdatedAt = now
+
+	if err := s.onCallRepo.UpdateRotation(ctx, rotation); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update rotation: %v", err)
+	}
+
+	return rotationToProto(rotation), nil
+}
+
+// DeleteRotation deletes a rotation and its overrides. Admins and the team
+// lead can delete it.
+func (s *Service) DeleteRotation(ctx context.Context, req *pb.DeleteRotationRequest) (*emptypb.Empty, error) {
+	rotation, uid, err := s.getRotation(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.checkTeamLead(ctx, uid, rotation.TeamID); err != nil {
+		return nil, err
+	}
+
+	if err := s.onCallRepo.DeleteRotation(ctx, rotation.ID); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete rotation: %v", err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// CreateOverride puts a user on call instead of the rotation for a while.
+// Admins and the team lead can create any override; team members can take
+// over shifts themselves.
+func (s *Service) CreateOverride(ctx context.Context, req *pb.CreateOverrideRequest) (*pb.OnCallOverride, error) {
+	if req.Override == nil {
+		return nil, status.Error(codes.InvalidArgument, "override is required")
+	}
+
+	rotation, uid, err := s.getRotation(ctx, req.Override.RotationId)
+	if err != nil {
+		return nil, err
+	}
+
+	overrideUserID := uid
+	if req.Override.UserId != "" {
+		overrideUserID, err = uuid.Parse(req.Override.UserId)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid user ID format")
+		}
+	}
+
+	if req.Override.StartAt == nil || req.Override.EndAt == nil {
+		return nil, status.Error(codes.InvalidArgument, "start_at and end_at are required")
+	}
+	startAt := req.Override.StartAt.AsTime()
+	endAt := req.Override.EndAt.AsTime()
+	if !startAt.Before(endAt) {
+		return nil, status.Error(codes.InvalidArgument, "start_at must be before end_at")
+	}
+
+	isMember, err := s.userRepo.IsUserInTeam(ctx, overrideUserID, rotation.TeamID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to check team membership: %v", err)
+	}
+	if !isMember {
+		return nil, status.Error(codes.FailedPrecondition, "user is not a member of the team")
+	}
+
+	if overrideUserID != uid {
+		if err := s.checkTeamLead(ctx, uid, rotation.TeamID); err != nil {
+			return nil, status.Error(codes.PermissionDenied, "only an admin or the team lead can put someone else on call")
+		}
+	}
+
+	override := &models.OnCallOverride{
+		ID:          uuid.New(),
+		RotationID:  rotation.ID,
+		UserID:      overrideUserID,
+		StartAt:     startAt,
+		EndAt:       endAt,
+		Reason:      strings.TrimSpace(req.Override.Reason),
+		CreatedAt:   time.Now(),
+		CreatedByID: &uid,
+	}
+
+	if err := s.onCallRepo.CreateOverride(ctx, override); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create override: %v", err)
+	}
+
+	return overrideToProto(override), nil
+}
+
+// DeleteOverride deletes an override. Admins, the team lead and whoever
+// created it can delete it.
+func (s *Service) DeleteOverride(ctx context.Context, req *pb.DeleteOverrideRequest) (*emptypb.Empty, error) {
+	overrideID, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid override ID format")
+	}
+
+	override, err := s.onCallRepo.GetOverride(ctx, overrideID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Error(codes.NotFound, "override not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get override: %v", err)
+	}
+
+	rotation, uid, err := s.getRotation(ctx, override.RotationID.String())
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, status.Error(codes.NotFound, "override not found")
+		}
+		return nil, err
+	}
+
+	if override.CreatedByID == nil || *override.CreatedByID != uid {
+		if err := s.checkTeamLead(ctx, uid, rotation.TeamID); err != nil {
+			return nil, status.Error(codes.PermissionDenied, "only an admin, the team lead or its creator can delete an override")
+		}
+	}
+
+	if err := s.onCallRepo.DeleteOverride(ctx, override.ID); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete override: %v", err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// GetCurrentOnCall gets who is on call for a team of the caller's
+// organization now: the rotation's shift, or else the user set by hand with
+// SetTeamOnCall
+func (s *Service) GetCurrentOnCall(ctx context.Context, req *pb.GetCurrentOnCallRequest) (*pb.OnCallShift, error) {
+	teamID, err := uuid.Parse(req.TeamId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid team ID format")
+	}
+
+	if err := s.checkTeamOrganization(ctx, teamID); err != nil {
+		return nil, err
+	}
+
+	shift, err := s.CurrentOnCall(ctx, teamID, time.Now())
+	if err == sql.ErrNoRows {
+		return nil, status.Error(codes.NotFound, "nobody is on call for the team")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get on-call: %v", err)
+	}
+
+	return shiftToProto(shift), nil
+}
+
+// ListCurrentOnCall lists who is on call now for each team of the caller's
+// organization
+func (s *Service) ListCurrentOnCall(ctx context.Context, req *pb.ListCurrentOnCallRequest) (*pb.ListShiftsResponse, error) {
+	rotations, organizationID, err := s.listRotations(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	manual, err := s.onCallRepo.ListTeamOnCall(ctx, organizationID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list team on-call: %v", err)
+	}
+
+	now := time.Now()
+	response := &pb.ListShiftsResponse{}
+	for _, rotation := range rotations {
+		shift, ok, err := s.rotationOnCall(ctx, rotation, now)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get on-call: %v", err)
+		}
+		if ok {
+			response.Shifts = append(response.Shifts, shiftToProto(&shift))
+			delete(manual, rotation.TeamID)
+		}
+	}
+
+	// Teams nobody in a rotation covers fall back to the user set by hand
+	for teamID, userID := range manual {
+		response.Shifts = append(response.Shifts, shiftToProto(&models.OnCallShift{
+			TeamID: teamID,
+			UserID: userID,
+		}))
+	}
+
+	return response, nil
+}
+
+// ListShifts lists the shifts of a team, or of a user across the teams of the
+// caller's organization
+func (s *Service) ListShifts(ctx context.Context, req *pb.ListShiftsRequest) (*pb.ListShiftsResponse, error) {
+	// Get user ID from context
+	userID, ok := ctx.Value("user_id").(string)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not authenticated")
+	}
+
+	from := time.Now()
+	if req.StartDate != nil {
+		from = req.StartDate.AsTime()
+	}
+	to := from.Add(defaultShiftRange)
+	if req.EndDate != nil {
+		to = req.EndDate.AsTime()
+	}
+	if !from.Before(to) {
+		return nil, status.Error(codes.InvalidArgument, "start_date must be before end_date")
+	}
+	if to.Sub(from) > maxShiftRange {
+		return nil, status.Error(codes.InvalidArgument, "range must not be longer than a year")
+	}
+
+	var rotations []*models.OnCallRotation
+	var filterUserID uuid.UUID
+	if req.TeamId != "" {
+		teamID, err := uuid.Parse(req.TeamId)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid team ID format")
+		}
+
+		if err := s.checkTeamOrganization(ctx, teamID); err != nil {
+			return nil, err
+		}
+
+		rotation, err := s.onCallRepo.GetRotationByTeam(ctx, teamID)
+		if err != nil && err != sql.ErrNoRows {
+			return nil, status.Errorf(codes.Internal, "failed to get rotation: %v", err)
+		}
+		if rotation != nil {
+			rotations = append(rotations, rotation)
+		}
+	} else {
+		filterUserID = uuid.MustParse(userID)
+		if req.UserId != "" && req.UserId != "me" {
+			id, err := uuid.Parse(req.UserId)
+			if err != nil {
+				return nil, status.Error(codes.InvalidArgument, "invalid user ID format")
+			}
+			filterUserID = id
+		}
+
+		var err error
+		if rotations, _, err = s.listRotations(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	var shifts []models.OnCallShift
+	for _, rotation := range rotations {
+		rotationShifts, err := s.shifts(ctx, rotation, from, to)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to list shifts: %v", err)
+		}
+		for _, shift := range rotationShifts {
+			if filterUserID == uuid.Nil || shift.UserID == filterUserID {
+				shifts = append(shifts, shift)
+			}
+		}
+	}
+
+	sort.SliceStable(shifts, func(i, j int) bool {
+		return shifts[i].StartAt.Before(shifts[j].StartAt)
+	})
+
+	response := &pb.ListShiftsResponse{
+		Shifts: make([]*pb.OnCallShift, 0, len(shifts)),
+	}
+	for i := range shifts {
+		response.Shifts = append(response.Shifts, shiftToProto(&shifts[i]))
+	}
+
+	return response, nil
+}
+
+// CurrentOnCall finds who is on call for a team at a time, falling back to
+// the user set by hand. It returns sql.ErrNoRows when nobody is. It does not
+// check the caller, so it is for in-process use such as the escalation
+// scheduler; RPCs go through GetCurrentOnCall.
+func (s *Service) CurrentOnCall(ctx context.Context, teamID uuid.UUID, at time.Time) (*models.OnCallShift, error) {
+	rotation, err := s.onCallRepo.GetRotationByTeam(ctx, teamID)
+	if err != nil && err != sql.ErrNoRows {
+		return nil, err
+	}
+
+	if rotation != nil {
+		shift, ok, err := s.rotationOnCall(ctx, rotation, at)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			return &shift, nil
+		}
+	}
+
+	userID, err := s.onCallRepo.GetTeamOnCall(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.OnCallShift{TeamID: teamID, UserID: userID}, nil
+}
+
+// rotationOnCall finds the shift of a rotation covering a time
+func (s *Service) rotationOnCall(ctx context.Context, rotation *models.OnCallRotation, at time.Time) (models.OnCallShift, bool, error) {
+	spanFrom, spanTo := rotation.Span(at, at.Add(time.Nanosecond))
+	overrides, err := s.onCallRepo.ListOverrides(ctx, rotation.ID, spanFrom, spanTo)
+	if err != nil {
+		return models.OnCallShift{}, false, fmt.Errorf("failed to list overrides: %v", err)
+	}
+
+	shift, ok := rotation.OnCallAt(at, overrides)
+	return shift, ok, nil
+}
+
+// shifts lists the shifts of a rotation overlapping [from, to) with its
+// overrides applied
+func (s *Service) shifts(ctx context.Context, rotation *models.OnCallRotation, from, to time.Time) ([]models.OnCallShift, error) {
+	spanFrom, spanTo := rotation.Span(from, to)
+	overrides, err := s.onCallRepo.ListOverrides(ctx, rotation.ID, spanFrom, spanTo)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list overrides: %v", err)
+	}
+
+	return rotation.Shifts(from, to, overrides), nil
+}
+
+// getRotation loads a rotation, hiding rotations of other organizations. It
+// also returns the caller's user ID.
+func (s *Service) getRotation(ctx context.Context, id string) (*models.OnCallRotation, uuid.UUID, error) {
+	if id == "" {
+		return nil, uuid.Nil, status.Error(codes.InvalidArgument, "rotation ID is required")
+	}
+
+	// Get user ID from context
+	userID, ok := ctx.Value("user_id").(string)
+	if !ok {
+		return nil, uuid.Nil, status.Error(codes.Unauthenticated, "user not authenticated")
+	}
+	uid := uuid.MustParse(userID)
+
+	rotationID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, uuid.Nil, status.Error(codes.InvalidArgument, "invalid rotation ID format")
+	}
+
+	rotation, err := s.onCallRepo.GetRotation(ctx, rotationID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, uuid.Nil, status.Error(codes.NotFound, "rotation not found")
+		}
+		return nil, uuid.Nil, status.Errorf(codes.Internal, "failed to get rotation: %v", err)
+	}
+
+	organizationID, err := s.userRepo.GetOrganizationID(ctx, uid)
+	if err != nil {
+		return nil, uuid.Nil, status.Errorf(codes.Internal, "failed to get organization: %v", err)
+	}
+	if rotation.OrganizationID != organizationID {
+		return nil, uuid.Nil, status.Error(codes.NotFound, "rotation not found")
+	}
+
+	return rotation, uid, nil
+}
+
+// listRotations lists the rotations of the caller's organization. It also
+// returns the organization's ID.
+func (s *Service) listRotations(ctx context.Context) ([]*models.OnCallRotation, uuid.UUID, error) {
+	// Get user ID from context
+	userID, ok := ctx.Value("user_id").(string)
+	if !ok {
+		return nil, uuid.Nil, status.Error(codes.Unauthenticated, "user not authenticated")
+	}
+
+	organizationID, err := s.userRepo.GetOrganizationID(ctx, uuid.MustParse(userID))
+	if err != nil {
+		return nil, uuid.Nil, status.Errorf(codes.Internal, "failed to get organization: %v", err)
+	}
+
+	rotations, err := s.onCallRepo.ListRotations(ctx, organizationID)
+	if err != nil {
+		return nil, uuid.Nil, status.Errorf(codes.Internal, "failed to list rotations: %v", err)
+	}
+
+	return rotations, organizationID, nil
+}
+
+// checkTeamOrganization checks that a team belongs to the caller's
+// organization, reporting teams of other organizations as missing
+func (s *Service) checkTeamOrganization(ctx context.Context, teamID uuid.UUID) error {
+	// Get user ID from context
+	userID, ok := ctx.Value("user_id").(string)
+	if !ok {
+		return status.Error(codes.Unauthenticated, "user not authenticated")
+	}
+
+	organizationID, err := s.userRepo.GetOrganizationID(ctx, uuid.MustParse(userID))
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get organization: %v", err)
+	}
+
+	teamOrganizationID, err := s.teamRepo.GetOrganizationID(ctx, teamID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return status.Error(codes.NotFound, "team not found")
+		}
+		return status.Errorf(codes.Internal, "failed to get team: %v", err)
+	}
+	if teamOrganizationID != organizationID {
+		return status.Error(codes.NotFound, "team not found")
+	}
+
+	return nil
+}
+
+// checkTeamLead checks that a user is an admin or the lead of a team
+func (s *Service) checkTeamLead(ctx context.Context, userID, teamID uuid.UUID) error {
+	team, err := s.teamRepo.GetByID(ctx, teamID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return status.Error(codes.NotFound, "team not found")
+		}
+		return status.Errorf(codes.Internal, "failed to get team: %v", err)
+	}
+	if team.LeadID == userID {
+		return nil
+	}
+
+	isAdmin, err := s.userRepo.IsAdmin(ctx, userID)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to check permissions: %v", err)
+	}
+	if !isAdmin {
+		return status.Error(codes.PermissionDenied, "only an admin or the team lead can manage the team's rotation")
+	}
+	return nil
+}
+
+// checkMembers checks that the members of a rotation are on its team
+func (s *Service) checkMembers(ctx context.Context, rotation *models.OnCallRotation) error {
+	for _, memberID := range rotation.MemberIDs {
+		isMember, err := s.userRepo.IsUserInTeam(ctx, memberID, rotation.TeamID)
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to check team membership: %v", err)
+		}
+		if !isMember {
+			return status.Errorf(codes.FailedPrecondition, "user %s is not a member of the team", memberID)
+		}
+	}
+	return nil
+}
+
+// applyRotation validates a protobuf rotation and copies its settings onto a
+// model, filling in the defaults for values left empty
+func applyRotation(rotation *models.OnCallRotation, pbRotation *pb.Rotation, now time.Time) error {
+	if strings.TrimSpace(pbRotation.Name) == "" {
+		return status.Error(codes.InvalidArgument, "rotation name is required")
+	}
+	rotation.Name = strings.TrimSpace(pbRotation.Name)
+
+	if len(pbRotation.MemberIds) == 0 {
+		return status.Error(codes.InvalidArgument, "at least one member is required")
+	}
+	rotation.MemberIDs = make([]uuid.UUID, 0, len(pbRotation.MemberIds))
+	for _, memberID := range pbRotation.MemberIds {
+		id, err := uuid.Parse(memberID)
+		if err != nil {
+			return status.Errorf(codes.InvalidArgument, "invalid member ID %q", memberID)
+		}
+		rotation.MemberIDs = append(rotation.MemberIDs, id)
+	}
+
+	rotation.ShiftLengthDays = 7
+	if pbRotation.ShiftLengthDays != 0 {
+		if pbRotation.ShiftLengthDays < 0 || pbRotation.ShiftLengthDays > 365 {
+			return status.Error(codes.InvalidArgument, "shift length must be between 1 and 365 days")
+		}
+		rotation.ShiftLengthDays = int(pbRotation.ShiftLengthDays)
+	}
+
+	rotation.HandoffTime = 9 * 60
+	if pbRotation.HandoffTime != "" {
+		handoff, err := time.Parse("15:04", pbRotation.HandoffTime)
+		if err != nil {
+			return status.Errorf(codes.InvalidArgument, "invalid handoff time %q, expected HH:MM", pbRotation.HandoffTime)
+		}
+		rotation.HandoffTime = handoff.Hour()*60 + handoff.Minute()
+	}
+
+	rotation.TimeZone = "UTC"
+	if pbRotation.TimeZone != "" {
+		if _, err := time.LoadLocation(pbRotation.TimeZone); err != nil {
+			return status.Errorf(codes.InvalidArgument, "unknown time zone %q", pbRotation.TimeZone)
+		}
+		rotation.TimeZone = pbRotation.TimeZone
+	}
+
+	if pbRotation.StartDate != "" {
+		date, err := time.Parse("2006-01-02", pbRotation.StartDate)
+		if err != nil {
+			return status.Errorf(codes.InvalidArgument, "invalid start date %q, expected YYYY-MM-DD", pbRotation.StartDate)
+		}
+		rotation.StartDate = date
+	} else if rotation.StartDate.IsZero() {
+		today := now.In(rotation.Location())
+		rotation.StartDate = time.Date(today.Year(), today.Month(), today.Day(), 0, 0, 0, 0, time.UTC)
+	}
+
+	return nil
+}
+
+// rotationToProto converts a model.OnCallRotation to a protobuf Rotation
+func rotationToProto(rotation *models.OnCallRotation) *pb.Rotation {
+	pbRotation := &pb.Rotation{
+		Id:              rotation.ID.String(),
+		OrganizationId:  rotation.OrganizationID.String(),
+		TeamId:          rotation.TeamID.String(),
+		Name:            rotation.Name,
+		MemberIds:       make([]string, 0, len(rotation.MemberIDs)),
+		ShiftLengthDays: int32(rotation.ShiftLengthDays),
+		HandoffTime:     fmt.Sprintf("%02d:%02d", rotation.HandoffTime/60, rotation.HandoffTime%60),
+		TimeZone:        rotation.TimeZone,
+		StartDate:       rotation.StartDate.Format("2006-01-02"),
+		CreatedAt:       timestamppb.New(rotation.CreatedAt),
+		UpdatedAt:       timestamppb.New(rotation.UpdatedAt),
+	}
+
+	for _, memberID := range rotation.MemberIDs {
+		pbRotation.MemberIds = append(pbRotation.MemberIds, memberID.String())
+	}
+
+	// Handle optional fields
+	if rotation.CreatedByID != nil {
+		pbRotation.CreatedById = rotation.CreatedByID.String()
+	}
+
+	return pbRotation
+}
+
+// overrideToProto converts a model.OnCallOverride to a protobuf OnCallOverride
+func overrideToProto(override *models.OnCallOverride) *pb.OnCallOverride {
+	pbOverride := &pb.OnCallOverride{
+		Id:         override.ID.String(),
+		RotationId: override.RotationID.String(),
+		UserId:     override.UserID.String(),
+		StartAt:    timestamppb.New(override.StartAt),
+		EndAt:      timestamppb.New(override.EndAt),
+		Reason:     override.Reason,
+		CreatedAt:  timestamppb.New(override.CreatedAt),
+	}
+
+	// Handle optional fields
+	if override.CreatedByID != nil {
+		pbOverride.CreatedById = override.CreatedByID.String()
+	}
+
+	return pbOverride
+}
+
+// shiftToProto converts a model.OnCallShift to a protobuf OnCallShift
+func shiftToProto(shift *models.OnCallShift) *pb.OnCallShift {
+	pbShift := &pb.OnCallShift{
+		TeamId:       shift.TeamID.String(),
+		UserId:       shift.UserID.String(),
+		RotationName: shift.RotationName,
+	}
+
+	// Handle optional fields
+	if shift.RotationID != nil {
+		pbShift.RotationId = shift.RotationID.String()
+	}
+	if !shift.StartAt.IsZero() {
+		pbShift.StartAt = timestamppb.New(shift.StartAt)
+	}
+	if !shift.EndAt.IsZero() {
+		pbShift.EndAt = timestamppb.New(shift.EndAt)
+	}
+	if shift.OverrideID != nil {
+		pbShift.OverrideId = shift.OverrideID.String()
+	}
+
+	return pbShift
+}
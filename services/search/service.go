@@ -5,6 +5,7 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -23,6 +24,9 @@ type Service struct {
 	issueRepo repositories.IssueRepository
 	viewRepo  repositories.ViewRepository
 	userRepo  repositories.UserRepository
+
+	// On-call service client, for "assignee:oncall"
+	onCallService pb.OnCallServiceClient
 }
 
 // NewService creates a new search service
@@ -30,11 +34,13 @@ func NewService(
 	issueRepo repositories.IssueRepository,
 	viewRepo repositories.ViewRepository,
 	userRepo repositories.UserRepository,
+	onCallService pb.OnCallServiceClient,
 ) *Service {
 	return &Service{
-		issueRepo: issueRepo,
-		viewRepo:  viewRepo,
-		userRepo:  userRepo,
+		issueRepo:     issueRepo,
+		viewRepo:      viewRepo,
+		userRepo:      userRepo,
+		onCallService: onCallService,
 	}
 }
 
@@ -55,8 +61,20 @@ func (s *Service) SearchIssues(ctx context.Context, req *pb.SearchRequest) (*pb.
 		fmt.Sscanf(req.PageToken, "%d", &offset)
 	}
 
+	// Resolve "cc:me" to the current user
+	query := req.Query
+	if userID, ok := ctx.Value("user_id").(string); ok {
+		query = models.ResolveCCMe(query, userID)
+	}
+
+	// Resolve "assignee:oncall" to whoever is on call now
+	query, err := s.resolveOnCall(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
 	// Query issues with the search query
-	issues, total, err := s.issueRepo.List(ctx, req.Query, pageSize, offset)
+	issues, total, err := s.issueRepo.List(ctx, query, pageSize, offset)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to search issues: %v", err)
 	}
@@ -220,6 +238,12 @@ func (s *Service) modelToProto(issue *models.Issue) *pb.Issue {
 	if issue.DueDate != nil {
 		protoIssue.DueDate = timestamppb.New(*issue.DueDate)
 	}
+	if issue.ResponseDueDate != nil {
+		protoIssue.ResponseDueDate = timestamppb.New(*issue.ResponseDueDate)
+	}
+	if issue.FirstResponseAt != nil {
+		protoIssue.FirstResponseAt = timestamppb.New(*issue.FirstResponseAt)
+	}
 
 	// Map priority and severity
 	switch issue.Priority {
@@ -249,6 +273,43 @@ func (s *Service) modelToProto(issue *models.Issue) *pb.Issue {
 	return protoIssue
 }
 
+// resolveOnCall replaces "assignee:oncall" with the users on call now for
+// the teams of the caller's organization
+func (s *Service) resolveOnCall(ctx context.Context, query string) (string, error) {
+	tokens := strings.Fields(query)
+
+	var onCall string
+	for i, token := range tokens {
+		if token != "assignee:oncall" {
+			continue
+		}
+
+		if onCall == "" {
+			resp, err := s.onCallService.ListCurrentOnCall(ctx, &pb.ListCurrentOnCallRequest{})
+			if err != nil {
+				return "", status.Errorf(codes.Internal, "failed to get on-call users: %v", err)
+			}
+
+			userIDs := make([]string, 0, len(resp.Shifts))
+			seen := make(map[string]bool)
+			for _, shift := range resp.Shifts {
+				if !seen[shift.UserId] {
+					seen[shift.UserId] = true
+					userIDs = append(userIDs, shift.UserId)
+				}
+			}
+
+			// Nobody on call matches no assignee rather than every issue
+			onCall = uuid.Nil.String()
+			if len(userIDs) > 0 {
+				onCall = strings.Join(userIDs, ",")
+			}
+		}
+		tokens[i] = "assignee:" + onCall
+	}
+	return strings.Join(tokens, " "), nil
+}
+
 // viewToProto converts a model.SavedView to a protobuf SavedView
 func (s *Service) viewToProto(view *models.SavedView) *pb.SavedView {
 	protoView := &pb.SavedView{
@@ -0,0 +1,398 @@
+// services/sla/calendars.go
+package sla
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/matthewmc1/buganizer/ical"
+	"github.com/matthewmc1/buganizer/models"
+	pb "github.com/matthewmc1/buganizer/proto"
+)
+
+// holidayHorizonYears is how far ahead unbounded yearly holidays are expanded
+// when importing an iCalendar file
+const holidayHorizonYears = 5
+
+// CreateBusinessCalendar creates a business-hours calendar
+func (s *Service) CreateBusinessCalendar(ctx context.Context, req *pb.CreateBusinessCalendarRequest) (*pb.BusinessCalendar, error) {
+	if req.Calendar == nil {
+		return nil, status.Error(codes.InvalidArgument, "calendar is required")
+	}
+
+	// Get user ID from context
+	userID, ok := ctx.Value("user_id").(string)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not authenticated")
+	}
+	uid := uuid.MustParse(userID)
+
+	if err := s.requireAdmin(ctx, uid); err != nil {
+		return nil, err
+	}
+
+	organizationID, err := s.userRepo.GetOrganizationID(ctx, uid)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get organization: %v", err)
+	}
+
+	now := time.Now()
+	calendar := &models.BusinessCalendar{
+		ID:             uuid.New(),
+		OrganizationID: organizationID,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+		CreatedByID:    &uid,
+	}
+
+	if err := applyCalendar(calendar, req.Calendar); err != nil {
+		return nil, err
+	}
+
+	if err := s.calendarRepo.Create(ctx, calendar); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create calendar: %v", err)
+	}
+
+	return calendarToProto(calendar), nil
+}
+
+// GetBusinessCalendar gets a business-hours calendar of the caller's organization
+func (s *Service) GetBusinessCalendar(ctx context.Context, req *pb.GetBusinessCalendarRequest) (*pb.BusinessCalendar, error) {
+	calendar, _, err := s.getCalendar(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return calendarToProto(calendar), nil
+}
+
+// ListBusinessCalendars lists the business-hours calendars of the caller's organization
+func (s *Service) ListBusinessCalendars(ctx context.Context, req *pb.ListBusinessCalendarsRequest) (*pb.ListBusinessCalendarsResponse, error) {
+	// Get user ID from context
+	userID, ok := ctx.Value("user_id").(string)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not authenticated")
+	}
+
+	organizationID, err := s.userRepo.GetOrganizationID(ctx, uuid.MustParse(userID))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get organization: %v", err)
+	}
+
+	calendars, err := s.calendarRepo.List(ctx, organizationID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list calendars: %v", err)
+	}
+
+	response := &pb.ListBusinessCalendarsResponse{
+		Calendars: make([]*pb.BusinessCalendar, 0, len(calendars)),
+	}
+	for _, calendar := range calendars {
+		response.Calendars = append(response.Calendars, calendarToProto(calendar))
+	}
+
+	return response, nil
+}
+
+// UpdateBusinessCalendar replaces the settings and holidays of a calendar
+func (s *Service) UpdateBusinessCalendar(ctx context.Context, req *pb.UpdateBusinessCalendarRequest) (*pb.BusinessCalendar, error) {
+	if req.Calendar == nil {
+		return nil, status.Error(codes.InvalidArgument, "calendar is required")
+	}
+
+	calendar, uid, err := s.getCalendar(ctx, req.Calendar.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.requireAdmin(ctx, uid); err != nil {
+		return nil, err
+	}
+
+	if err := applyCalendar(calendar, req.Calendar); err != nil {
+		return nil, err
+	}
+	calendar.UpdatedAt = time.Now()
+
+	if err := s.calendarRepo.Update(ctx, calendar); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update calendar: %v", err)
+	}
+
+	return calendarToProto(calendar), nil
+}
+
+// DeleteBusinessCalendar deletes a calendar. Teams and policies using it fall
+// back to wall-clock time.
+func (s *Service) DeleteBusinessCalendar(ctx context.Context, req *pb.DeleteBusinessCalendarRequest) (*emptypb.Empty, error) {
+	calendar, uid, err := s.getCalendar(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.requireAdmin(ctx, uid); err != nil {
+		return nil, err
+	}
+
+	if err := s.calendarRepo.Delete(ctx, calendar.ID); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete calendar: %v", err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// ImportHolidays adds the all-day events of an iCalendar file to a calendar's
+// holidays, or replaces them
+func (s *Service) ImportHolidays(ctx context.Context, req *pb.ImportHolidaysRequest) (*pb.BusinessCalendar, error) {
+	if req.Ical == "" {
+		return nil, status.Error(codes.InvalidArgument, "iCalendar content is required")
+	}
+
+	calendar, uid, err := s.getCalendar(ctx, req.CalendarId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.requireAdmin(ctx, uid); err != nil {
+		return nil, err
+	}
+
+	holidays, err := ical.ParseHolidays(strings.NewReader(req.Ical), time.Now().AddDate(holidayHorizonYears, 0, 0))
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to parse iCalendar file: %v", err)
+	}
+
+	if req.Replace {
+		calendar.Holidays = holidays
+		calendar.UpdatedAt = time.Now()
+		err = s.calendarRepo.Update(ctx, calendar)
+	} else {
+		err = s.calendarRepo.AddHolidays(ctx, calendar.ID, holidays)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to import holidays: %v", err)
+	}
+
+	// Reload to return the merged holidays
+	calendar, err = s.calendarRepo.GetByID(ctx, calendar.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get calendar: %v", err)
+	}
+
+	return calendarToProto(calendar), nil
+}
+
+// SetTeamCalendar attaches a calendar to a team, or detaches it when no
+// calendar is given. Admins and the team lead can change it.
+func (s *Service) SetTeamCalendar(ctx context.Context, req *pb.SetTeamCalendarRequest) (*emptypb.Empty, error) {
+	// Get user ID from context
+	userID, ok := ctx.Value("user_id").(string)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not authenticated")
+	}
+	uid := uuid.MustParse(userID)
+
+	teamID, err := uuid.Parse(req.TeamId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid team ID format")
+	}
+
+	team, err := s.teamRepo.GetByID(ctx, teamID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Error(codes.NotFound, "team not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get team: %v", err)
+	}
+
+	if team.LeadID != uid {
+		if err := s.requireAdmin(ctx, uid); err != nil {
+			return nil, status.Error(codes.PermissionDenied, "only an admin or the team lead can change the team's calendar")
+		}
+	}
+
+	var calendarID *uuid.UUID
+	if req.CalendarId != "" {
+		calendar, _, err := s.getCalendar(ctx, req.CalendarId)
+		if err != nil {
+			return nil, err
+		}
+		calendarID = &calendar.ID
+	}
+
+	if err := s.calendarRepo.SetTeamCalendar(ctx, teamID, calendarID); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Error(codes.NotFound, "team not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to set team calendar: %v", err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// getCalendar loads a calendar, hiding calendars of other organizations. It
+// also returns the caller's user ID.
+func (s *Service) getCalendar(ctx context.Context, id string) (*models.BusinessCalendar, uuid.UUID, error) {
+	if id == "" {
+		return nil, uuid.Nil, status.Error(codes.InvalidArgument, "calendar ID is required")
+	}
+
+	// Get user ID from context
+	userID, ok := ctx.Value("user_id").(string)
+	if !ok {
+		return nil, uuid.Nil, status.Error(codes.Unauthenticated, "user not authenticated")
+	}
+	uid := uuid.MustParse(userID)
+
+	calendarID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, uuid.Nil, status.Error(codes.InvalidArgument, "invalid calendar ID format")
+	}
+
+	calendar, err := s.calendarRepo.GetByID(ctx, calendarID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, uuid.Nil, status.Error(codes.NotFound, "calendar not found")
+		}
+		return nil, uuid.Nil, status.Errorf(codes.Internal, "failed to get calendar: %v", err)
+	}
+
+	organizationID, err := s.userRepo.GetOrganizationID(ctx, uid)
+	if err != nil {
+		return nil, uuid.Nil, status.Errorf(codes.Internal, "failed to get organization: %v", err)
+	}
+	if calendar.OrganizationID != organizationID {
+		return nil, uuid.Nil, status.Error(codes.NotFound, "calendar not found")
+	}
+
+	return calendar, uid, nil
+}
+
+// requireAdmin checks that a user is an admin
+func (s *Service) requireAdmin(ctx context.Context, userID uuid.UUID) error {
+	isAdmin, err := s.userRepo.IsAdmin(ctx, userID)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to check permissions: %v", err)
+	}
+	if !isAdmin {
+		return status.Error(codes.PermissionDenied, "only an admin can manage calendars")
+	}
+	return nil
+}
+
+// applyCalendar copies the settings of a protobuf calendar onto a model,
+// filling in the defaults for values left empty
+func applyCalendar(calendar *models.BusinessCalendar, pbCalendar *pb.BusinessCalendar) error {
+	if strings.TrimSpace(pbCalendar.Name) == "" {
+		return status.Error(codes.InvalidArgument, "calendar name is required")
+	}
+	calendar.Name = strings.TrimSpace(pbCalendar.Name)
+
+	calendar.TimeZone = "UTC"
+	if pbCalendar.TimeZone != "" {
+		if _, err := time.LoadLocation(pbCalendar.TimeZone); err != nil {
+			return status.Errorf(codes.InvalidArgument, "unknown time zone %q", pbCalendar.TimeZone)
+		}
+		calendar.TimeZone = pbCalendar.TimeZone
+	}
+
+	calendar.WorkingDays = nil
+	seen := make(map[int32]bool)
+	for _, day := range pbCalendar.WorkingDays {
+		if day < 0 || day > 6 {
+			return status.Errorf(codes.InvalidArgument, "invalid working day %d, expected 0 (Sunday) to 6", day)
+		}
+		if !seen[day] {
+			seen[day] = true
+			calendar.WorkingDays = append(calendar.WorkingDays, time.Weekday(day))
+		}
+	}
+	if len(calendar.WorkingDays) == 0 {
+		calendar.WorkingDays = []time.Weekday{time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday}
+	}
+
+	var err error
+	if calendar.DayStart, err = parseClock(pbCalendar.DayStart, 9*60); err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid day start: %v", err)
+	}
+	if calendar.DayEnd, err = parseClock(pbCalendar.DayEnd, 17*60); err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid day end: %v", err)
+	}
+	if calendar.DayStart >= calendar.DayEnd {
+		return status.Error(codes.InvalidArgument, "day start must be before day end")
+	}
+
+	calendar.Holidays = make([]models.Holiday, 0, len(pbCalendar.Holidays))
+	for _, holiday := range pbCalendar.Holidays {
+		date, err := time.Parse("2006-01-02", holiday.Date)
+		if err != nil {
+			return status.Errorf(codes.InvalidArgument, "invalid holiday date %q, expected YYYY-MM-DD", holiday.Date)
+		}
+		calendar.Holidays = append(calendar.Holidays, models.Holiday{Date: date, Name: holiday.Name})
+	}
+
+	return nil
+}
+
+// parseClock parses an "HH:MM" time of day as minutes after midnight
+func parseClock(value string, fallback int) (int, error) {
+	if value == "" {
+		return fallback, nil
+	}
+
+	var hours, minutes int
+	if _, err := fmt.Sscanf(value, "%d:%d", &hours, &minutes); err != nil {
+		return 0, fmt.Errorf("%q is not HH:MM", value)
+	}
+	if hours < 0 || minutes < 0 || minutes > 59 || hours*60+minutes > 24*60 {
+		return 0, fmt.Errorf("%q is out of range", value)
+	}
+
+	return hours*60 + minutes, nil
+}
+
+// formatClock formats minutes after midnight as "HH:MM"
+func formatClock(minutes int) string {
+	return fmt.Sprintf("%02d:%02d", minutes/60, minutes%60)
+}
+
+// calendarToProto converts a model.BusinessCalendar to a protobuf BusinessCalendar
+func calendarToProto(calendar *models.BusinessCalendar) *pb.BusinessCalendar {
+	pbCalendar := &pb.BusinessCalendar{
+		Id:             calendar.ID.String(),
+		OrganizationId: calendar.OrganizationID.String(),
+		Name:           calendar.Name,
+		TimeZone:       calendar.TimeZone,
+		WorkingDays:    make([]int32, 0, len(calendar.WorkingDays)),
+		DayStart:       formatClock(calendar.DayStart),
+		DayEnd:         formatClock(calendar.DayEnd),
+		Holidays:       make([]*pb.Holiday, 0, len(calendar.Holidays)),
+		CreatedAt:      timestamppb.New(calendar.CreatedAt),
+		UpdatedAt:      timestamppb.New(calendar.UpdatedAt),
+	}
+
+	for _, day := range calendar.WorkingDays {
+		pbCalendar.WorkingDays = append(pbCalendar.WorkingDays, int32(day))
+	}
+	for _, holiday := range calendar.Holidays {
+		pbCalendar.Holidays = append(pbCalendar.Holidays, &pb.Holiday{
+			Date: holiday.Date.Format("2006-01-02"),
+			Name: holiday.Name,
+		})
+	}
+
+	// Handle optional fields
+	if calendar.CreatedByID != nil {
+		pbCalendar.CreatedById = calendar.CreatedByID.String()
+	}
+
+	return pbCalendar
+}
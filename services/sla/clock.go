@@ -0,0 +1,204 @@
+// services/sla/clock.go
+package sla
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/matthewmc1/buganizer/models"
+	pb "github.com/matthewmc1/buganizer/proto"
+)
+
+// GetSLAClock gets the state of an issue's SLA clock and its pause history
+func (s *Service) GetSLAClock(ctx context.Context, req *pb.GetSLAClockRequest) (*pb.SLAClock, error) {
+	issue, err := s.getIssue(ctx, req.IssueId)
+	if err != nil {
+		return nil, err
+	}
+
+	pauses, err := s.pauseRepo.ListByIssue(ctx, issue.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list SLA pauses: %v", err)
+	}
+
+	return clockToProto(issue, pauses, time.Now()), nil
+}
+
+// SyncSLAClock pauses an issue's SLA clock when its status or labels are
+// configured to pause it, and resumes it otherwise. On resume the deadlines
+// move out by the time paused, counted in the issue's business hours.
+func (s *Service) SyncSLAClock(ctx context.Context, req *pb.SyncSLAClockRequest) (*pb.SLAClock, error) {
+	issue, err := s.getIssue(ctx, req.IssueId)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	reason := s.pauseReason(issue)
+
+	open, err := s.pauseRepo.GetOpen(ctx, issue.ID)
+	if err != nil && err != sql.ErrNoRows {
+		return nil, status.Errorf(codes.Internal, "failed to get SLA pause: %v", err)
+	}
+	if err == sql.ErrNoRows {
+		open = nil
+	}
+
+	switch {
+	case reason != "" && open == nil:
+		pause := &models.SLAPause{
+			ID:        uuid.New(),
+			IssueID:   issue.ID,
+			StartedAt: now,
+			Reason:    reason,
+		}
+		if _, err := s.pauseRepo.Create(ctx, pause); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to pause SLA clock: %v", err)
+		}
+
+	case reason == "" && open != nil:
+		ended, err := s.pauseRepo.End(ctx, issue.ID, now)
+		if err != nil && err != sql.ErrNoRows {
+			return nil, status.Errorf(codes.Internal, "failed to resume SLA clock: %v", err)
+		}
+		// A concurrent sync may have resumed the clock already
+		if err == nil {
+			if err := s.extendDeadlines(ctx, issue, ended); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	pauses, err := s.pauseRepo.ListByIssue(ctx, issue.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list SLA pauses: %v", err)
+	}
+
+	return clockToProto(issue, pauses, now), nil
+}
+
+// extendDeadlines moves an issue's deadlines out by the business time of a
+// finished pause. The response deadline only moves if the issue was still
+// waiting for a response when the pause started.
+func (s *Service) extendDeadlines(ctx context.Context, issue *models.Issue, pause *models.SLAPause) error {
+	_, calendar, err := s.resolvePolicy(ctx, issue.ComponentID)
+	if err != nil {
+		return err
+	}
+
+	paused := businessTimeBetween(calendar, pause.StartedAt, *pause.EndedAt)
+	if paused <= 0 {
+		return nil
+	}
+
+	if issue.DueDate != nil {
+		dueDate := addBusinessTime(calendar, *issue.DueDate, paused)
+		issue.DueDate = &dueDate
+	}
+	if issue.ResponseDueDate != nil && (issue.FirstResponseAt == nil || issue.FirstResponseAt.After(pause.StartedAt)) {
+		responseDueDate := addBusinessTime(calendar, *issue.ResponseDueDate, paused)
+		issue.ResponseDueDate = &responseDueDate
+	}
+
+	if err := s.issueRepo.SetDueDates(ctx, issue.ID, issue.DueDate, issue.ResponseDueDate); err != nil {
+		return status.Errorf(codes.Internal, "failed to update SLA deadlines: %v", err)
+	}
+
+	return nil
+}
+
+// pauseReason returns the status or label that pauses an issue's SLA clock,
+// or "" when the clock should run
+func (s *Service) pauseReason(issue *models.Issue) string {
+	issueStatus := normalizeStatus(string(issue.Status))
+	for _, pauseStatus := range s.config.PauseStatuses {
+		if normalizeStatus(pauseStatus) == issueStatus {
+			return "status:" + issueStatus
+		}
+	}
+
+	for _, label := range issue.Labels {
+		for _, pauseLabel := range s.config.PauseLabels {
+			if strings.EqualFold(label, pauseLabel) {
+				return "label:" + label
+			}
+		}
+	}
+
+	return ""
+}
+
+// getIssue loads an issue by its ID
+func (s *Service) getIssue(ctx context.Context, id string) (*models.Issue, error) {
+	if id == "" {
+		return nil, status.Error(codes.InvalidArgument, "issue ID is required")
+	}
+
+	issueID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid issue ID format")
+	}
+
+	issue, err := s.issueRepo.GetByID(ctx, issueID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Error(codes.NotFound, "issue not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get issue: %v", err)
+	}
+
+	return issue, nil
+}
+
+// normalizeStatus maps both model values ("Waiting on Reporter") and enum
+// names ("WAITING_ON_REPORTER") to the enum name
+func normalizeStatus(s string) string {
+	return strings.ToUpper(strings.ReplaceAll(strings.TrimSpace(s), " ", "_"))
+}
+
+// clockToProto converts an issue's pauses to a protobuf SLAClock
+func clockToProto(issue *models.Issue, pauses []*models.SLAPause, now time.Time) *pb.SLAClock {
+	clock := &pb.SLAClock{
+		IssueId: issue.ID.String(),
+		Pauses:  make([]*pb.SLAPause, 0, len(pauses)),
+	}
+
+	var paused time.Duration
+	for _, pause := range pauses {
+		pbPause := &pb.SLAPause{
+			Id:        pause.ID.String(),
+			IssueId:   pause.IssueID.String(),
+			StartedAt: timestamppb.New(pause.StartedAt),
+			Reason:    pause.Reason,
+		}
+
+		if pause.EndedAt != nil {
+			pbPause.EndedAt = timestamppb.New(*pause.EndedAt)
+			paused += pause.EndedAt.Sub(pause.StartedAt)
+		} else {
+			clock.Paused = true
+			clock.PausedSince = timestamppb.New(pause.StartedAt)
+			paused += now.Sub(pause.StartedAt)
+		}
+
+		clock.Pauses = append(clock.Pauses, pbPause)
+	}
+	clock.PausedSeconds = int64(paused.Seconds())
+
+	// Handle optional fields
+	if issue.DueDate != nil {
+		clock.DueDate = timestamppb.New(*issue.DueDate)
+	}
+	if issue.ResponseDueDate != nil {
+		clock.ResponseDueDate = timestamppb.New(*issue.ResponseDueDate)
+	}
+
+	return clock
+}
@@ -0,0 +1,375 @@
+// services/sla/escalation_scheduler.go
+package sla
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/matthewmc1/buganizer/config"
+	"github.com/matthewmc1/buganizer/models"
+	pb "github.com/matthewmc1/buganizer/proto"
+)
+
+const (
+	// escalationBatchSize is how many due steps the scheduler claims at a time
+	escalationBatchSize = 50
+
+	// escalationLease is how long a claimed step is hidden from other
+	// schedulers; a step whose scheduler died runs again once it expires
+	escalationLease = 5 * time.Minute
+
+	// maxEscalationAttempts is how many times a step runs before it fails
+	maxEscalationAttempts = 5
+)
+
+// OnCallFinder finds who is on call for a team. It returns sql.ErrNoRows when
+// nobody is.
+type OnCallFinder interface {
+	CurrentOnCall(ctx context.Context, teamID uuid.UUID, at time.Time) (*models.OnCallShift, error)
+}
+
+// EscalationNotifier sends the notifications of escalation steps. The
+// notification service implements it; PageWebhook is not part of its gRPC
+// API.
+type EscalationNotifier interface {
+	SendSlackNotification(ctx context.Context, req *pb.NotificationRequest) (*pb.NotificationResponse, error)
+	PageWebhook(ctx context.Context, webhookID uuid.UUID, req *pb.NotificationRequest) error
+}
+
+// EscalationScheduler runs the escalation steps that have come due. Steps are
+// stored in the database and leased while they run, so every replica can run
+// a scheduler and steps survive restarts.
+type EscalationScheduler struct {
+	service  *Service
+	notifier EscalationNotifier
+	onCall   OnCallFinder
+	interval time.Duration
+}
+
+// NewEscalationScheduler creates a new escalation scheduler. Like the monitor,
+// it notifies through the notification service and looks up who is on call
+// through the on-call service directly, for teams of any organization.
+func NewEscalationScheduler(service *Service, notifier EscalationNotifier, onCall OnCallFinder, cfg config.SLAConfig) *EscalationScheduler {
+	return &EscalationScheduler{
+		service:  service,
+		notifier: notifier,
+		onCall:   onCall,
+		interval: time.Duration(cfg.EscalationPollSeconds) * time.Second,
+	}
+}
+
+// Run runs due steps on every interval until ctx is cancelled. It does nothing
+// when the interval is not positive.
+func (e *EscalationScheduler) Run(ctx context.Context) {
+	if e.interval <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(e.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := e.RunDue(ctx); err != nil {
+				fmt.Printf("Error running escalations: %v\n", err)
+			}
+		}
+	}
+}
+
+// RunDue claims and runs due steps until none are left
+func (e *EscalationScheduler) RunDue(ctx context.Context) error {
+	for {
+		escalations, err := e.service.escalationRepo.ClaimDue(ctx, time.Now(), escalationLease, escalationBatchSize)
+		if err != nil {
+			return fmt.Errorf("failed to claim escalations: %v", err)
+		}
+
+		for _, escalation := range escalations {
+			e.runStep(ctx, escalation)
+		}
+
+		if len(escalations) < escalationBatchSize {
+			return nil
+		}
+	}
+}
+
+// runStep runs a claimed step and records its outcome. Failed steps are
+// retried with a growing backoff until they run out of attempts.
+func (e *EscalationScheduler) runStep(ctx context.Context, escalation *models.Escalation) {
+	s := e.service
+
+	issue, err := s.issueRepo.GetByID(ctx, escalation.IssueID)
+	if err == sql.ErrNoRows {
+		e.finish(ctx, escalation, models.EscalationSkipped, "issue no longer exists")
+		return
+	}
+	if err != nil {
+		e.retry(ctx, escalation, fmt.Errorf("failed to get issue: %v", err))
+		return
+	}
+
+	// Resolving an issue should have cancelled its steps; catch any the
+	// issue service missed
+	if issue.Status.IsResolved() {
+		if err := s.cancelEscalations(ctx, issue.ID, nil); err != nil {
+			fmt.Printf("Error cancelling escalations for issue %s: %v\n", issue.ID, err)
+		}
+		return
+	}
+
+	var result string
+	switch escalation.Action {
+	case models.EscalationNotifyAssignee:
+		result, err = e.notifyAssignee(ctx, issue)
+	case models.EscalationNotifyTeamLead:
+		result, err = e.notifyTeamLead(ctx, issue)
+	case models.EscalationReassignOnCall:
+		result, err = e.reassignOnCall(ctx, issue, escalation.Target)
+	case models.EscalationBumpPriority:
+		result, err = e.bumpPriority(ctx, issue, escalation.Target)
+	case models.EscalationPageWebhook:
+		result, err = e.pageWebhook(ctx, issue, escalation.Target)
+	default:
+		err = fmt.Errorf("unknown action %s", escalation.Action)
+	}
+
+	if err != nil {
+		e.retry(ctx, escalation, err)
+		return
+	}
+
+	if result == "" {
+		e.finish(ctx, escalation, models.EscalationSkipped, "nothing to do")
+		return
+	}
+	e.finish(ctx, escalation, models.EscalationDone, result)
+}
+
+// notifyAssignee messages the assignee of an issue
+func (e *EscalationScheduler) notifyAssignee(ctx context.Context, issue *models.Issue) (string, error) {
+	if issue.AssigneeID == nil {
+		return "", nil
+	}
+
+	if err := e.notify(ctx, issue, *issue.AssigneeID, fmt.Sprintf("SLA breached and escalated to you as assignee: %s", issue.Title)); err != nil {
+		return "", err
+	}
+	return "notified assignee " + issue.AssigneeID.String(), nil
+}
+
+// notifyTeamLead messages the lead of the team owning the issue's component
+func (e *EscalationScheduler) notifyTeamLead(ctx context.Context, issue *models.Issue) (string, error) {
+	s := e.service
+
+	component, err := s.componentRepo.GetByID(ctx, issue.ComponentID)
+	if err == sql.ErrNoRows {
+		return "", nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to get component: %v", err)
+	}
+
+	team, err := s.teamRepo.GetByID(ctx, component.TeamID)
+	if err == sql.ErrNoRows {
+		return "", nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to get team: %v", err)
+	}
+	if team.LeadID == uuid.Nil {
+		return "", nil
+	}
+
+	if err := e.notify(ctx, issue, team.LeadID, fmt.Sprintf("SLA breached and escalated to you as lead of %s: %s", team.Name, issue.Title)); err != nil {
+		return "", err
+	}
+	return "notified team lead " + team.LeadID.String(), nil
+}
+
+// reassignOnCall assigns an issue to the target user, or else to whoever is
+// on call for the team owning its component
+func (e *EscalationScheduler) reassignOnCall(ctx context.Context, issue *models.Issue, target string) (string, error) {
+	s := e.service
+
+	var assigneeID uuid.UUID
+	if target != "" {
+		id, err := uuid.Parse(target)
+		if err != nil {
+			return "", fmt.Errorf("invalid target user ID: %v", err)
+		}
+		assigneeID = id
+	} else {
+		component, err := s.componentRepo.GetByID(ctx, issue.ComponentID)
+		if err == sql.ErrNoRows {
+			return "", nil
+		}
+		if err != nil {
+			return "", fmt.Errorf("failed to get component: %v", err)
+		}
+
+		shift, err := e.onCall.CurrentOnCall(ctx, component.TeamID, time.Now())
+		if err == sql.ErrNoRows {
+			return "", nil
+		}
+		if err != nil {
+			return "", fmt.Errorf("failed to get on-call: %v", err)
+		}
+		assigneeID = shift.UserID
+	}
+
+	if issue.AssigneeID != nil && *issue.AssigneeID == assigneeID {
+		return "", nil
+	}
+
+	now := time.Now()
+	oldValue := ""
+	if issue.AssigneeID != nil {
+		oldValue = issue.AssigneeID.String()
+	}
+	issue.AssigneeID = &assigneeID
+	issue.UpdatedAt = now
+
+	if err := s.issueRepo.Update(ctx, issue); err != nil {
+		return "", fmt.Errorf("failed to reassign issue: %v", err)
+	}
+
+	if err := s.historyRepo.Create(ctx, &models.IssueHistory{
+		ID:        uuid.New(),
+		IssueID:   issue.ID,
+		Field:     "assignee_id",
+		OldValue:  oldValue,
+		NewValue:  assigneeID.String(),
+		CreatedAt: now,
+	}); err != nil {
+		fmt.Printf("Error recording history for issue %s: %v\n", issue.ID, err)
+	}
+
+	// The step is done once the issue is reassigned; a lost message is not
+	// worth reassigning again
+	if err := e.notify(ctx, issue, assigneeID, fmt.Sprintf("SLA breached and issue reassigned to you: %s", issue.Title)); err != nil {
+		fmt.Printf("Error notifying new assignee of issue %s: %v\n", issue.ID, err)
+	}
+
+	return "reassigned to " + assigneeID.String(), nil
+}
+
+// bumpPriority raises the priority of an issue to the target priority, or
+// else by one level, and recalculates its deadlines
+func (e *EscalationScheduler) bumpPriority(ctx context.Context, issue *models.Issue, target string) (string, error) {
+	s := e.service
+
+	current, ok := pb.Priority_value[string(issue.Priority)]
+	if !ok {
+		return "", fmt.Errorf("unknown priority %s", issue.Priority)
+	}
+
+	next := current - 1
+	if target != "" {
+		value, ok := pb.Priority_value[target]
+		if !ok {
+			return "", fmt.Errorf("invalid target priority %s", target)
+		}
+		next = value
+	}
+
+	// Only ever raise the priority
+	if next < 0 || next >= current {
+		return "", nil
+	}
+
+	now := time.Now()
+	oldValue := string(issue.Priority)
+	issue.Priority = models.Priority(pb.Priority(next).String())
+	issue.UpdatedAt = now
+
+	if err := s.issueRepo.Update(ctx, issue); err != nil {
+		return "", fmt.Errorf("failed to update priority: %v", err)
+	}
+
+	if err := s.historyRepo.Create(ctx, &models.IssueHistory{
+		ID:        uuid.New(),
+		IssueID:   issue.ID,
+		Field:     "priority",
+		OldValue:  oldValue,
+		NewValue:  string(issue.Priority),
+		CreatedAt: now,
+	}); err != nil {
+		fmt.Printf("Error recording history for issue %s: %v\n", issue.ID, err)
+	}
+
+	if _, _, err := s.recalculateDeadlines(ctx, issue); err != nil {
+		fmt.Printf("Error recalculating SLA for issue %s: %v\n", issue.ID, err)
+	}
+
+	return fmt.Sprintf("priority raised from %s to %s", oldValue, issue.Priority), nil
+}
+
+// pageWebhook calls a registered webhook about an issue
+func (e *EscalationScheduler) pageWebhook(ctx context.Context, issue *models.Issue, target string) (string, error) {
+	webhookID, err := uuid.Parse(target)
+	if err != nil {
+		return "", fmt.Errorf("invalid webhook ID %q: %v", target, err)
+	}
+
+	err = e.notifier.PageWebhook(ctx, webhookID, &pb.NotificationRequest{
+		IssueId: issue.ID.String(),
+		Type:    pb.NotificationRequest_ISSUE_ESCALATED,
+		Message: fmt.Sprintf("SLA breached: %s", issue.Title),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return "paged webhook " + target, nil
+}
+
+// notify sends an ISSUE_ESCALATED message to a single user
+func (e *EscalationScheduler) notify(ctx context.Context, issue *models.Issue, userID uuid.UUID, message string) error {
+	_, err := e.notifier.SendSlackNotification(ctx, &pb.NotificationRequest{
+		IssueId:      issue.ID.String(),
+		Type:         pb.NotificationRequest_ISSUE_ESCALATED,
+		Message:      message,
+		RecipientIds: []string{userID.String()},
+	})
+	return err
+}
+
+// finish records a step's outcome and adds it to the issue's history
+func (e *EscalationScheduler) finish(ctx context.Context, escalation *models.Escalation, status models.EscalationStatus, result string) {
+	now := time.Now()
+	lastError := ""
+	if status == models.EscalationFailed {
+		lastError = result
+	}
+
+	if err := e.service.escalationRepo.Finish(ctx, escalation.ID, status, lastError, now); err != nil {
+		fmt.Printf("Error finishing escalation %s: %v\n", escalation.ID, err)
+		return
+	}
+
+	event := fmt.Sprintf("step %d %s %s: %s", escalation.Position+1, escalation.Action, status, result)
+	e.service.recordEscalationHistory(ctx, escalation.IssueID, nil, event, now)
+}
+
+// retry reschedules a failed step, backing off by the square of its attempts
+// in minutes, or fails it once it is out of attempts
+func (e *EscalationScheduler) retry(ctx context.Context, escalation *models.Escalation, cause error) {
+	if escalation.Attempts >= maxEscalationAttempts {
+		e.finish(ctx, escalation, models.EscalationFailed, cause.Error())
+		return
+	}
+
+	backoff := time.Duration(escalation.Attempts*escalation.Attempts) * time.Minute
+	if err := e.service.escalationRepo.Retry(ctx, escalation.ID, time.Now().Add(backoff), cause.Error()); err != nil {
+		fmt.Printf("Error rescheduling escalation %s: %v\n", escalation.ID, err)
+	}
+}
@@ -0,0 +1,531 @@
+// services/sla/escalations.go
+package sla
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/matthewmc1/buganizer/models"
+	pb "github.com/matthewmc1/buganizer/proto"
+)
+
+// CreateEscalationPolicy creates an escalation policy for a component or a team
+func (s *Service) CreateEscalationPolicy(ctx context.Context, req *pb.CreateEscalationPolicyRequest) (*pb.EscalationPolicy, error) {
+	if req.Policy == nil {
+		return nil, status.Error(codes.InvalidArgument, "policy is required")
+	}
+
+	// Get user ID from context
+	userID, ok := ctx.Value("user_id").(string)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not authenticated")
+	}
+	uid := uuid.MustParse(userID)
+
+	organizationID, err := s.userRepo.GetOrganizationID(ctx, uid)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get organization: %v", err)
+	}
+
+	now := time.Now()
+	policy := &models.EscalationPolicy{
+		ID:             uuid.New(),
+		OrganizationID: organizationID,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+		CreatedByID:    &uid,
+	}
+
+	switch {
+	case req.Policy.ComponentId != "" && req.Policy.TeamId != "":
+		return nil, status.Error(codes.InvalidArgument, "only one of component_id and team_id can be set")
+	case req.Policy.ComponentId != "":
+		componentID, err := uuid.Parse(req.Policy.ComponentId)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid component ID format")
+		}
+		policy.ComponentID = &componentID
+	case req.Policy.TeamId != "":
+		teamID, err := uuid.Parse(req.Policy.TeamId)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid team ID format")
+		}
+		policy.TeamID = &teamID
+	default:
+		return nil, status.Error(codes.InvalidArgument, "component_id or team_id is required")
+	}
+
+	if err := s.checkEscalationPermission(ctx, uid, policy); err != nil {
+		return nil, err
+	}
+
+	if err := applyEscalationPolicy(policy, req.Policy); err != nil {
+		return nil, err
+	}
+
+	if err := s.escalationRepo.CreatePolicy(ctx, policy); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create escalation policy: %v", err)
+	}
+
+	return escalationPolicyToProto(policy), nil
+}
+
+// GetEscalationPolicy gets an escalation policy of the caller's organization
+func (s *Service) GetEscalationPolicy(ctx context.Context, req *pb.GetEscalationPolicyRequest) (*pb.EscalationPolicy, error) {
+	policy, _, err := s.getEscalationPolicy(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return escalationPolicyToProto(policy), nil
+}
+
+// ListEscalationPolicies lists the escalation policies of the caller's organization
+func (s *Service) ListEscalationPolicies(ctx context.Context, req *pb.ListEscalationPoliciesRequest) (*pb.ListEscalationPoliciesResponse, error) {
+	// Get user ID from context
+	userID, ok := ctx.Value("user_id").(string)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not authenticated")
+	}
+
+	organizationID, err := s.userRepo.GetOrganizationID(ctx, uuid.MustParse(userID))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get organization: %v", err)
+	}
+
+	policies, err := s.escalationRepo.ListPolicies(ctx, organizationID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list escalation policies: %v", err)
+	}
+
+	response := &pb.ListEscalationPoliciesResponse{
+		Policies: make([]*pb.EscalationPolicy, 0, len(policies)),
+	}
+	for _, policy := range policies {
+		response.Policies = append(response.Policies, escalationPolicyToProto(policy))
+	}
+
+	return response, nil
+}
+
+// UpdateEscalationPolicy replaces the name, priorities and steps of an
+// escalation policy
+func (s *Service) UpdateEscalationPolicy(ctx context.Context, req *pb.UpdateEscalationPolicyRequest) (*pb.EscalationPolicy, error) {
+	if req.Policy == nil {
+		return nil, status.Error(codes.InvalidArgument, "policy is required")
+	}
+
+	policy, uid, err := s.getEscalationPolicy(ctx, req.Policy.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.checkEscalationPermission(ctx, uid, policy); err != nil {
+		return nil, err
+	}
+
+	if err := applyEscalationPolicy(policy, req.Policy); err != nil {
+		return nil, err
+	}
+	policy.UpdatedAt = time.Now()
+
+	if err := s.escalationRepo.UpdatePolicy(ctx, policy); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update escalation policy: %v", err)
+	}
+
+	return escalationPolicyToProto(policy), nil
+}
+
+// DeleteEscalationPolicy deletes an escalation policy. Steps already scheduled
+// still run.
+func (s *Service) DeleteEscalationPolicy(ctx context.Context, req *pb.DeleteEscalationPolicyRequest) (*emptypb.Empty, error) {
+	policy, uid, err := s.getEscalationPolicy(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.checkEscalationPermission(ctx, uid, policy); err != nil {
+		return nil, err
+	}
+
+	if err := s.escalationRepo.DeletePolicy(ctx, policy.ID); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete escalation policy: %v", err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// SetTeamOnCall sets who is on call for a team, or clears it when no user is
+// given. Admins and the team lead can change it.
+func (s *Service) SetTeamOnCall(ctx context.Context, req *pb.SetTeamOnCallRequest) (*emptypb.Empty, error) {
+	// Get user ID from context
+	userID, ok := ctx.Value("user_id").(string)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not authenticated")
+	}
+	uid := uuid.MustParse(userID)
+
+	teamID, err := uuid.Parse(req.TeamId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid team ID format")
+	}
+
+	team, err := s.teamRepo.GetByID(ctx, teamID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Error(codes.NotFound, "team not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get team: %v", err)
+	}
+
+	if team.LeadID != uid {
+		if err := s.requireAdmin(ctx, uid); err != nil {
+			return nil, status.Error(codes.PermissionDenied, "only an admin or the team lead can change who is on call")
+		}
+	}
+
+	var onCallID *uuid.UUID
+	if req.UserId != "" {
+		id, err := uuid.Parse(req.UserId)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid user ID format")
+		}
+
+		// The on-call must be on the team
+		isMember, err := s.userRepo.IsUserInTeam(ctx, id, teamID)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to check team membership: %v", err)
+		}
+		if !isMember {
+			return nil, status.Error(codes.FailedPrecondition, "user is not a member of the team")
+		}
+		onCallID = &id
+	}
+
+	if err := s.escalationRepo.SetTeamOnCall(ctx, teamID, onCallID); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Error(codes.NotFound, "team not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to set team on-call: %v", err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// ListIssueEscalations lists the escalation steps scheduled or run for an issue
+func (s *Service) ListIssueEscalations(ctx context.Context, req *pb.ListIssueEscalationsRequest) (*pb.ListIssueEscalationsResponse, error) {
+	issue, err := s.getIssue(ctx, req.IssueId)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.listEscalations(ctx, issue.ID)
+}
+
+// CancelEscalations cancels the pending escalation steps of an issue. The
+// issue service calls it when an issue is resolved.
+func (s *Service) CancelEscalations(ctx context.Context, req *pb.CancelEscalationsRequest) (*pb.ListIssueEscalationsResponse, error) {
+	issue, err := s.getIssue(ctx, req.IssueId)
+	if err != nil {
+		return nil, err
+	}
+
+	var actorID *uuid.UUID
+	if userID, ok := ctx.Value("user_id").(string); ok {
+		if id, err := uuid.Parse(userID); err == nil {
+			actorID = &id
+		}
+	}
+
+	if err := s.cancelEscalations(ctx, issue.ID, actorID); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to cancel escalations: %v", err)
+	}
+
+	return s.listEscalations(ctx, issue.ID)
+}
+
+// startEscalation schedules the steps of the escalation policy that applies to
+// a breached issue. An issue is escalated once; later breaches are ignored.
+func (s *Service) startEscalation(ctx context.Context, issue *models.Issue, breachedAt time.Time) error {
+	policy, err := s.escalationRepo.GetPolicyForComponent(ctx, issue.ComponentID)
+	if err == sql.ErrNoRows {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get escalation policy: %v", err)
+	}
+
+	if !policy.Applies(issue.Priority) || len(policy.Steps) == 0 {
+		return nil
+	}
+
+	// Each step runs its delay after the one before
+	escalations := make([]*models.Escalation, len(policy.Steps))
+	runAt := breachedAt
+	for i, step := range policy.Steps {
+		runAt = runAt.Add(time.Duration(step.DelayMinutes) * time.Minute)
+		escalations[i] = &models.Escalation{
+			ID:        uuid.New(),
+			IssueID:   issue.ID,
+			PolicyID:  &policy.ID,
+			Position:  i,
+			Action:    step.Action,
+			Target:    step.Target,
+			RunAt:     runAt,
+			Status:    models.EscalationPending,
+			CreatedAt: breachedAt,
+		}
+	}
+
+	scheduled, err := s.escalationRepo.Schedule(ctx, escalations)
+	if err != nil {
+		return fmt.Errorf("failed to schedule escalation: %v", err)
+	}
+	if !scheduled {
+		return nil
+	}
+
+	s.recordEscalationHistory(ctx, issue.ID, nil, fmt.Sprintf("started %q", policy.Name), breachedAt)
+	return nil
+}
+
+// cancelEscalations cancels the pending escalation steps of an issue and
+// records the cancellation in its history
+func (s *Service) cancelEscalations(ctx context.Context, issueID uuid.UUID, actorID *uuid.UUID) error {
+	now := time.Now()
+	cancelled, err := s.escalationRepo.CancelPending(ctx, issueID, now)
+	if err != nil {
+		return err
+	}
+
+	if len(cancelled) > 0 {
+		s.recordEscalationHistory(ctx, issueID, actorID, fmt.Sprintf("cancelled %d pending steps", len(cancelled)), now)
+	}
+	return nil
+}
+
+// recordEscalationHistory records an escalation event in an issue's history;
+// failures are logged
+func (s *Service) recordEscalationHistory(ctx context.Context, issueID uuid.UUID, actorID *uuid.UUID, event string, at time.Time) {
+	err := s.historyRepo.Create(ctx, &models.IssueHistory{
+		ID:        uuid.New(),
+		IssueID:   issueID,
+		ActorID:   actorID,
+		Field:     "escalation",
+		NewValue:  event,
+		CreatedAt: at,
+	})
+	if err != nil {
+		fmt.Printf("Error recording escalation history for issue %s: %v\n", issueID, err)
+	}
+}
+
+// listEscalations lists the escalation steps of an issue as a response
+func (s *Service) listEscalations(ctx context.Context, issueID uuid.UUID) (*pb.ListIssueEscalationsResponse, error) {
+	escalations, err := s.escalationRepo.ListByIssue(ctx, issueID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list escalations: %v", err)
+	}
+
+	response := &pb.ListIssueEscalationsResponse{
+		Escalations: make([]*pb.Escalation, 0, len(escalations)),
+	}
+	for _, escalation := range escalations {
+		response.Escalations = append(response.Escalations, escalationToProto(escalation))
+	}
+
+	return response, nil
+}
+
+// getEscalationPolicy loads an escalation policy, hiding policies of other
+// organizations. It also returns the caller's user ID.
+func (s *Service) getEscalationPolicy(ctx context.Context, id string) (*models.EscalationPolicy, uuid.UUID, error) {
+	if id == "" {
+		return nil, uuid.Nil, status.Error(codes.InvalidArgument, "policy ID is required")
+	}
+
+	// Get user ID from context
+	userID, ok := ctx.Value("user_id").(string)
+	if !ok {
+		return nil, uuid.Nil, status.Error(codes.Unauthenticated, "user not authenticated")
+	}
+	uid := uuid.MustParse(userID)
+
+	policyID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, uuid.Nil, status.Error(codes.InvalidArgument, "invalid policy ID format")
+	}
+
+	policy, err := s.escalationRepo.GetPolicy(ctx, policyID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, uuid.Nil, status.Error(codes.NotFound, "escalation policy not found")
+		}
+		return nil, uuid.Nil, status.Errorf(codes.Internal, "failed to get escalation policy: %v", err)
+	}
+
+	organizationID, err := s.userRepo.GetOrganizationID(ctx, uid)
+	if err != nil {
+		return nil, uuid.Nil, status.Errorf(codes.Internal, "failed to get organization: %v", err)
+	}
+	if policy.OrganizationID != organizationID {
+		return nil, uuid.Nil, status.Error(codes.NotFound, "escalation policy not found")
+	}
+
+	return policy, uid, nil
+}
+
+// checkEscalationPermission allows admins to manage any escalation policy,
+// component owners their component's and team leads their team's
+func (s *Service) checkEscalationPermission(ctx context.Context, userID uuid.UUID, policy *models.EscalationPolicy) error {
+	if policy.ComponentID != nil {
+		if err := s.checkPolicyPermission(ctx, userID, policy.ComponentID); err != nil {
+			if status.Code(err) == codes.PermissionDenied {
+				return status.Error(codes.PermissionDenied, "only an admin or the component owner can change its escalation policy")
+			}
+			return err
+		}
+		return nil
+	}
+
+	team, err := s.teamRepo.GetByID(ctx, *policy.TeamID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return status.Error(codes.NotFound, "team not found")
+		}
+		return status.Errorf(codes.Internal, "failed to get team: %v", err)
+	}
+	if team.LeadID == userID {
+		return nil
+	}
+
+	if err := s.requireAdmin(ctx, userID); err != nil {
+		return status.Error(codes.PermissionDenied, "only an admin or the team lead can change the team's escalation policy")
+	}
+	return nil
+}
+
+// applyEscalationPolicy validates a protobuf policy and copies its name,
+// priorities and steps onto a model
+func applyEscalationPolicy(policy *models.EscalationPolicy, pbPolicy *pb.EscalationPolicy) error {
+	if pbPolicy.Name == "" {
+		return status.Error(codes.InvalidArgument, "name is required")
+	}
+	if len(pbPolicy.Steps) == 0 {
+		return status.Error(codes.InvalidArgument, "at least one step is required")
+	}
+
+	priorities := make([]models.Priority, 0, len(pbPolicy.Priorities))
+	for _, priority := range pbPolicy.Priorities {
+		if _, ok := pb.Priority_name[int32(priority)]; !ok {
+			return status.Error(codes.InvalidArgument, "invalid priority")
+		}
+		priorities = append(priorities, models.Priority(priority.String()))
+	}
+
+	steps := make([]models.EscalationStep, 0, len(pbPolicy.Steps))
+	for i, step := range pbPolicy.Steps {
+		if _, ok := pb.EscalationAction_name[int32(step.Action)]; !ok {
+			return status.Errorf(codes.InvalidArgument, "step %d: invalid action", i+1)
+		}
+		if step.DelayMinutes < 0 {
+			return status.Errorf(codes.InvalidArgument, "step %d: delay must not be negative", i+1)
+		}
+
+		switch step.Action {
+		case pb.EscalationAction_REASSIGN_ON_CALL:
+			if _, err := uuid.Parse(step.Target); step.Target != "" && err != nil {
+				return status.Errorf(codes.InvalidArgument, "step %d: target must be a user ID", i+1)
+			}
+		case pb.EscalationAction_BUMP_PRIORITY:
+			if _, ok := pb.Priority_value[step.Target]; step.Target != "" && !ok {
+				return status.Errorf(codes.InvalidArgument, "step %d: target must be a priority", i+1)
+			}
+		case pb.EscalationAction_PAGE_WEBHOOK:
+			if _, err := uuid.Parse(step.Target); err != nil {
+				return status.Errorf(codes.InvalidArgument, "step %d: target must be a webhook ID", i+1)
+			}
+		}
+
+		steps = append(steps, models.EscalationStep{
+			Action:       models.EscalationAction(step.Action.String()),
+			DelayMinutes: int(step.DelayMinutes),
+			Target:       step.Target,
+		})
+	}
+
+	policy.Name = pbPolicy.Name
+	policy.Priorities = priorities
+	policy.Steps = steps
+	return nil
+}
+
+// escalationPolicyToProto converts a model.EscalationPolicy to a protobuf EscalationPolicy
+func escalationPolicyToProto(policy *models.EscalationPolicy) *pb.EscalationPolicy {
+	pbPolicy := &pb.EscalationPolicy{
+		Id:             policy.ID.String(),
+		OrganizationId: policy.OrganizationID.String(),
+		Name:           policy.Name,
+		Priorities:     make([]pb.Priority, 0, len(policy.Priorities)),
+		Steps:          make([]*pb.EscalationStep, 0, len(policy.Steps)),
+		CreatedAt:      timestamppb.New(policy.CreatedAt),
+		UpdatedAt:      timestamppb.New(policy.UpdatedAt),
+	}
+
+	for _, priority := range policy.Priorities {
+		pbPolicy.Priorities = append(pbPolicy.Priorities, pb.Priority(pb.Priority_value[string(priority)]))
+	}
+	for _, step := range policy.Steps {
+		pbPolicy.Steps = append(pbPolicy.Steps, &pb.EscalationStep{
+			Action:       pb.EscalationAction(pb.EscalationAction_value[string(step.Action)]),
+			DelayMinutes: int32(step.DelayMinutes),
+			Target:       step.Target,
+		})
+	}
+
+	// Handle optional fields
+	if policy.ComponentID != nil {
+		pbPolicy.ComponentId = policy.ComponentID.String()
+	}
+	if policy.TeamID != nil {
+		pbPolicy.TeamId = policy.TeamID.String()
+	}
+	if policy.CreatedByID != nil {
+		pbPolicy.CreatedById = policy.CreatedByID.String()
+	}
+
+	return pbPolicy
+}
+
+// escalationToProto converts a model.Escalation to a protobuf Escalation
+func escalationToProto(escalation *models.Escalation) *pb.Escalation {
+	pbEscalation := &pb.Escalation{
+		Id:        escalation.ID.String(),
+		IssueId:   escalation.IssueID.String(),
+		Position:  int32(escalation.Position),
+		Action:    pb.EscalationAction(pb.EscalationAction_value[string(escalation.Action)]),
+		Target:    escalation.Target,
+		RunAt:     timestamppb.New(escalation.RunAt),
+		Status:    string(escalation.Status),
+		Attempts:  int32(escalation.Attempts),
+		LastError: escalation.LastError,
+	}
+
+	// Handle optional fields
+	if escalation.PolicyID != nil {
+		pbEscalation.PolicyId = escalation.PolicyID.String()
+	}
+	if escalation.FinishedAt != nil {
+		pbEscalation.FinishedAt = timestamppb.New(*escalation.FinishedAt)
+	}
+
+	return pbEscalation
+}
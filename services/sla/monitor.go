@@ -0,0 +1,223 @@
+// services/sla/monitor.go
+package sla
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/matthewmc1/buganizer/config"
+	"github.com/matthewmc1/buganizer/models"
+	pb "github.com/matthewmc1/buganizer/proto"
+	"github.com/matthewmc1/buganizer/repositories"
+)
+
+// monitorPageSize is how many issues the monitor loads at a time
+const monitorPageSize = 100
+
+// resolvedStatuses are the statuses whose SLA clocks have stopped for good,
+// as model values and as the enum names stored by updates
+var resolvedStatuses = []string{
+	string(models.StatusFixed), pb.Status_FIXED.String(),
+	string(models.StatusVerified), pb.Status_VERIFIED.String(),
+	string(models.StatusClosed), pb.Status_CLOSED.String(),
+	string(models.StatusDuplicate), pb.Status_DUPLICATE.String(),
+	string(models.StatusWontFix), pb.Status_WONT_FIX.String(),
+}
+
+// Monitor periodically scans open issues and sends SLA_AT_RISK notifications
+// as issues use up their SLA budget and SLA_BREACHED notifications when a
+// deadline passes, starting the escalation policy of the issue. Each threshold
+// is notified once per issue and target. Only the replica holding the leader
+// lock scans.
+type Monitor struct {
+	service          *Service
+	notificationRepo repositories.SLANotificationRepository
+	lock             repositories.LeaderLock
+	notifier         pb.NotificationServiceServer
+	interval         time.Duration
+	thresholds       []int
+}
+
+// NewMonitor creates a new SLA monitor. Notifications go straight to the
+// notification service as the monitor has no caller to act for.
+func NewMonitor(
+	service *Service,
+	notificationRepo repositories.SLANotificationRepository,
+	lock repositories.LeaderLock,
+	notifier pb.NotificationServiceServer,
+	cfg config.SLAConfig,
+) *Monitor {
+	return &Monitor{
+		service:          service,
+		notificationRepo: notificationRepo,
+		lock:             lock,
+		notifier:         notifier,
+		interval:         time.Duration(cfg.MonitorIntervalSeconds) * time.Second,
+		thresholds:       cfg.RiskThresholds,
+	}
+}
+
+// Run scans on every interval until ctx is cancelled. It does nothing when the
+// interval is not positive.
+func (m *Monitor) Run(ctx context.Context) {
+	if m.interval <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(m.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			if err := m.lock.Release(context.Background()); err != nil {
+				fmt.Printf("Error releasing SLA monitor lock: %v\n", err)
+			}
+			return
+		case <-ticker.C:
+			leader, err := m.lock.TryAcquire(ctx)
+			if err != nil {
+				fmt.Printf("Error acquiring SLA monitor lock: %v\n", err)
+				continue
+			}
+			if !leader {
+				continue
+			}
+
+			if err := m.Scan(ctx); err != nil {
+				fmt.Printf("Error scanning SLAs: %v\n", err)
+			}
+		}
+	}
+}
+
+// Scan checks every issue with a running deadline once
+func (m *Monitor) Scan(ctx context.Context) error {
+	s := m.service
+	now := time.Now()
+	policies := make(map[uuid.UUID]*models.SLAConfig)
+	calendars := make(map[uuid.UUID]*models.BusinessCalendar)
+
+	for offset := 0; ; offset += monitorPageSize {
+		issues, err := s.issueRepo.ListWithDeadlines(ctx, resolvedStatuses, monitorPageSize, offset)
+		if err != nil {
+			return fmt.Errorf("failed to list issues: %v", err)
+		}
+
+		issueIDs := make([]uuid.UUID, 0, len(issues))
+		for _, issue := range issues {
+			issueIDs = append(issueIDs, issue.ID)
+		}
+		pauses, err := s.pauseRepo.ListOpen(ctx, issueIDs)
+		if err != nil {
+			return fmt.Errorf("failed to list SLA pauses: %v", err)
+		}
+
+		for _, issue := range issues {
+			// A paused clock can't run out
+			if _, ok := pauses[issue.ID]; ok {
+				continue
+			}
+
+			policy, ok := policies[issue.ComponentID]
+			if !ok {
+				var calendar *models.BusinessCalendar
+				policy, calendar, err = s.resolvePolicy(ctx, issue.ComponentID)
+				if err != nil {
+					return err
+				}
+				policies[issue.ComponentID] = policy
+				calendars[issue.ComponentID] = calendar
+			}
+			calendar := calendars[issue.ComponentID]
+
+			if issue.ResponseDueDate != nil && issue.FirstResponseAt == nil {
+				budget := policy.ResponseTime(issue.Priority, issue.Severity)
+				remaining := businessTimeBetween(calendar, now, *issue.ResponseDueDate)
+				m.check(ctx, issue, models.SLATargetResponse, *issue.ResponseDueDate, budget, remaining, now)
+			}
+			if issue.DueDate != nil {
+				budget := policy.ResolutionTime(issue.Priority, issue.Severity)
+				remaining := businessTimeBetween(calendar, now, *issue.DueDate)
+				m.check(ctx, issue, models.SLATargetResolution, *issue.DueDate, budget, remaining, now)
+			}
+		}
+
+		if len(issues) < monitorPageSize {
+			return nil
+		}
+	}
+}
+
+// check sends the notification for the highest threshold an issue has newly
+// crossed for a deadline. Lower thresholds crossed at the same time are
+// recorded without a notification of their own.
+func (m *Monitor) check(ctx context.Context, issue *models.Issue, target models.SLATarget, deadline time.Time, budget, remaining time.Duration, now time.Time) {
+	var crossed []int
+	if remaining <= 0 {
+		crossed = append(crossed, m.thresholds...)
+		crossed = append(crossed, models.SLABreachThreshold)
+	} else if budget > 0 {
+		used := int(100 * (budget - remaining) / budget)
+		for _, threshold := range m.thresholds {
+			if used >= threshold {
+				crossed = append(crossed, threshold)
+			}
+		}
+	}
+
+	highest := 0
+	for _, threshold := range crossed {
+		claimed, err := m.notificationRepo.Claim(ctx, issue.ID, target, threshold, now)
+		if err != nil {
+			fmt.Printf("Error recording SLA notification for issue %s: %v\n", issue.ID, err)
+			return
+		}
+		if claimed {
+			highest = threshold
+		}
+	}
+	if highest == 0 {
+		return
+	}
+
+	name := "Resolution"
+	if target == models.SLATargetResponse {
+		name = "Response"
+	}
+
+	req := &pb.NotificationRequest{
+		IssueId: issue.ID.String(),
+		Type:    pb.NotificationRequest_SLA_AT_RISK,
+		Message: fmt.Sprintf("%s SLA %d%% used, due %s: %s",
+			name, highest, deadline.Format("2006-01-02 15:04 MST"), issue.Title),
+	}
+	if highest == models.SLABreachThreshold {
+		req.Type = pb.NotificationRequest_SLA_BREACHED
+		req.Message = fmt.Sprintf("%s SLA breached, was due %s: %s",
+			name, deadline.Format("2006-01-02 15:04 MST"), issue.Title)
+	}
+
+	resp, err := m.notifier.SendSlackNotification(ctx, req)
+	if err == nil && !resp.Success {
+		err = fmt.Errorf("%s", resp.Message)
+	}
+	if err != nil {
+		fmt.Printf("Error sending SLA notification for issue %s: %v\n", issue.ID, err)
+
+		// Let the next scan try again
+		if err := m.notificationRepo.Release(ctx, issue.ID, target, highest); err != nil {
+			fmt.Printf("Error releasing SLA notification for issue %s: %v\n", issue.ID, err)
+		}
+		return
+	}
+
+	if highest == models.SLABreachThreshold {
+		if err := m.service.startEscalation(ctx, issue, now); err != nil {
+			fmt.Printf("Error starting escalation for issue %s: %v\n", issue.ID, err)
+		}
+	}
+}
@@ -0,0 +1,423 @@
+// services/sla/policies.go
+package sla
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/matthewmc1/buganizer/models"
+	pb "github.com/matthewmc1/buganizer/proto"
+)
+
+// CreateSLAPolicy creates an SLA policy for a component, or the organization
+// default when no component is given
+func (s *Service) CreateSLAPolicy(ctx context.Context, req *pb.CreateSLAPolicyRequest) (*pb.SLAPolicy, error) {
+	if req.Policy == nil {
+		return nil, status.Error(codes.InvalidArgument, "policy is required")
+	}
+
+	// Get user ID from context
+	userID, ok := ctx.Value("user_id").(string)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not authenticated")
+	}
+	uid := uuid.MustParse(userID)
+
+	organizationID, err := s.userRepo.GetOrganizationID(ctx, uid)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get organization: %v", err)
+	}
+
+	now := time.Now()
+	config := &models.SLAConfig{
+		ID:             uuid.New(),
+		OrganizationID: organizationID,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+		CreatedByID:    &uid,
+	}
+
+	if req.Policy.ComponentId != "" {
+		componentID, err := uuid.Parse(req.Policy.ComponentId)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid component ID format")
+		}
+
+		// Make sure the component exists in the caller's organization
+		componentOrganizationID, err := s.componentRepo.GetOrganizationID(ctx, componentID)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return nil, status.Error(codes.NotFound, "component not found")
+			}
+			return nil, status.Errorf(codes.Internal, "failed to get component: %v", err)
+		}
+		if componentOrganizationID != organizationID {
+			return nil, status.Error(codes.NotFound, "component not found")
+		}
+		config.ComponentID = &componentID
+	}
+
+	if err := s.checkPolicyPermission(ctx, uid, config.ComponentID); err != nil {
+		return nil, err
+	}
+
+	if err := applyPolicy(config, req.Policy); err != nil {
+		return nil, err
+	}
+	if err := s.checkPolicyCalendar(ctx, config); err != nil {
+		return nil, err
+	}
+
+	// Only one policy may exist per component, and one default per organization
+	var existing *models.SLAConfig
+	if config.ComponentID != nil {
+		existing, err = s.slaConfigRepo.GetByComponent(ctx, *config.ComponentID)
+	} else {
+		existing, err = s.slaConfigRepo.GetOrganizationDefault(ctx, organizationID)
+	}
+	if err != nil && err != sql.ErrNoRows {
+		return nil, status.Errorf(codes.Internal, "failed to check existing policies: %v", err)
+	}
+	if existing != nil {
+		return nil, status.Errorf(codes.AlreadyExists, "SLA policy %s already exists", existing.ID)
+	}
+
+	if err := s.slaConfigRepo.Create(ctx, config); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create SLA policy: %v", err)
+	}
+
+	return policyToProto(config), nil
+}
+
+// GetSLAPolicy gets an SLA policy of the caller's organization
+func (s *Service) GetSLAPolicy(ctx context.Context, req *pb.GetSLAPolicyRequest) (*pb.SLAPolicy, error) {
+	config, _, err := s.getPolicy(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return policyToProto(config), nil
+}
+
+// ListSLAPolicies lists the SLA policies of the caller's organization
+func (s *Service) ListSLAPolicies(ctx context.Context, req *pb.ListSLAPoliciesRequest) (*pb.ListSLAPoliciesResponse, error) {
+	// Get user ID from context
+	userID, ok := ctx.Value("user_id").(string)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not authenticated")
+	}
+
+	organizationID, err := s.userRepo.GetOrganizationID(ctx, uuid.MustParse(userID))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get organization: %v", err)
+	}
+
+	configs, err := s.slaConfigRepo.List(ctx, organizationID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list SLA policies: %v", err)
+	}
+
+	response := &pb.ListSLAPoliciesResponse{
+		Policies: make([]*pb.SLAPolicy, 0, len(configs)),
+	}
+	for _, config := range configs {
+		response.Policies = append(response.Policies, policyToProto(config))
+	}
+
+	return response, nil
+}
+
+// UpdateSLAPolicy replaces the targets of an SLA policy
+func (s *Service) UpdateSLAPolicy(ctx context.Context, req *pb.UpdateSLAPolicyRequest) (*pb.SLAPolicy, error) {
+	if req.Policy == nil {
+		return nil, status.Error(codes.InvalidArgument, "policy is required")
+	}
+
+	config, uid, err := s.getPolicy(ctx, req.Policy.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.checkPolicyPermission(ctx, uid, config.ComponentID); err != nil {
+		return nil, err
+	}
+
+	if err := applyPolicy(config, req.Policy); err != nil {
+		return nil, err
+	}
+	if err := s.checkPolicyCalendar(ctx, config); err != nil {
+		return nil, err
+	}
+	config.UpdatedAt = time.Now()
+
+	if err := s.slaConfigRepo.Update(ctx, config); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update SLA policy: %v", err)
+	}
+
+	return policyToProto(config), nil
+}
+
+// DeleteSLAPolicy deletes an SLA policy. Issues of the component fall back to
+// the organization default.
+func (s *Service) DeleteSLAPolicy(ctx context.Context, req *pb.DeleteSLAPolicyRequest) (*emptypb.Empty, error) {
+	config, uid, err := s.getPolicy(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.checkPolicyPermission(ctx, uid, config.ComponentID); err != nil {
+		return nil, err
+	}
+
+	if err := s.slaConfigRepo.Delete(ctx, config.ID); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete SLA policy: %v", err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// SetComponentSLAPolicy creates or replaces the SLA policy of a component
+func (s *Service) SetComponentSLAPolicy(ctx context.Context, req *pb.SetComponentSLAPolicyRequest) (*pb.SLAPolicy, error) {
+	if req.ComponentId == "" {
+		return nil, status.Error(codes.InvalidArgument, "component ID is required")
+	}
+
+	componentID, err := uuid.Parse(req.ComponentId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid component ID format")
+	}
+
+	policy := &pb.SLAPolicy{
+		ComponentId:            req.ComponentId,
+		CriticalResponseTime:   req.CriticalResponseTime,
+		HighResponseTime:       req.HighResponseTime,
+		MediumResponseTime:     req.MediumResponseTime,
+		LowResponseTime:        req.LowResponseTime,
+		TrivialResponseTime:    req.TrivialResponseTime,
+		CriticalResolutionTime: req.CriticalResolutionTime,
+		HighResolutionTime:     req.HighResolutionTime,
+		MediumResolutionTime:   req.MediumResolutionTime,
+		LowResolutionTime:      req.LowResolutionTime,
+		TrivialResolutionTime:  req.TrivialResolutionTime,
+		S0Factor:               req.S0Factor,
+		S1Factor:               req.S1Factor,
+		S2Factor:               req.S2Factor,
+		S3Factor:               req.S3Factor,
+		CalendarId:             req.CalendarId,
+		RecalculateFrom:        req.RecalculateFrom,
+	}
+
+	config, err := s.slaConfigRepo.GetByComponent(ctx, componentID)
+	if err == sql.ErrNoRows {
+		return s.CreateSLAPolicy(ctx, &pb.CreateSLAPolicyRequest{Policy: policy})
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get SLA policy: %v", err)
+	}
+
+	policy.Id = config.ID.String()
+	return s.UpdateSLAPolicy(ctx, &pb.UpdateSLAPolicyRequest{Policy: policy})
+}
+
+// getPolicy loads a policy, hiding policies of other organizations. It also
+// returns the caller's user ID.
+func (s *Service) getPolicy(ctx context.Context, id string) (*models.SLAConfig, uuid.UUID, error) {
+	if id == "" {
+		return nil, uuid.Nil, status.Error(codes.InvalidArgument, "policy ID is required")
+	}
+
+	// Get user ID from context
+	userID, ok := ctx.Value("user_id").(string)
+	if !ok {
+		return nil, uuid.Nil, status.Error(codes.Unauthenticated, "user not authenticated")
+	}
+	uid := uuid.MustParse(userID)
+
+	policyID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, uuid.Nil, status.Error(codes.InvalidArgument, "invalid policy ID format")
+	}
+
+	config, err := s.slaConfigRepo.GetByID(ctx, policyID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, uuid.Nil, status.Error(codes.NotFound, "SLA policy not found")
+		}
+		return nil, uuid.Nil, status.Errorf(codes.Internal, "failed to get SLA policy: %v", err)
+	}
+
+	organizationID, err := s.userRepo.GetOrganizationID(ctx, uid)
+	if err != nil {
+		return nil, uuid.Nil, status.Errorf(codes.Internal, "failed to get organization: %v", err)
+	}
+	if config.OrganizationID != organizationID {
+		return nil, uuid.Nil, status.Error(codes.NotFound, "SLA policy not found")
+	}
+
+	return config, uid, nil
+}
+
+// checkPolicyPermission allows admins to manage any policy and component
+// owners to manage their component's policy
+func (s *Service) checkPolicyPermission(ctx context.Context, userID uuid.UUID, componentID *uuid.UUID) error {
+	isAdmin, err := s.userRepo.IsAdmin(ctx, userID)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to check permissions: %v", err)
+	}
+	if isAdmin {
+		return nil
+	}
+
+	if componentID != nil {
+		component, err := s.componentRepo.GetByID(ctx, *componentID)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return status.Error(codes.NotFound, "component not found")
+			}
+			return status.Errorf(codes.Internal, "failed to get component: %v", err)
+		}
+		if component.OwnerID == userID {
+			return nil
+		}
+		return status.Error(codes.PermissionDenied, "only an admin or the component owner can change its SLA policy")
+	}
+
+	return status.Error(codes.PermissionDenied, "only an admin can change the organization's default SLA policy")
+}
+
+// checkPolicyCalendar makes sure the calendar of a policy belongs to the
+// policy's organization
+func (s *Service) checkPolicyCalendar(ctx context.Context, config *models.SLAConfig) error {
+	if config.CalendarID == nil {
+		return nil
+	}
+
+	calendar, err := s.calendarRepo.GetByID(ctx, *config.CalendarID)
+	if err != nil && err != sql.ErrNoRows {
+		return status.Errorf(codes.Internal, "failed to get calendar: %v", err)
+	}
+	if err == sql.ErrNoRows || calendar.OrganizationID != config.OrganizationID {
+		return status.Error(codes.NotFound, "calendar not found")
+	}
+
+	return nil
+}
+
+// applyPolicy copies the targets of a protobuf policy onto a config, using the
+// built-in defaults for values left at zero
+func applyPolicy(config *models.SLAConfig, policy *pb.SLAPolicy) error {
+	defaults := models.DefaultSLAConfig()
+
+	hours := []struct {
+		dst      *int
+		value    int32
+		fallback int
+	}{
+		{&config.CriticalResponseTime, policy.CriticalResponseTime, defaults.CriticalResponseTime},
+		{&config.HighResponseTime, policy.HighResponseTime, defaults.HighResponseTime},
+		{&config.MediumResponseTime, policy.MediumResponseTime, defaults.MediumResponseTime},
+		{&config.LowResponseTime, policy.LowResponseTime, defaults.LowResponseTime},
+		{&config.TrivialResponseTime, policy.TrivialResponseTime, defaults.TrivialResponseTime},
+		{&config.CriticalResolutionTime, policy.CriticalResolutionTime, defaults.CriticalResolutionTime},
+		{&config.HighResolutionTime, policy.HighResolutionTime, defaults.HighResolutionTime},
+		{&config.MediumResolutionTime, policy.MediumResolutionTime, defaults.MediumResolutionTime},
+		{&config.LowResolutionTime, policy.LowResolutionTime, defaults.LowResolutionTime},
+		{&config.TrivialResolutionTime, policy.TrivialResolutionTime, defaults.TrivialResolutionTime},
+	}
+	for _, h := range hours {
+		if h.value < 0 {
+			return status.Error(codes.InvalidArgument, "SLA hours must not be negative")
+		}
+		*h.dst = h.fallback
+		if h.value > 0 {
+			*h.dst = int(h.value)
+		}
+	}
+
+	factors := []struct {
+		dst      *float64
+		value    float64
+		fallback float64
+	}{
+		{&config.S0Factor, policy.S0Factor, defaults.S0Factor},
+		{&config.S1Factor, policy.S1Factor, defaults.S1Factor},
+		{&config.S2Factor, policy.S2Factor, defaults.S2Factor},
+		{&config.S3Factor, policy.S3Factor, defaults.S3Factor},
+	}
+	for _, f := range factors {
+		if f.value < 0 {
+			return status.Error(codes.InvalidArgument, "severity factors must not be negative")
+		}
+		*f.dst = f.fallback
+		if f.value > 0 {
+			*f.dst = f.value
+		}
+	}
+
+	config.CalendarID = nil
+	if policy.CalendarId != "" {
+		calendarID, err := uuid.Parse(policy.CalendarId)
+		if err != nil {
+			return status.Error(codes.InvalidArgument, "invalid calendar ID format")
+		}
+		config.CalendarID = &calendarID
+	}
+
+	switch policy.RecalculateFrom {
+	case pb.SLARecalculation_RECALCULATE_FROM_CREATION:
+		config.RecalculateFrom = models.RecalculateFromCreated
+	case pb.SLARecalculation_RECALCULATE_FROM_CHANGE:
+		config.RecalculateFrom = models.RecalculateFromChanged
+	default:
+		return status.Error(codes.InvalidArgument, "invalid recalculate_from value")
+	}
+
+	return nil
+}
+
+// policyToProto converts a model.SLAConfig to a protobuf SLAPolicy
+func policyToProto(config *models.SLAConfig) *pb.SLAPolicy {
+	policy := &pb.SLAPolicy{
+		Id:                     config.ID.String(),
+		OrganizationId:         config.OrganizationID.String(),
+		CriticalResponseTime:   int32(config.CriticalResponseTime),
+		HighResponseTime:       int32(config.HighResponseTime),
+		MediumResponseTime:     int32(config.MediumResponseTime),
+		LowResponseTime:        int32(config.LowResponseTime),
+		TrivialResponseTime:    int32(config.TrivialResponseTime),
+		CriticalResolutionTime: int32(config.CriticalResolutionTime),
+		HighResolutionTime:     int32(config.HighResolutionTime),
+		MediumResolutionTime:   int32(config.MediumResolutionTime),
+		LowResolutionTime:      int32(config.LowResolutionTime),
+		TrivialResolutionTime:  int32(config.TrivialResolutionTime),
+		S0Factor:               config.S0Factor,
+		S1Factor:               config.S1Factor,
+		S2Factor:               config.S2Factor,
+		S3Factor:               config.S3Factor,
+		CreatedAt:              timestamppb.New(config.CreatedAt),
+		UpdatedAt:              timestamppb.New(config.UpdatedAt),
+	}
+
+	// Handle optional fields
+	if config.ComponentID != nil {
+		policy.ComponentId = config.ComponentID.String()
+	}
+	if config.CalendarID != nil {
+		policy.CalendarId = config.CalendarID.String()
+	}
+	if config.CreatedByID != nil {
+		policy.CreatedById = config.CreatedByID.String()
+	}
+	if config.RecalculateFrom == models.RecalculateFromChanged {
+		policy.RecalculateFrom = pb.SLARecalculation_RECALCULATE_FROM_CHANGE
+	}
+
+	return policy
+}
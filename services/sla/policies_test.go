@@ -0,0 +1,105 @@
+// services/sla/policies_test.go
+package sla
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/matthewmc1/buganizer/models"
+	pb "github.com/matthewmc1/buganizer/proto"
+	"github.com/matthewmc1/buganizer/repositories"
+)
+
+// adminUserRepo is a UserRepository of admins in one organization
+type adminUserRepo struct {
+	repositories.UserRepository
+	organizationID uuid.UUID
+}
+
+func (r *adminUserRepo) GetOrganizationID(ctx context.Context, userID uuid.UUID) (uuid.UUID, error) {
+	return r.organizationID, nil
+}
+
+func (r *adminUserRepo) IsAdmin(ctx context.Context, userID uuid.UUID) (bool, error) {
+	return true, nil
+}
+
+// orgComponentRepo is a ComponentRepository of components in organizations
+type orgComponentRepo struct {
+	repositories.ComponentRepository
+	organizations map[uuid.UUID]uuid.UUID
+}
+
+func (r *orgComponentRepo) GetOrganizationID(ctx context.Context, componentID uuid.UUID) (uuid.UUID, error) {
+	organizationID, ok := r.organizations[componentID]
+	if !ok {
+		return uuid.Nil, sql.ErrNoRows
+	}
+	return organizationID, nil
+}
+
+// memorySLAConfigRepo is an in-memory SLAConfigRepository
+type memorySLAConfigRepo struct {
+	repositories.SLAConfigRepository
+	configs []*models.SLAConfig
+}
+
+func (r *memorySLAConfigRepo) GetByComponent(ctx context.Context, componentID uuid.UUID) (*models.SLAConfig, error) {
+	for _, config := range r.configs {
+		if config.ComponentID != nil && *config.ComponentID == componentID {
+			return config, nil
+		}
+	}
+	return nil, sql.ErrNoRows
+}
+
+func (r *memorySLAConfigRepo) Create(ctx context.Context, config *models.SLAConfig) error {
+	r.configs = append(r.configs, config)
+	return nil
+}
+
+func TestCreateSLAPolicyChecksComponentOrganization(t *testing.T) {
+	ours, theirs := uuid.New(), uuid.New()
+	ownComponent, foreignComponent := uuid.New(), uuid.New()
+
+	configs := &memorySLAConfigRepo{}
+	s := &Service{
+		userRepo: &adminUserRepo{organizationID: ours},
+		componentRepo: &orgComponentRepo{organizations: map[uuid.UUID]uuid.UUID{
+			ownComponent:     ours,
+			foreignComponent: theirs,
+		}},
+		slaConfigRepo: configs,
+	}
+	ctx := context.WithValue(context.Background(), "user_id", uuid.New().String())
+
+	tests := []struct {
+		name        string
+		componentID uuid.UUID
+		code        codes.Code
+	}{
+		{"own component", ownComponent, codes.OK},
+		{"other organization's component", foreignComponent, codes.NotFound},
+		{"missing component", uuid.New(), codes.NotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := s.CreateSLAPolicy(ctx, &pb.CreateSLAPolicyRequest{Policy: &pb.SLAPolicy{
+				ComponentId: tt.componentID.String(),
+			}})
+			if status.Code(err) != tt.code {
+				t.Errorf("CreateSLAPolicy error = %v, want %s", err, tt.code)
+			}
+		})
+	}
+
+	if len(configs.configs) != 1 || *configs.configs[0].ComponentID != ownComponent {
+		t.Errorf("created %d policies, want one for the caller's component", len(configs.configs))
+	}
+}
@@ -0,0 +1,171 @@
+// services/sla/recalculate.go
+package sla
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/matthewmc1/buganizer/models"
+	pb "github.com/matthewmc1/buganizer/proto"
+)
+
+// RecalculateSLA recalculates an issue's deadlines from its current priority,
+// severity and component. Depending on the policy the targets count from the
+// issue's creation, less the time its clock was paused, or from now. The
+// response deadline is kept once the issue has had a first response. Changed
+// deadlines are recorded in the issue history, and watchers are notified when
+// a deadline moves earlier.
+func (s *Service) RecalculateSLA(ctx context.Context, req *pb.RecalculateSLARequest) (*pb.SLATarget, error) {
+	issue, err := s.getIssue(ctx, req.IssueId)
+	if err != nil {
+		return nil, err
+	}
+
+	response, earlier, err := s.recalculateDeadlines(ctx, issue)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(earlier) > 0 {
+		_, err := s.notifService.SendSlackNotification(ctx, &pb.NotificationRequest{
+			IssueId: issue.ID.String(),
+			Type:    pb.NotificationRequest_SLA_DEADLINE_CHANGED,
+			Message: fmt.Sprintf("SLA deadline moved earlier (%s) for issue: %s", strings.Join(earlier, "; "), issue.Title),
+		})
+		if err != nil {
+			fmt.Printf("Error sending SLA deadline notification for issue %s: %v\n", issue.ID, err)
+		}
+	}
+
+	return response, nil
+}
+
+// recalculateDeadlines recalculates and saves an issue's deadlines, recording
+// the changes in its history. It also describes the deadlines that moved
+// earlier.
+func (s *Service) recalculateDeadlines(ctx context.Context, issue *models.Issue) (*pb.SLATarget, []string, error) {
+	policy, calendar, err := s.resolvePolicy(ctx, issue.ComponentID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pauses, err := s.pauseRepo.ListByIssue(ctx, issue.ID)
+	if err != nil {
+		return nil, nil, status.Errorf(codes.Internal, "failed to list SLA pauses: %v", err)
+	}
+
+	now := time.Now()
+	start := issue.CreatedAt
+	if policy.RecalculateFrom == models.RecalculateFromChanged {
+		start = now
+	}
+
+	// Finished pauses after the start push the deadlines out. An open pause
+	// does so when the clock resumes, so a clock recalculated while paused
+	// counts from the pause's start.
+	var paused time.Duration
+	for _, pause := range pauses {
+		switch {
+		case pause.EndedAt == nil:
+			if pause.StartedAt.Before(start) {
+				start = pause.StartedAt
+			}
+		case pause.EndedAt.After(start):
+			from := pause.StartedAt
+			if from.Before(start) {
+				from = start
+			}
+			paused += businessTimeBetween(calendar, from, *pause.EndedAt)
+		}
+	}
+
+	// Model values match the enum names (P0-P4, S0-S3)
+	dueDate := addBusinessTime(calendar, start, policy.ResolutionTime(issue.Priority, issue.Severity)+paused)
+	responseDueDate := issue.ResponseDueDate
+	if issue.FirstResponseAt == nil {
+		date := addBusinessTime(calendar, start, policy.ResponseTime(issue.Priority, issue.Severity)+paused)
+		responseDueDate = &date
+	}
+
+	oldDueDate, oldResponseDueDate := issue.DueDate, issue.ResponseDueDate
+	if err := s.issueRepo.SetDueDates(ctx, issue.ID, &dueDate, responseDueDate); err != nil {
+		return nil, nil, status.Errorf(codes.Internal, "failed to update SLA deadlines: %v", err)
+	}
+	issue.DueDate, issue.ResponseDueDate = &dueDate, responseDueDate
+
+	var actorID *uuid.UUID
+	if userID, ok := ctx.Value("user_id").(string); ok {
+		if id, err := uuid.Parse(userID); err == nil {
+			actorID = &id
+		}
+	}
+
+	var entries []*models.IssueHistory
+	var earlier []string
+	changes := []struct {
+		field    string
+		old, new *time.Time
+	}{
+		{"due_date", oldDueDate, issue.DueDate},
+		{"response_due_date", oldResponseDueDate, issue.ResponseDueDate},
+	}
+	for _, c := range changes {
+		if formatDeadline(c.old) == formatDeadline(c.new) {
+			continue
+		}
+		entries = append(entries, &models.IssueHistory{
+			ID:        uuid.New(),
+			IssueID:   issue.ID,
+			ActorID:   actorID,
+			Field:     c.field,
+			OldValue:  formatDeadline(c.old),
+			NewValue:  formatDeadline(c.new),
+			CreatedAt: now,
+		})
+		if c.old != nil && c.new != nil && c.new.Before(*c.old) {
+			earlier = append(earlier, fmt.Sprintf("%s moved from %s to %s",
+				c.field,
+				c.old.Format("2006-01-02 15:04 MST"),
+				c.new.Format("2006-01-02 15:04 MST")))
+		}
+	}
+
+	// The deadlines are already saved, so a history failure doesn't fail the
+	// recalculation
+	if err := s.historyRepo.Create(ctx, entries...); err != nil {
+		fmt.Printf("Error recording SLA deadline history for issue %s: %v\n", issue.ID, err)
+	}
+
+	response := &pb.SLATarget{
+		Priority:    pb.Priority(pb.Priority_value[string(issue.Priority)]),
+		Severity:    pb.Severity(pb.Severity_value[string(issue.Severity)]),
+		TargetDate:  timestamppb.New(dueDate),
+		Description: fmt.Sprintf("Deadlines recalculated from %s", start.Format("2006-01-02 15:04:05 MST")),
+	}
+	if responseDueDate != nil {
+		response.ResponseTargetDate = timestamppb.New(*responseDueDate)
+	}
+	if policy.ID != uuid.Nil {
+		response.PolicyId = policy.ID.String()
+	}
+	if calendar != nil {
+		response.CalendarId = calendar.ID.String()
+	}
+
+	return response, earlier, nil
+}
+
+// formatDeadline formats a deadline for the issue history, "" when unset
+func formatDeadline(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
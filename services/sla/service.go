@@ -3,102 +3,212 @@ package sla
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 	"time"
 
+	"github.com/google/uuid"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/matthewmc1/buganizer/config"
 	"github.com/matthewmc1/buganizer/models"
 	pb "github.com/matthewmc1/buganizer/proto"
 	"github.com/matthewmc1/buganizer/repositories"
 )
 
+// riskHorizon is how far ahead CheckSLARisk looks for due dates
+const riskHorizon = 14 * 24 * time.Hour
+
+// riskPageSize is how many issues CheckSLARisk loads per query
+const riskPageSize = 200
+
 // Service implements the SLAService gRPC interface
 type Service struct {
 	pb.UnimplementedSLAServiceServer
-	issueRepo     repositories.IssueRepository
-	componentRepo repositories.ComponentRepository
-	notifService  pb.NotificationServiceClient
+	issueRepo      repositories.IssueRepository
+	componentRepo  repositories.ComponentRepository
+	slaConfigRepo  repositories.SLAConfigRepository
+	calendarRepo   repositories.CalendarRepository
+	teamRepo       repositories.TeamRepository
+	userRepo       repositories.UserRepository
+	pauseRepo      repositories.SLAPauseRepository
+	historyRepo    repositories.IssueHistoryRepository
+	escalationRepo repositories.EscalationRepository
+	config         config.SLAConfig
+	notifService   pb.NotificationServiceClient
 }
 
 // NewService creates a new SLA service
 func NewService(
 	issueRepo repositories.IssueRepository,
 	componentRepo repositories.ComponentRepository,
+	slaConfigRepo repositories.SLAConfigRepository,
+	calendarRepo repositories.CalendarRepository,
+	teamRepo repositories.TeamRepository,
+	userRepo repositories.UserRepository,
+	pauseRepo repositories.SLAPauseRepository,
+	historyRepo repositories.IssueHistoryRepository,
+	escalationRepo repositories.EscalationRepository,
+	cfg config.SLAConfig,
 	notifService pb.NotificationServiceClient,
 ) *Service {
 	return &Service{
-		issueRepo:     issueRepo,
-		componentRepo: componentRepo,
-		notifService:  notifService,
+		issueRepo:      issueRepo,
+		componentRepo:  componentRepo,
+		slaConfigRepo:  slaConfigRepo,
+		calendarRepo:   calendarRepo,
+		teamRepo:       teamRepo,
+		userRepo:       userRepo,
+		pauseRepo:      pauseRepo,
+		historyRepo:    historyRepo,
+		escalationRepo: escalationRepo,
+		config:         cfg,
+		notifService:   notifService,
 	}
 }
 
-// CalculateSLATarget calculates the SLA target date based on priority and severity
+// CalculateSLATarget calculates the SLA target date based on priority and
+// severity, using the component's SLA policy or the organization default
 func (s *Service) CalculateSLATarget(ctx context.Context, req *pb.CalculateSLARequest) (*pb.SLATarget, error) {
-	if req.Priority == pb.Priority(0) {
-		return nil, status.Error(codes.InvalidArgument, "priority is required")
-	}
-	if req.Severity == pb.Severity(0) {
-		return nil, status.Error(codes.InvalidArgument, "severity is required")
-	}
-
-	// Calculate SLA target based on priority and severity
-	// These values would typically come from a configuration system
-	// For this example, we'll use hardcoded values
-	var targetHours int
-
-	// Calculate base SLA hours based on priority
-	switch req.Priority {
-	case pb.Priority_P0:
-		targetHours = 4 // Critical - 4 hours
-	case pb.Priority_P1:
-		targetHours = 24 // High - 24 hours (1 day)
-	case pb.Priority_P2:
-		targetHours = 72 // Medium - 72 hours (3 days)
-	case pb.Priority_P3:
-		targetHours = 168 // Low - 168 hours (1 week)
-	case pb.Priority_P4:
-		targetHours = 336 // Trivial - 336 hours (2 weeks)
-	default:
-		targetHours = 72 // Default to medium
-	}
-
-	// Adjust based on severity
-	switch req.Severity {
-	case pb.Severity_S0:
-		targetHours = targetHours / 2 // Cut time in half for critical severity
-	case pb.Severity_S1:
-		targetHours = int(float64(targetHours) * 0.75) // 25% reduction for major severity
-	case pb.Severity_S2:
-		// No change for moderate severity
-	case pb.Severity_S3:
-		targetHours = int(float64(targetHours) * 1.5) // 50% increase for minor severity
-	default:
-		// No adjustment for default
-	}
-
-	// Adjust for business hours (simplified)
-	// In a real implementation, you would account for weekends and holidays
-	targetDate := time.Now().Add(time.Duration(targetHours) * time.Hour)
+	if _, ok := pb.Priority_name[int32(req.Priority)]; !ok {
+		return nil, status.Error(codes.InvalidArgument, "invalid priority")
+	}
+	if _, ok := pb.Severity_name[int32(req.Severity)]; !ok {
+		return nil, status.Error(codes.InvalidArgument, "invalid severity")
+	}
+
+	componentID := uuid.Nil
+	if req.ComponentId != "" {
+		id, err := uuid.Parse(req.ComponentId)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid component ID format")
+		}
+		componentID = id
+	}
+
+	policy, calendar, err := s.resolvePolicy(ctx, componentID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Enum names match the model values (P0-P4, S0-S3)
+	priority := models.Priority(req.Priority.String())
+	severity := models.Severity(req.Severity.String())
+	now := time.Now()
+	target := policy.ResolutionTime(priority, severity)
+	targetDate := addBusinessTime(calendar, now, target)
+	responseTarget := policy.ResponseTime(priority, severity)
+	responseDate := addBusinessTime(calendar, now, responseTarget)
 
 	// Create human-readable description
-	description := fmt.Sprintf("Target resolution time: %d hours (%s)",
-		targetHours,
-		targetDate.Format("2006-01-02 15:04:05"))
+	hours := "hours"
+	if calendar != nil {
+		hours = fmt.Sprintf("business hours, %s", calendar.Name)
+	}
+	description := fmt.Sprintf("Target response time: %g %s (%s); target resolution time: %g %s (%s)",
+		responseTarget.Hours(),
+		hours,
+		responseDate.Format("2006-01-02 15:04:05 MST"),
+		target.Hours(),
+		hours,
+		targetDate.Format("2006-01-02 15:04:05 MST"))
 
 	response := &pb.SLATarget{
-		Priority:    req.Priority,
-		Severity:    req.Severity,
-		TargetDate:  timestamppb.New(targetDate),
-		Description: description,
+		Priority:           req.Priority,
+		Severity:           req.Severity,
+		TargetDate:         timestamppb.New(targetDate),
+		ResponseTargetDate: timestamppb.New(responseDate),
+		Description:        description,
+	}
+	if policy.ID != uuid.Nil {
+		response.PolicyId = policy.ID.String()
+	}
+	if calendar != nil {
+		response.CalendarId = calendar.ID.String()
 	}
 
 	return response, nil
 }
 
+// resolvePolicy finds the SLA policy for a component and the calendar its
+// targets are counted in. The policy is the component's own policy, else its
+// organization's default, else the built-in default; without a component the
+// caller's organization default is used. The calendar is the policy's, else
+// that of the team owning the component, else nil for wall-clock time.
+func (s *Service) resolvePolicy(ctx context.Context, componentID uuid.UUID) (*models.SLAConfig, *models.BusinessCalendar, error) {
+	policy, err := s.findPolicy(ctx, componentID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var calendar *models.BusinessCalendar
+	switch {
+	case policy.CalendarID != nil:
+		calendar, err = s.calendarRepo.GetByID(ctx, *policy.CalendarID)
+	case componentID != uuid.Nil:
+		calendar, err = s.calendarRepo.GetByComponentTeam(ctx, componentID)
+	}
+	if err != nil && err != sql.ErrNoRows {
+		return nil, nil, status.Errorf(codes.Internal, "failed to get calendar: %v", err)
+	}
+	if err == sql.ErrNoRows {
+		calendar = nil
+	}
+
+	return policy, calendar, nil
+}
+
+// findPolicy finds the SLA policy that applies to a component
+func (s *Service) findPolicy(ctx context.Context, componentID uuid.UUID) (*models.SLAConfig, error) {
+	if componentID != uuid.Nil {
+		policy, err := s.slaConfigRepo.GetEffective(ctx, componentID)
+		if err == nil {
+			return policy, nil
+		}
+		if err != sql.ErrNoRows {
+			return nil, status.Errorf(codes.Internal, "failed to get SLA policy: %v", err)
+		}
+	}
+
+	if userID, ok := ctx.Value("user_id").(string); ok {
+		organizationID, err := s.userRepo.GetOrganizationID(ctx, uuid.MustParse(userID))
+		if err != nil && err != sql.ErrNoRows {
+			return nil, status.Errorf(codes.Internal, "failed to get organization: %v", err)
+		}
+		if err == nil {
+			policy, err := s.slaConfigRepo.GetOrganizationDefault(ctx, organizationID)
+			if err == nil {
+				return policy, nil
+			}
+			if err != sql.ErrNoRows {
+				return nil, status.Errorf(codes.Internal, "failed to get SLA policy: %v", err)
+			}
+		}
+	}
+
+	return models.DefaultSLAConfig(), nil
+}
+
+// addBusinessTime adds d to start in the calendar's working hours, or in
+// wall-clock time without a calendar
+func addBusinessTime(calendar *models.BusinessCalendar, start time.Time, d time.Duration) time.Time {
+	if calendar == nil {
+		return start.Add(d)
+	}
+	return calendar.AddBusinessTime(start, d)
+}
+
+// businessTimeBetween returns the time from start to end in the calendar's
+// working hours, or in wall-clock time without a calendar
+func businessTimeBetween(calendar *models.BusinessCalendar, start, end time.Time) time.Duration {
+	if calendar == nil {
+		return end.Sub(start)
+	}
+	return calendar.BusinessTimeBetween(start, end)
+}
+
 // CheckSLARisk checks if issues are at risk of SLA breach
 func (s *Service) CheckSLARisk(ctx context.Context, req *pb.CheckSLARiskRequest) (*pb.CheckSLARiskResponse, error) {
 	// Default to not including closed issues
@@ -106,13 +216,25 @@ func (s *Service) CheckSLARisk(ctx context.Context, req *pb.CheckSLARiskRequest)
 
 	// Define what "at risk" means (e.g., less than 4 hours remaining)
 	atRiskThresholdHours := 4
-	atRiskThreshold := time.Now().Add(time.Duration(atRiskThresholdHours) * time.Hour)
+	atRiskThreshold := time.Duration(atRiskThresholdHours) * time.Hour
+
+	// Response targets are short, so they are at risk within the last hour
+	responseAtRiskThreshold := time.Hour
 
-	// Get all issues with due dates before the at-risk threshold
-	filter := fmt.Sprintf("due_date:<=%s", atRiskThreshold.Format(time.RFC3339))
+	// Remaining time is counted in business hours, so an issue due days away
+	// can be at risk over a weekend or holiday. Look far enough ahead to cover
+	// those and filter on the business time remaining.
+	now := time.Now()
+	horizon := now.Add(riskHorizon)
+
+	// Get all issues with due dates before the horizon
+	filter := fmt.Sprintf("due_date:<=%s", horizon.Format(time.RFC3339))
 
 	// Add team filter if provided
 	if req.TeamId != "" {
+		if _, err := uuid.Parse(req.TeamId); err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid team ID format")
+		}
 		filter += fmt.Sprintf(" team:%s", req.TeamId)
 	}
 
@@ -121,30 +243,77 @@ func (s *Service) CheckSLARisk(ctx context.Context, req *pb.CheckSLARiskRequest)
 		filter += " is:open"
 	}
 
-	// Query issues with filter
-	issues, _, err := s.issueRepo.List(ctx, filter, 100, 0)
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to list issues: %v", err)
+	// Query issues with filter, a page at a time so none are missed
+	var issues []*models.Issue
+	for {
+		page, total, err := s.issueRepo.List(ctx, filter, riskPageSize, len(issues))
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to list issues: %v", err)
+		}
+		issues = append(issues, page...)
+		if len(page) == 0 || len(issues) >= total {
+			break
+		}
 	}
 
 	// Convert to response format
 	response := &pb.CheckSLARiskResponse{
 		AtRiskIssues: make([]*pb.SLARiskIssue, 0, len(issues)),
-		TotalAtRisk:  int32(len(issues)),
 	}
 
+	// Remaining time is frozen at the start of a pause
+	issueIDs := make([]uuid.UUID, 0, len(issues))
+	for _, issue := range issues {
+		issueIDs = append(issueIDs, issue.ID)
+	}
+	pauses, err := s.pauseRepo.ListOpen(ctx, issueIDs)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list SLA pauses: %v", err)
+	}
+
+	calendars := make(map[uuid.UUID]*models.BusinessCalendar)
 	for _, issue := range issues {
-		if issue.DueDate == nil {
+		awaitingResponse := issue.ResponseDueDate != nil && issue.FirstResponseAt == nil
+		if issue.DueDate == nil && !awaitingResponse {
 			continue // Skip issues without due dates
 		}
 
-		hoursRemaining := int32(issue.DueDate.Sub(time.Now()).Hours())
+		calendar, ok := calendars[issue.ComponentID]
+		if !ok {
+			_, calendar, err = s.resolvePolicy(ctx, issue.ComponentID)
+			if err != nil {
+				return nil, err
+			}
+			calendars[issue.ComponentID] = calendar
+		}
 
 		riskIssue := &pb.SLARiskIssue{
-			IssueId:        issue.ID.String(),
-			Title:          issue.Title,
-			DueDate:        timestamppb.New(*issue.DueDate),
-			HoursRemaining: hoursRemaining,
+			IssueId: issue.ID.String(),
+			Title:   issue.Title,
+		}
+
+		clockTime := now
+		if pause, ok := pauses[issue.ID]; ok {
+			clockTime = pause.StartedAt
+			riskIssue.Paused = true
+		}
+
+		if issue.DueDate != nil {
+			remaining := businessTimeBetween(calendar, clockTime, *issue.DueDate)
+			riskIssue.DueDate = timestamppb.New(*issue.DueDate)
+			riskIssue.HoursRemaining = int32(remaining.Hours())
+			riskIssue.ResolutionAtRisk = remaining < atRiskThreshold
+		}
+
+		if awaitingResponse {
+			remaining := businessTimeBetween(calendar, clockTime, *issue.ResponseDueDate)
+			riskIssue.ResponseDueDate = timestamppb.New(*issue.ResponseDueDate)
+			riskIssue.ResponseHoursRemaining = int32(remaining.Hours())
+			riskIssue.ResponseAtRisk = remaining < responseAtRiskThreshold
+		}
+
+		if !riskIssue.ResolutionAtRisk && !riskIssue.ResponseAtRisk {
+			continue
 		}
 
 		// Map priority and severity
@@ -174,6 +343,7 @@ func (s *Service) CheckSLARisk(ctx context.Context, req *pb.CheckSLARiskRequest)
 
 		response.AtRiskIssues = append(response.AtRiskIssues, riskIssue)
 	}
+	response.TotalAtRisk = int32(len(response.AtRiskIssues))
 
 	return response, nil
 }
@@ -185,102 +355,110 @@ func (s *Service) GetSLAStats(ctx context.Context, req *pb.GetSLAStatsRequest) (
 		return nil, status.Error(codes.InvalidArgument, "either component_id or team_id is required")
 	}
 
-	// Set default date range if not provided
-	startDate := time.Now().AddDate(0, -1, 0) // Default to last month
+	now := time.Now()
+	filter := models.SLAStatsFilter{
+		CreatedFrom:      now.AddDate(0, -1, 0), // Default to last month
+		CreatedTo:        now,
+		Now:              now,
+		ResolvedStatuses: resolvedStatuses,
+	}
 	if req.StartDate != nil {
-		startDate = req.StartDate.AsTime()
+		filter.CreatedFrom = req.StartDate.AsTime()
 	}
-
-	endDate := time.Now()
 	if req.EndDate != nil {
-		endDate = req.EndDate.AsTime()
+		filter.CreatedTo = req.EndDate.AsTime()
 	}
 
-	// Build filter query
-	filter := fmt.Sprintf("created_at:>=%s created_at:<=%s",
-		startDate.Format(time.RFC3339),
-		endDate.Format(time.RFC3339))
-
 	if req.ComponentId != "" {
-		filter += fmt.Sprintf(" component:%s", req.ComponentId)
+		componentID, err := uuid.Parse(req.ComponentId)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid component ID format")
+		}
+		filter.ComponentID = &componentID
 	}
 
 	if req.TeamId != "" {
-		filter += fmt.Sprintf(" team:%s", req.TeamId)
+		teamID, err := uuid.Parse(req.TeamId)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid team ID format")
+		}
+		filter.TeamID = &teamID
 	}
 
-	// Query issues with filter
-	issues, totalCount, err := s.issueRepo.List(ctx, filter, 1000, 0)
+	counts, err := s.issueRepo.GetSLAStats(ctx, filter)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to list issues: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to get SLA stats: %v", err)
 	}
 
-	// Calculate statistics
 	stats := &pb.SLAStats{
-		TotalIssues:             int32(totalCount),
-		MetSla:                  0,
-		MissedSla:               0,
-		SlaCompliancePercentage: 0,
-		IssuesByPriority:        make(map[string]int32),
-		IssuesBySeverity:        make(map[string]int32),
-		ComplianceByPriority:    make(map[string]float32),
-		ComplianceBySeverity:    make(map[string]float32),
-	}
-
-	// Initialize counters for each priority and severity
-	priorityMetCounts := make(map[string]int32)
-	priorityTotalCounts := make(map[string]int32)
-	severityMetCounts := make(map[string]int32)
-	severityTotalCounts := make(map[string]int32)
-
-	for _, issue := range issues {
-		// Skip issues without due dates or not yet closed
-		if issue.DueDate == nil ||
-			(issue.Status != models.StatusClosed &&
-				issue.Status != models.StatusVerified) {
-			continue
-		}
+		IssuesByPriority:             make(map[string]int32),
+		IssuesBySeverity:             make(map[string]int32),
+		ComplianceByPriority:         make(map[string]float32),
+		ComplianceBySeverity:         make(map[string]float32),
+		ResponseComplianceByPriority: make(map[string]float32),
+		ResponseComplianceBySeverity: make(map[string]float32),
+
+		IssuesByComponent:             make(map[string]int32),
+		ComplianceByComponent:         make(map[string]float32),
+		ResponseComplianceByComponent: make(map[string]float32),
+		IssuesByTeam:                  make(map[string]int32),
+		ComplianceByTeam:              make(map[string]float32),
+		ResponseComplianceByTeam:      make(map[string]float32),
+		IssuesByAssignee:              make(map[string]int32),
+		ComplianceByAssignee:          make(map[string]float32),
+		ResponseComplianceByAssignee:  make(map[string]float32),
+	}
 
-		priority := string(issue.Priority)
-		severity := string(issue.Severity)
-
-		// Count by priority
-		priorityTotalCounts[priority]++
-		stats.IssuesByPriority[priority]++
-
-		// Count by severity
-		severityTotalCounts[severity]++
-		stats.IssuesBySeverity[severity]++
-
-		// Check if SLA was met (closed before due date)
-		metSLA := issue.UpdatedAt.Before(*issue.DueDate)
-		if metSLA {
-			stats.MetSla++
-			priorityMetCounts[priority]++
-			severityMetCounts[severity]++
-		} else {
-			stats.MissedSla++
+	for _, c := range counts {
+		switch c.Dimension {
+		case models.SLAStatsOverall:
+			stats.TotalIssues = int32(c.Issues)
+			stats.MetSla = int32(c.Met)
+			stats.MissedSla = int32(c.Missed)
+			stats.SlaCompliancePercentage, _ = compliance(c.Met, c.Missed)
+			stats.ResponseMetSla = int32(c.ResponseMet)
+			stats.ResponseMissedSla = int32(c.ResponseMissed)
+			stats.ResponseCompliancePercentage, _ = compliance(c.ResponseMet, c.ResponseMissed)
+		case models.SLAStatsByPriority:
+			addBreakdown(c, stats.IssuesByPriority, stats.ComplianceByPriority, stats.ResponseComplianceByPriority)
+		case models.SLAStatsBySeverity:
+			addBreakdown(c, stats.IssuesBySeverity, stats.ComplianceBySeverity, stats.ResponseComplianceBySeverity)
+		case models.SLAStatsByComponent:
+			addBreakdown(c, stats.IssuesByComponent, stats.ComplianceByComponent, stats.ResponseComplianceByComponent)
+		case models.SLAStatsByTeam:
+			addBreakdown(c, stats.IssuesByTeam, stats.ComplianceByTeam, stats.ResponseComplianceByTeam)
+		case models.SLAStatsByAssignee:
+			if c.Key == "" {
+				c.Key = "unassigned"
+			}
+			addBreakdown(c, stats.IssuesByAssignee, stats.ComplianceByAssignee, stats.ResponseComplianceByAssignee)
 		}
 	}
 
-	// Calculate overall compliance percentage
-	if stats.TotalIssues > 0 {
-		stats.SlaCompliancePercentage = float32(stats.MetSla) / float32(stats.TotalIssues) * 100
-	}
+	return stats, nil
+}
 
-	// Calculate compliance by priority
-	for priority, total := range priorityTotalCounts {
-		if total > 0 {
-			stats.ComplianceByPriority[priority] = float32(priorityMetCounts[priority]) / float32(total) * 100
-		}
+// addBreakdown adds one group's counts to the maps of a breakdown. Groups
+// without a key, e.g. issues without a component, are left out.
+func addBreakdown(c *models.SLACounts, issues map[string]int32, resolution, response map[string]float32) {
+	if c.Key == "" {
+		return
 	}
 
-	// Calculate compliance by severity
-	for severity, total := range severityTotalCounts {
-		if total > 0 {
-			stats.ComplianceBySeverity[severity] = float32(severityMetCounts[severity]) / float32(total) * 100
-		}
+	issues[c.Key] = int32(c.Issues)
+	if percentage, ok := compliance(c.Met, c.Missed); ok {
+		resolution[c.Key] = percentage
+	}
+	if percentage, ok := compliance(c.ResponseMet, c.ResponseMissed); ok {
+		response[c.Key] = percentage
 	}
+}
 
-	return stats, nil
+// compliance calculates the percentage of SLAs met, reporting false when none
+// were counted
+func compliance(met, missed int) (float32, bool) {
+	if met+missed == 0 {
+		return 0, false
+	}
+	return float32(met) / float32(met+missed) * 100, true
 }
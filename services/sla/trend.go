@@ -0,0 +1,136 @@
+// services/sla/trend.go
+package sla
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/matthewmc1/buganizer/models"
+	pb "github.com/matthewmc1/buganizer/proto"
+)
+
+// maxTrendBuckets limits how many buckets a trend can have, e.g. about a year
+// of days
+const maxTrendBuckets = 400
+
+// GetSLATrend gets SLA compliance, median time to resolve and breaches per
+// day, week or month
+func (s *Service) GetSLATrend(ctx context.Context, req *pb.GetSLATrendRequest) (*pb.SLATrend, error) {
+	now := time.Now()
+	filter := models.SLATrendFilter{
+		To:               now,
+		Now:              now,
+		ResolvedStatuses: resolvedStatuses,
+	}
+
+	// Set the interval and its default range
+	var bucket time.Duration
+	switch req.Interval {
+	case pb.TrendInterval_TREND_DAY:
+		filter.Interval = models.SLATrendDay
+		filter.From = now.AddDate(0, 0, -30)
+		bucket = 24 * time.Hour
+	case pb.TrendInterval_TREND_WEEK:
+		filter.Interval = models.SLATrendWeek
+		filter.From = now.AddDate(0, 0, -7*12)
+		bucket = 7 * 24 * time.Hour
+	case pb.TrendInterval_TREND_MONTH:
+		filter.Interval = models.SLATrendMonth
+		filter.From = now.AddDate(0, -12, 0)
+		bucket = 28 * 24 * time.Hour
+	default:
+		return nil, status.Error(codes.InvalidArgument, "invalid interval")
+	}
+
+	if req.StartDate != nil {
+		filter.From = req.StartDate.AsTime()
+	}
+	if req.EndDate != nil {
+		filter.To = req.EndDate.AsTime()
+	}
+	if !filter.From.Before(filter.To) {
+		return nil, status.Error(codes.InvalidArgument, "start_date must be before end_date")
+	}
+	if filter.To.Sub(filter.From)/bucket >= maxTrendBuckets {
+		return nil, status.Errorf(codes.InvalidArgument, "range has more than %d buckets; use a longer interval", maxTrendBuckets)
+	}
+
+	// Rolling compliance covers only the point itself unless a window is requested
+	if req.RollingWindow < 0 || req.RollingWindow > maxTrendBuckets {
+		return nil, status.Errorf(codes.InvalidArgument, "rolling_window must be between 0 and %d", maxTrendBuckets)
+	}
+	window := max(int(req.RollingWindow), 1)
+
+	if req.ComponentId != "" {
+		componentID, err := uuid.Parse(req.ComponentId)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid component ID format")
+		}
+		filter.ComponentID = &componentID
+	}
+
+	if req.TeamId != "" {
+		teamID, err := uuid.Parse(req.TeamId)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid team ID format")
+		}
+		filter.TeamID = &teamID
+	}
+
+	for _, priority := range req.Priorities {
+		if _, ok := pb.Priority_name[int32(priority)]; !ok {
+			return nil, status.Error(codes.InvalidArgument, "invalid priority")
+		}
+		filter.Priorities = append(filter.Priorities, priority.String())
+	}
+	for _, severity := range req.Severities {
+		if _, ok := pb.Severity_name[int32(severity)]; !ok {
+			return nil, status.Error(codes.InvalidArgument, "invalid severity")
+		}
+		filter.Severities = append(filter.Severities, severity.String())
+	}
+
+	buckets, err := s.issueRepo.GetSLATrend(ctx, filter)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get SLA trend: %v", err)
+	}
+
+	trend := &pb.SLATrend{
+		Interval: req.Interval,
+		Points:   make([]*pb.SLATrendPoint, 0, len(buckets)),
+	}
+	for i, b := range buckets {
+		point := &pb.SLATrendPoint{
+			BucketStart: timestamppb.New(b.Start),
+			Date:        b.Start.Format("2006-01-02"),
+			Resolved:    int32(b.Resolved),
+			MetSla:      int32(b.Met),
+			MissedSla:   int32(b.Missed),
+			Breached:    int32(b.Breached),
+		}
+		point.CompliancePercentage, _ = compliance(b.Met, b.Missed)
+
+		// Sum the last window buckets up to this one, fewer for the first
+		// buckets of the range
+		rollingMet, rollingMissed := 0, 0
+		for _, prev := range buckets[max(i-window+1, 0) : i+1] {
+			rollingMet += prev.Met
+			rollingMissed += prev.Missed
+		}
+		point.RollingCompliancePercentage, _ = compliance(rollingMet, rollingMissed)
+
+		// Handle optional fields
+		if b.MedianResolve != nil {
+			point.MedianHoursToResolve = b.MedianResolve.Hours()
+		}
+
+		trend.Points = append(trend.Points, point)
+	}
+
+	return trend, nil
+}
@@ -0,0 +1,110 @@
+// storage/local_test.go
+package storage
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestLocalStorageRoundTrip(t *testing.T) {
+	s, err := NewLocalStorage(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewLocalStorage: %v", err)
+	}
+	ctx := context.Background()
+	content := "0123456789abcdef"
+
+	location, err := s.Put(ctx, "issues/1/a.txt", strings.NewReader(content), int64(len(content)), "text/plain")
+	if err != nil {
+		t.Fatalf("Put: %v", err)
+	}
+	if !strings.HasPrefix(location, "file://") || !strings.HasSuffix(location, "/issues/1/a.txt") {
+		t.Errorf("Put location = %s", location)
+	}
+
+	tests := []struct {
+		offset, length int64
+		want           string
+	}{
+		{0, 0, content},
+		{4, 3, "456"},
+		{10, 0, "abcdef"},
+		{14, 10, "ef"},
+	}
+	for _, tt := range tests {
+		r, err := s.Get(ctx, "issues/1/a.txt", tt.offset, tt.length)
+		if err != nil {
+			t.Fatalf("Get(%d, %d): %v", tt.offset, tt.length, err)
+		}
+		b, err := io.ReadAll(r)
+		r.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(b) != tt.want {
+			t.Errorf("Get(%d, %d) = %q, want %q", tt.offset, tt.length, b, tt.want)
+		}
+	}
+
+	if err := s.Delete(ctx, "issues/1/a.txt"); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+	if _, err := s.Get(ctx, "issues/1/a.txt", 0, 0); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Get after Delete: %v, want ErrNotFound", err)
+	}
+	if err := s.Delete(ctx, "issues/1/a.txt"); err != nil {
+		t.Errorf("Delete of a missing file: %v", err)
+	}
+}
+
+func TestLocalStoragePutLeavesNoPartialFile(t *testing.T) {
+	dir := t.TempDir()
+	s, err := NewLocalStorage(dir)
+	if err != nil {
+		t.Fatalf("NewLocalStorage: %v", err)
+	}
+
+	failing := io.MultiReader(strings.NewReader("partial"), errReader{errors.New("connection reset")})
+	if _, err := s.Put(context.Background(), "a.txt", failing, 100, ""); err == nil {
+		t.Fatal("expected Put to fail when the reader fails")
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 0 {
+		t.Errorf("files left behind after a failed Put: %v", entries)
+	}
+}
+
+func TestLocalStorageRejectsEscapingKeys(t *testing.T) {
+	dir := t.TempDir()
+	s, err := NewLocalStorage(filepath.Join(dir, "store"))
+	if err != nil {
+		t.Fatalf("NewLocalStorage: %v", err)
+	}
+
+	for _, key := range []string{"../outside.txt", "a/../../outside.txt", "", "."} {
+		if _, err := s.Put(context.Background(), key, strings.NewReader("x"), 1, ""); err == nil {
+			t.Errorf("Put(%q) succeeded", key)
+		}
+	}
+	if _, err := os.Stat(filepath.Join(dir, "outside.txt")); !os.IsNotExist(err) {
+		t.Error("a key escaped the storage directory")
+	}
+}
+
+// errReader is a reader that always fails
+type errReader struct {
+	err error
+}
+
+func (r errReader) Read([]byte) (int, error) {
+	return 0, r.err
+}
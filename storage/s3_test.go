@@ -0,0 +1,288 @@
+// storage/s3_test.go
+package storage
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/matthewmc1/buganizer/config"
+)
+
+func newTestS3(t *testing.T, endpoint string, pathStyle bool) *S3Storage {
+	t.Helper()
+
+	s, err := NewS3Storage(config.StorageConfig{
+		Provider:        "s3",
+		Bucket:          "attachments",
+		Endpoint:        endpoint,
+		Region:          "eu-west-1",
+		AccessKeyID:     "AKIDEXAMPLE",
+		SecretAccessKey: "wJalrXUtnFEMI/K7MDENG+bPxRfiCYEXAMPLEKEY",
+		UsePathStyle:    pathStyle,
+	})
+	if err != nil {
+		t.Fatalf("NewS3Storage: %v", err)
+	}
+	return s
+}
+
+func TestNewS3StorageValidatesConfig(t *testing.T) {
+	if _, err := NewS3Storage(config.StorageConfig{Provider: "s3"}); err == nil {
+		t.Error("expected an error without a bucket")
+	}
+	if _, err := NewS3Storage(config.StorageConfig{Provider: "s3", Bucket: "b", Endpoint: "not a url"}); err == nil {
+		t.Error("expected an error for an endpoint without a host")
+	}
+
+	s, err := NewS3Storage(config.StorageConfig{Provider: "s3", Bucket: "b"})
+	if err != nil {
+		t.Fatalf("NewS3Storage: %v", err)
+	}
+	if s.region != "us-east-1" || s.endpoint.String() != "https://s3.us-east-1.amazonaws.com" {
+		t.Errorf("defaults = %s %s", s.region, s.endpoint)
+	}
+}
+
+func TestObjectURL(t *testing.T) {
+	pathStyle := newTestS3(t, "http://localhost:9000", true)
+	if got := pathStyle.objectURL("issues/a b/file+1.txt").String(); got != "http://localhost:9000/attachments/issues/a%20b/file%2B1.txt" {
+		t.Errorf("path-style URL = %s", got)
+	}
+
+	virtualHosted := newTestS3(t, "https://s3.eu-west-1.amazonaws.com", false)
+	if got := virtualHosted.objectURL("issues/x.txt").String(); got != "https://attachments.s3.eu-west-1.amazonaws.com/issues/x.txt" {
+		t.Errorf("virtual-hosted URL = %s", got)
+	}
+}
+
+func TestSign(t *testing.T) {
+	s := newTestS3(t, "https://s3.eu-west-1.amazonaws.com", false)
+	now := time.Date(2024, 3, 9, 14, 5, 7, 0, time.UTC)
+
+	req, err := http.NewRequest(http.MethodPut, s.objectURL("issues/report 1.txt").String()+"?b=2&a=1", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("Content-Type", "text/plain")
+	s.sign(req, now)
+
+	if got := req.Header.Get("X-Amz-Date"); got != "20240309T140507Z" {
+		t.Errorf("X-Amz-Date = %q", got)
+	}
+	if got := req.Header.Get("X-Amz-Content-Sha256"); got != unsignedPayload {
+		t.Errorf("X-Amz-Content-Sha256 = %q", got)
+	}
+
+	// The canonical request and string to sign as laid out in the SigV4 documentation
+	canonicalRequest := "PUT\n" +
+		"/issues/report%201.txt\n" +
+		"a=1&b=2\n" +
+		"content-type:text/plain\n" +
+		"host:attachments.s3.eu-west-1.amazonaws.com\n" +
+		"x-amz-content-sha256:UNSIGNED-PAYLOAD\n" +
+		"x-amz-date:20240309T140507Z\n" +
+		"\n" +
+		"content-type;host;x-amz-content-sha256;x-amz-date\n" +
+		"UNSIGNED-PAYLOAD"
+	hashed := sha256.Sum256([]byte(canonicalRequest))
+	stringToSign := "AWS4-HMAC-SHA256\n" +
+		"20240309T140507Z\n" +
+		"20240309/eu-west-1/s3/aws4_request\n" +
+		hex.EncodeToString(hashed[:])
+
+	key := hmacSHA256([]byte("AWS4wJalrXUtnFEMI/K7MDENG+bPxRfiCYEXAMPLEKEY"), "20240309")
+	key = hmacSHA256(key, "eu-west-1")
+	key = hmacSHA256(key, "s3")
+	key = hmacSHA256(key, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	want := "AWS4-HMAC-SHA256 Credential=AKIDEXAMPLE/20240309/eu-west-1/s3/aws4_request, " +
+		"SignedHeaders=content-type;host;x-amz-content-sha256;x-amz-date, " +
+		"Signature=" + signature
+	if got := req.Header.Get("Authorization"); got != want {
+		t.Errorf("Authorization =\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestSignIsDeterministic(t *testing.T) {
+	s := newTestS3(t, "https://s3.eu-west-1.amazonaws.com", false)
+	now := time.Date(2024, 3, 9, 14, 5, 7, 0, time.UTC)
+
+	sign := func(method, key string) string {
+		req, err := http.NewRequest(method, s.objectURL(key).String(), nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		s.sign(req, now)
+		return req.Header.Get("Authorization")
+	}
+
+	if sign(http.MethodGet, "a.txt") != sign(http.MethodGet, "a.txt") {
+		t.Error("signing the same request twice gave different signatures")
+	}
+	if sign(http.MethodGet, "a.txt") == sign(http.MethodDelete, "a.txt") {
+		t.Error("the method is not covered by the signature")
+	}
+	if sign(http.MethodGet, "a.txt") == sign(http.MethodGet, "b.txt") {
+		t.Error("the path is not covered by the signature")
+	}
+}
+
+func TestURIEncode(t *testing.T) {
+	tests := []struct {
+		in          string
+		encodeSlash bool
+		want        string
+	}{
+		{"abc-_.~XYZ019", true, "abc-_.~XYZ019"},
+		{"a/b c", false, "a/b%20c"},
+		{"a/b c", true, "a%2Fb%20c"},
+		{"x+y=z&é", true, "x%2By%3Dz%26%C3%A9"},
+	}
+	for _, tt := range tests {
+		if got := uriEncode(tt.in, tt.encodeSlash); got != tt.want {
+			t.Errorf("uriEncode(%q, %v) = %q, want %q", tt.in, tt.encodeSlash, got, tt.want)
+		}
+	}
+}
+
+func TestCanonicalQuery(t *testing.T) {
+	values := url.Values{
+		"prefix":    {"a b"},
+		"list-type": {"2"},
+		"marker":    {"z", "a"},
+	}
+	if got := canonicalQuery(values); got != "list-type=2&marker=a&marker=z&prefix=a%20b" {
+		t.Errorf("canonicalQuery = %q", got)
+	}
+	if got := canonicalQuery(nil); got != "" {
+		t.Errorf("canonicalQuery(nil) = %q", got)
+	}
+}
+
+// fakeS3 is an in-memory S3 server that checks requests are signed
+type fakeS3 struct {
+	t       *testing.T
+	mu      sync.Mutex
+	objects map[string][]byte
+	ranges  []string
+}
+
+func (f *fakeS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	auth := r.Header.Get("Authorization")
+	if !strings.HasPrefix(auth, "AWS4-HMAC-SHA256 Credential=AKIDEXAMPLE/") || r.Header.Get("X-Amz-Date") == "" {
+		http.Error(w, "AccessDenied", http.StatusForbidden)
+		return
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	switch r.Method {
+	case http.MethodPut:
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if int64(len(body)) != r.ContentLength {
+			f.t.Errorf("PUT body is %d bytes, Content-Length %d", len(body), r.ContentLength)
+		}
+		f.objects[r.URL.Path] = body
+	case http.MethodGet:
+		body, ok := f.objects[r.URL.Path]
+		if !ok {
+			http.Error(w, "NoSuchKey", http.StatusNotFound)
+			return
+		}
+		f.ranges = append(f.ranges, r.Header.Get("Range"))
+		http.ServeContent(w, r, "", time.Time{}, strings.NewReader(string(body)))
+	case http.MethodDelete:
+		if _, ok := f.objects[r.URL.Path]; !ok {
+			http.Error(w, "NoSuchKey", http.StatusNotFound)
+			return
+		}
+		delete(f.objects, r.URL.Path)
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+func TestS3StorageRoundTrip(t *testing.T) {
+	fake := &fakeS3{t: t, objects: make(map[string][]byte)}
+	server := httptest.NewServer(fake)
+	defer server.Close()
+
+	s := newTestS3(t, server.URL, true)
+	ctx := context.Background()
+	content := "0123456789abcdef"
+
+	location, err := s.Put(ctx, "issues/1/a.txt", strings.NewReader(content), int64(len(content)), "text/plain")
+	if err != nil {
+		t.Fatalf("Put: %v", err)
+	}
+	if location != server.URL+"/attachments/issues/1/a.txt" {
+		t.Errorf("Put location = %s", location)
+	}
+
+	read := func(offset, length int64) string {
+		t.Helper()
+		r, err := s.Get(ctx, "issues/1/a.txt", offset, length)
+		if err != nil {
+			t.Fatalf("Get(%d, %d): %v", offset, length, err)
+		}
+		defer r.Close()
+		b, err := io.ReadAll(r)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return string(b)
+	}
+
+	if got := read(0, 0); got != content {
+		t.Errorf("full read = %q", got)
+	}
+	if got := read(4, 3); got != "456" {
+		t.Errorf("ranged read = %q", got)
+	}
+	if got := read(10, 0); got != "abcdef" {
+		t.Errorf("read from offset = %q", got)
+	}
+	if want := []string{"", "bytes=4-6", "bytes=10-"}; strings.Join(fake.ranges, ",") != strings.Join(want, ",") {
+		t.Errorf("Range headers = %q, want %q", fake.ranges, want)
+	}
+
+	if err := s.Delete(ctx, "issues/1/a.txt"); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+	if _, err := s.Get(ctx, "issues/1/a.txt", 0, 0); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Get after Delete: %v, want ErrNotFound", err)
+	}
+
+	// Deleting a missing object is not an error
+	if err := s.Delete(ctx, "issues/1/a.txt"); err != nil {
+		t.Errorf("Delete of a missing object: %v", err)
+	}
+}
+
+func TestS3StorageErrorStatus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "SlowDown", http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	s := newTestS3(t, server.URL, true)
+	_, err := s.Put(context.Background(), "k", strings.NewReader("x"), 1, "")
+	if err == nil || !strings.Contains(err.Error(), "status 503") || !strings.Contains(err.Error(), "SlowDown") {
+		t.Errorf("Put error = %v", err)
+	}
+}
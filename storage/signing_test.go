@@ -0,0 +1,88 @@
+// storage/signing_test.go
+package storage
+
+import (
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// parseSignedURL splits a signed URL into its path, expiry and signature
+func parseSignedURL(t *testing.T, signed string) (string, int64, string) {
+	t.Helper()
+
+	u, err := url.Parse(signed)
+	if err != nil {
+		t.Fatalf("invalid URL %q: %v", signed, err)
+	}
+	expires, err := strconv.ParseInt(u.Query().Get("expires"), 10, 64)
+	if err != nil {
+		t.Fatalf("invalid expires in %q: %v", signed, err)
+	}
+	return u.Path, expires, u.Query().Get("signature")
+}
+
+func TestSignedURL(t *testing.T) {
+	signer := NewURLSigner("secret", "https://bugs.example.com/", 15*time.Minute)
+	id := uuid.New()
+
+	before := time.Now()
+	signed, expiresAt := signer.SignedURL(id, ResourceDownload)
+
+	if !strings.HasPrefix(signed, "https://bugs.example.com/api/v1/attachments/"+id.String()+"/download?") {
+		t.Errorf("SignedURL = %s", signed)
+	}
+	if expiresAt.Before(before.Add(15*time.Minute-time.Second)) || expiresAt.After(before.Add(15*time.Minute+time.Second)) {
+		t.Errorf("expires at %s, want about 15 minutes after %s", expiresAt, before)
+	}
+
+	_, expires, signature := parseSignedURL(t, signed)
+	if expires != expiresAt.Unix() {
+		t.Errorf("expires = %d, want %d", expires, expiresAt.Unix())
+	}
+	if !signer.Verify(id, ResourceDownload, expires, signature) {
+		t.Error("Verify rejected a freshly signed URL")
+	}
+}
+
+func TestVerifyRejectsTampering(t *testing.T) {
+	signer := NewURLSigner("secret", "https://bugs.example.com", time.Hour)
+	id := uuid.New()
+	signed, _ := signer.SignedURL(id, ResourceDownload)
+	_, expires, signature := parseSignedURL(t, signed)
+
+	tests := []struct {
+		name      string
+		signer    *URLSigner
+		id        uuid.UUID
+		resource  string
+		expires   int64
+		signature string
+	}{
+		{"other attachment", signer, uuid.New(), ResourceDownload, expires, signature},
+		{"other resource", signer, id, ResourceThumbnail, expires, signature},
+		{"extended expiry", signer, id, ResourceDownload, expires + 3600, signature},
+		{"other secret", NewURLSigner("other", "https://bugs.example.com", time.Hour), id, ResourceDownload, expires, signature},
+		{"empty signature", signer, id, ResourceDownload, expires, ""},
+		{"truncated signature", signer, id, ResourceDownload, expires, signature[:len(signature)-1]},
+	}
+	for _, tt := range tests {
+		if tt.signer.Verify(tt.id, tt.resource, tt.expires, tt.signature) {
+			t.Errorf("%s: Verify accepted a tampered URL", tt.name)
+		}
+	}
+}
+
+func TestVerifyRejectsExpired(t *testing.T) {
+	signer := NewURLSigner("secret", "https://bugs.example.com", time.Hour)
+	id := uuid.New()
+
+	expires := time.Now().Add(-time.Second).Unix()
+	if signer.Verify(id, ResourceDownload, expires, signer.sign(id, ResourceDownload, expires)) {
+		t.Error("Verify accepted an expired URL")
+	}
+}
@@ -0,0 +1,190 @@
+// storage/storage.go
+package storage
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/matthewmc1/buganizer/config"
+)
+
+// ErrNotFound is returned when no object exists for a key
+var ErrNotFound = errors.New("storage: object not found")
+
+// Storage is a backend for attachment content
+type Storage interface {
+	// Put stores the content of r under key and returns the object's location
+	Put(ctx context.Context, key string, r io.Reader, size int64, contentType string) (string, error)
+
+	// Get opens the content stored under key, starting at offset and reading at
+	// most length bytes (a length of zero or less reads to the end)
+	Get(ctx context.Context, key string, offset, length int64) (io.ReadCloser, error)
+
+	// Delete removes the content stored under key
+	Delete(ctx context.Context, key string) error
+}
+
+// New creates the storage backend selected by the configuration
+func New(cfg config.StorageConfig) (Storage, error) {
+	switch cfg.Provider {
+	case "", "local":
+		return NewLocalStorage(cfg.BasePath)
+	case "s3":
+		return NewS3Storage(cfg)
+	case "gcs":
+		// Cloud Storage speaks the S3 XML API with HMAC keys
+		if cfg.Endpoint == "" {
+			cfg.Endpoint = "https://storage.googleapis.com"
+		}
+		return NewS3Storage(cfg)
+	default:
+		return nil, fmt.Errorf("unknown storage provider: %s", cfg.Provider)
+	}
+}
+
+// AttachmentKey builds the object key for an attachment's content
+func AttachmentKey(issueID, attachmentID uuid.UUID, filename string) string {
+	return path.Join("issues", issueID.String(), attachmentID.String(), sanitizeFilename(filename))
+}
+
+// UploadPartKey builds the object key for a staged part of a chunked upload
+func UploadPartKey(uploadID uuid.UUID, offset int64) string {
+	return path.Join("uploads", uploadID.String(), fmt.Sprintf("%020d", offset))
+}
+
+// ThumbnailKey builds the object key for the thumbnail stored alongside an attachment
+func ThumbnailKey(key string) string {
+	return key + ".thumbnail.png"
+}
+
+// QuarantineKey builds the object key that infected content is moved to
+func QuarantineKey(key string) string {
+	return path.Join("quarantine", key)
+}
+
+// DetectContentType determines the content type from the first bytes of the
+// content, falling back to the file extension when sniffing is inconclusive.
+// Executables and core dumps are always identified by their content so that a
+// misleading extension cannot get them past the content type policy.
+func DetectContentType(filename string, head []byte) string {
+	if contentType := sniffBinary(head); contentType != "" {
+		return contentType
+	}
+
+	contentType := http.DetectContentType(head)
+	if contentType == "application/octet-stream" || strings.HasPrefix(contentType, "text/plain") {
+		if byExt := mime.TypeByExtension(filepath.Ext(filename)); byExt != "" {
+			return byExt
+		}
+	}
+	return contentType
+}
+
+// ContentTypeAllowed checks a content type against allow and deny lists of
+// types such as "image/png" or "image/*". Denied types take precedence; an
+// empty allow list allows everything that is not denied.
+func ContentTypeAllowed(contentType string, allowed, denied []string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		mediaType = strings.ToLower(strings.TrimSpace(contentType))
+	}
+
+	for _, pattern := range denied {
+		if matchContentType(mediaType, pattern) {
+			return false
+		}
+	}
+
+	if len(allowed) == 0 {
+		return true
+	}
+	for _, pattern := range allowed {
+		if matchContentType(mediaType, pattern) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchContentType matches a media type against a pattern with an optional "/*" wildcard
+func matchContentType(mediaType, pattern string) bool {
+	pattern = strings.ToLower(strings.TrimSpace(pattern))
+	if pattern == "*/*" {
+		return true
+	}
+	if prefix, ok := strings.CutSuffix(pattern, "/*"); ok {
+		return strings.HasPrefix(mediaType, prefix+"/")
+	}
+	return mediaType == pattern
+}
+
+// sniffBinary recognizes executable formats that http.DetectContentType reports
+// as application/octet-stream
+func sniffBinary(head []byte) string {
+	switch {
+	case len(head) >= 18 && bytes.HasPrefix(head, []byte("\x7fELF")):
+		// e_type follows the 16-byte identification, in the file's byte order
+		var elfType uint16
+		if head[5] == 2 {
+			elfType = binary.BigEndian.Uint16(head[16:18])
+		} else {
+			elfType = binary.LittleEndian.Uint16(head[16:18])
+		}
+		switch elfType {
+		case 1:
+			return "application/x-object"
+		case 3:
+			return "application/x-sharedlib"
+		case 4:
+			return "application/x-coredump"
+		default:
+			return "application/x-executable"
+		}
+	case bytes.HasPrefix(head, []byte("MZ")):
+		return "application/vnd.microsoft.portable-executable"
+	case len(head) >= 4 && isMachO(binary.BigEndian.Uint32(head[:4])):
+		return "application/x-mach-binary"
+	case bytes.HasPrefix(head, []byte("#!")):
+		return "text/x-shellscript"
+	}
+	return ""
+}
+
+// isMachO reports whether magic is a Mach-O magic number in either byte order
+func isMachO(magic uint32) bool {
+	switch magic {
+	case 0xfeedface, 0xfeedfacf, 0xcefaedfe, 0xcffaedfe:
+		return true
+	}
+	return false
+}
+
+// sanitizeFilename strips directories and characters that are unsafe in object keys
+func sanitizeFilename(filename string) string {
+	name := filepath.Base(strings.ReplaceAll(filename, "\\", "/"))
+	name = strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9':
+			return r
+		case r == '.', r == '-', r == '_':
+			return r
+		default:
+			return '_'
+		}
+	}, name)
+	if name == "" || name == "." || name == ".." {
+		return "file"
+	}
+	return name
+}
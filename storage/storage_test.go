@@ -0,0 +1,96 @@
+// storage/storage_test.go
+package storage
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestDetectContentType(t *testing.T) {
+	elf := func(class, elfType byte) []byte {
+		head := make([]byte, 64)
+		copy(head, "\x7fELF")
+		head[4] = class
+		head[5] = 1 // little-endian
+		head[16] = elfType
+		return head
+	}
+
+	tests := []struct {
+		name     string
+		filename string
+		head     []byte
+		want     string
+	}{
+		{"png", "image.png", []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR"), "image/png"},
+		{"text by extension", "trace.json", []byte(`{"frames": []}`), "application/json"},
+		{"plain text", "notes", []byte("hello world"), "text/plain; charset=utf-8"},
+		{"executable named as text", "readme.txt", elf(2, 2), "application/x-executable"},
+		{"core dump", "core", elf(2, 4), "application/x-coredump"},
+		{"shared library", "lib.so", elf(2, 3), "application/x-sharedlib"},
+		{"windows executable", "setup.pdf", []byte("MZ\x90\x00"), "application/vnd.microsoft.portable-executable"},
+		{"mach-o", "tool", []byte{0xcf, 0xfa, 0xed, 0xfe, 0x07}, "application/x-mach-binary"},
+		{"script", "run.txt", []byte("#!/bin/sh\nrm -rf /\n"), "text/x-shellscript"},
+	}
+	for _, tt := range tests {
+		if got := DetectContentType(tt.filename, tt.head); got != tt.want {
+			t.Errorf("%s: DetectContentType(%q) = %q, want %q", tt.name, tt.filename, got, tt.want)
+		}
+	}
+}
+
+func TestContentTypeAllowed(t *testing.T) {
+	tests := []struct {
+		contentType     string
+		allowed, denied []string
+		want            bool
+	}{
+		{"image/png", nil, nil, true},
+		{"image/png", []string{"image/*"}, nil, true},
+		{"text/plain; charset=utf-8", []string{"text/plain"}, nil, true},
+		{"application/pdf", []string{"image/*", "text/plain"}, nil, false},
+		{"application/x-executable", nil, []string{"application/x-executable"}, false},
+		{"image/svg+xml", []string{"image/*"}, []string{"image/svg+xml"}, false},
+		{"IMAGE/PNG", []string{" Image/* "}, nil, true},
+		{"anything/else", []string{"*/*"}, nil, true},
+	}
+	for _, tt := range tests {
+		if got := ContentTypeAllowed(tt.contentType, tt.allowed, tt.denied); got != tt.want {
+			t.Errorf("ContentTypeAllowed(%q, %q, %q) = %v, want %v", tt.contentType, tt.allowed, tt.denied, got, tt.want)
+		}
+	}
+}
+
+func TestAttachmentKey(t *testing.T) {
+	issueID := uuid.MustParse("11111111-1111-1111-1111-111111111111")
+	attachmentID := uuid.MustParse("22222222-2222-2222-2222-222222222222")
+
+	tests := []struct {
+		filename string
+		want     string
+	}{
+		{"crash.log", "crash.log"},
+		{"../../etc/passwd", "passwd"},
+		{`C:\Users\me\screen shot.png`, "screen_shot.png"},
+		{"..", "file"},
+		{"", "file"},
+	}
+	for _, tt := range tests {
+		key := AttachmentKey(issueID, attachmentID, tt.filename)
+		want := "issues/11111111-1111-1111-1111-111111111111/22222222-2222-2222-2222-222222222222/" + tt.want
+		if key != want {
+			t.Errorf("AttachmentKey(%q) = %q, want %q", tt.filename, key, want)
+		}
+	}
+}
+
+func TestUploadPartKeysSortByOffset(t *testing.T) {
+	uploadID := uuid.New()
+	small := UploadPartKey(uploadID, 9)
+	large := UploadPartKey(uploadID, 10)
+	if strings.Compare(small, large) >= 0 {
+		t.Errorf("part keys %q and %q do not sort by offset", small, large)
+	}
+}
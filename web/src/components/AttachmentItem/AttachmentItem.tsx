@@ -94,7 +94,16 @@ const AttachmentItem: React.FC<AttachmentItemProps> = ({
       <CardContent sx={{ p: 2 }}>
         <Box sx={{ display: 'flex', flexDirection: 'column', alignItems: 'center', height: '100%' }}>
           <Box sx={{ mb: 1 }}>
-            {getFileIcon()}
+            {attachment.thumbnailUrl ? (
+              <Box
+                component="img"
+                src={attachment.thumbnailUrl}
+                alt={attachment.filename}
+                sx={{ maxWidth: '100%', maxHeight: 128, borderRadius: 1 }}
+              />
+            ) : (
+              getFileIcon()
+            )}
           </Box>
           
           <Typography 
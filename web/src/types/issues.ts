@@ -23,6 +23,8 @@ export enum Status {
   CLOSED = 'CLOSED',
   DUPLICATE = 'DUPLICATE',
   WONT_FIX = 'WONT_FIX',
+  WAITING_ON_REPORTER = 'WAITING_ON_REPORTER',
+  BLOCKED = 'BLOCKED',
 }
 
 export interface Issue {
@@ -40,6 +42,19 @@ export interface Issue {
   createdAt: string;
   updatedAt: string;
   labels: string[];
+  responseDueDate: string | null;
+  firstResponseAt: string | null;
+  resolvedAt: string | null;
+}
+
+export interface IssueHistoryEntry {
+  id: string;
+  issueId: string;
+  actorId?: string;
+  field: string;
+  oldValue: string;
+  newValue: string;
+  createdAt: string;
 }
 
 export interface Comment {
@@ -58,9 +73,26 @@ export interface Attachment {
   filename: string;
   fileUrl: string;
   fileSize: number;
+  contentType?: string;
+  thumbnailUrl?: string;
   createdAt: string;
 }
 
+export interface PreviewLine {
+  number: number;
+  text: string;
+}
+
+export interface AttachmentPreview {
+  attachmentId: string;
+  filename: string;
+  contentType: string;
+  head: PreviewLine[];
+  tail: PreviewLine[];
+  totalLines: number;
+  truncated: boolean;
+}
+
 export interface Component {
   id: string;
   name: string;
@@ -6,16 +6,50 @@ export enum NotificationType {
     COMMENT_ADDED = 'COMMENT_ADDED',
     SLA_AT_RISK = 'SLA_AT_RISK',
     SLA_BREACHED = 'SLA_BREACHED',
+    SLA_DEADLINE_CHANGED = 'SLA_DEADLINE_CHANGED',
+    ISSUE_ESCALATED = 'ISSUE_ESCALATED',
   }
   
+  // An entry in the current user's in-app inbox
+  export interface Notification {
+    id: string;
+    userId: string;
+    type: NotificationType;
+    issueId?: string;
+    title: string; // Title of the issue when the event happened
+    message: string;
+    actorId?: string; // Missing for system events
+    read: boolean;
+    readAt?: string;
+    createdAt: string;
+  }
+
+  // How often a user gets notifications of a type
+  export enum DigestFrequency {
+    IMMEDIATE = 'DIGEST_IMMEDIATE',
+    HOURLY = 'DIGEST_HOURLY',
+    DAILY = 'DIGEST_DAILY',
+  }
+
   export interface NotificationPreference {
     userId: string;
     emailNotifications: boolean;
     slackNotifications: boolean;
     subscribedEvents: NotificationType[];
+    digestFrequencies: Partial<Record<NotificationType, DigestFrequency>>; // Types not listed are sent immediately
+    timeZone: string;
+    quietHoursStart: string; // "HH:MM" in timeZone; notifications about issues other than P0 wait until quiet hours end
+    quietHoursEnd: string;
+    dailyDigestTime: string;
     updatedAt: string;
   }
   
+  // How a webhook's deliveries are encoded
+  export enum WebhookPayloadFormat {
+    BUGANIZER = 'BUGANIZER',
+    CLOUDEVENTS = 'CLOUDEVENTS', // CloudEvents 1.0 JSON, with the payload as data
+  }
+
   export interface Webhook {
     id: string;
     url: string;
@@ -25,4 +59,23 @@ export enum NotificationType {
     createdAt: string;
     lastCalledAt: string | null;
     lastSuccess: boolean | null;
-  }
\ No newline at end of file
+    hasSecret: boolean; // The secret itself is only returned on creation and rotation
+    payloadFormat: WebhookPayloadFormat;
+  }
+  export interface WebhookDelivery {
+    id: string;
+    webhookId: string;
+    eventType: NotificationType;
+    issueId?: string;
+    requestHeaders: Record<string, string>;
+    requestBody: string;
+    responseStatus: number; // 0 when no response was received
+    responseHeaders: Record<string, string>;
+    responseBody: string;
+    responseTruncated: boolean;
+    latencyMs: number;
+    error?: string;
+    success: boolean;
+    redeliveryOfId?: string; // The delivery replayed
+    createdAt: string;
+  }
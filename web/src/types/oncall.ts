@@ -0,0 +1,37 @@
+// src/types/oncall.ts
+
+export interface Rotation {
+  id: string;
+  organizationId: string;
+  teamId: string;
+  name: string;
+  memberIds: string[];
+  shiftLengthDays: number;
+  handoffTime: string; // HH:MM in timeZone
+  timeZone: string;
+  startDate: string; // YYYY-MM-DD
+  createdAt: string;
+  updatedAt: string;
+  createdById?: string;
+}
+
+export interface OnCallOverride {
+  id: string;
+  rotationId: string;
+  userId: string;
+  startAt: string;
+  endAt: string;
+  reason?: string;
+  createdAt: string;
+  createdById?: string;
+}
+
+export interface OnCallShift {
+  rotationId?: string; // Empty when set by hand
+  teamId: string;
+  userId: string;
+  startAt?: string;
+  endAt?: string;
+  overrideId?: string;
+  rotationName?: string;
+}
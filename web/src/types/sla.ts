@@ -6,7 +6,10 @@ export interface SLATarget {
   priority: string;
   severity: string;
   targetDate: string;
+  responseTargetDate: string;
   description: string;
+  policyId?: string;
+  calendarId?: string;
 }
 
 export interface SLARiskIssue {
@@ -16,6 +19,10 @@ export interface SLARiskIssue {
   hoursRemaining: number;
   priority: string;
   severity: string;
+  responseDueDate?: string;
+  responseHoursRemaining: number;
+  responseAtRisk: boolean;
+  resolutionAtRisk: boolean;
 }
 
 export interface SLAStats {
@@ -27,4 +34,78 @@ export interface SLAStats {
   issuesBySeverity: Record<string, number>;
   complianceByPriority: Record<string, number>;
   complianceBySeverity: Record<string, number>;
-}
\ No newline at end of file
+  responseMetSla: number;
+  responseMissedSla: number;
+  responseCompliancePercentage: number;
+  responseComplianceByPriority: Record<string, number>;
+  responseComplianceBySeverity: Record<string, number>;
+  issuesByComponent: Record<string, number>;
+  complianceByComponent: Record<string, number>;
+  responseComplianceByComponent: Record<string, number>;
+  issuesByTeam: Record<string, number>;
+  complianceByTeam: Record<string, number>;
+  responseComplianceByTeam: Record<string, number>;
+  issuesByAssignee: Record<string, number>;
+  complianceByAssignee: Record<string, number>;
+  responseComplianceByAssignee: Record<string, number>;
+}
+
+export enum EscalationAction {
+  NOTIFY_ASSIGNEE = 'NOTIFY_ASSIGNEE',
+  NOTIFY_TEAM_LEAD = 'NOTIFY_TEAM_LEAD',
+  REASSIGN_ON_CALL = 'REASSIGN_ON_CALL',
+  BUMP_PRIORITY = 'BUMP_PRIORITY',
+  PAGE_WEBHOOK = 'PAGE_WEBHOOK',
+}
+
+export interface EscalationStep {
+  action: EscalationAction;
+  delayMinutes: number;
+  target?: string;
+}
+
+export interface EscalationPolicy {
+  id: string;
+  organizationId: string;
+  name: string;
+  componentId?: string;
+  teamId?: string;
+  priorities: Priority[];
+  steps: EscalationStep[];
+  createdAt: string;
+  updatedAt: string;
+  createdById?: string;
+}
+
+export interface Escalation {
+  id: string;
+  issueId: string;
+  policyId?: string;
+  position: number;
+  action: EscalationAction;
+  target?: string;
+  runAt: string;
+  status: 'PENDING' | 'DONE' | 'SKIPPED' | 'FAILED' | 'CANCELLED';
+  attempts: number;
+  lastError?: string;
+  finishedAt?: string;
+}
+
+export type TrendInterval = 'TREND_DAY' | 'TREND_WEEK' | 'TREND_MONTH';
+
+export interface SLATrendPoint {
+  bucketStart: string;
+  date: string;
+  resolved: number;
+  metSla: number;
+  missedSla: number;
+  compliancePercentage: number;
+  rollingCompliancePercentage: number;
+  medianHoursToResolve: number;
+  breached: number;
+}
+
+export interface SLATrend {
+  interval: TrendInterval;
+  points: SLATrendPoint[];
+}
@@ -2,6 +2,9 @@
 import axios from 'axios';
 import { decodeJwt } from './jwt';
 import { Role } from '../types/permissions';
+import { TrendInterval } from '../types/sla';
+import { Rotation, OnCallOverride } from '../types/oncall';
+import { DigestFrequency, WebhookPayloadFormat } from '../types/notifications';
 
 // Configure axios
 const apiClient = axios.create({
@@ -313,7 +316,13 @@ export const api = {
     
     // Delete an attachment
     deleteAttachment: (issueId: string, attachmentId: string) =>
-      apiClient.delete(`/api/v1/issues/${issueId}/attachments/${attachmentId}`),
+      apiClient.delete(`/api/v1/attachments/${attachmentId}`),
+      
+    // Get the first and last lines of a text attachment
+    getPreview: (attachmentId: string, headLines = 50, tailLines = 50) =>
+      apiClient.get(`/api/v1/attachments/${attachmentId}/preview`, {
+        params: { head_lines: headLines, tail_lines: tailLines },
+      }),
   },
   
   // Components
@@ -366,6 +375,31 @@ export const api = {
         } 
       }),
       
+    // Get SLA compliance over time
+    getSLATrend: (params: {
+      interval?: TrendInterval,
+      componentId?: string,
+      teamId?: string,
+      priorities?: string[],
+      severities?: string[],
+      startDate?: string,
+      endDate?: string,
+      rollingWindow?: number,
+    }) => 
+      apiClient.get('/api/v1/sla/trend', { 
+        params: { 
+          interval: params.interval,
+          component_id: params.componentId, 
+          team_id: params.teamId, 
+          priorities: params.priorities,
+          severities: params.severities,
+          start_date: params.startDate, 
+          end_date: params.endDate,
+          rolling_window: params.rollingWindow
+        },
+        paramsSerializer: { indexes: null },
+      }),
+      
     // Configure SLA for a component
     configureSLA: (componentId: string, config: {
       criticalResponseTime: number;
@@ -378,6 +412,60 @@ export const api = {
       lowResolutionTime: number;
     }) => apiClient.post(`/api/v1/sla/components/${componentId}`, config),
   },
+
+  // On-call
+  oncall: {
+    // List the organization's rotations
+    getRotations: () => apiClient.get('/api/v1/oncall/rotations'),
+
+    // Get a rotation
+    getRotation: (id: string) => apiClient.get(`/api/v1/oncall/rotations/${id}`),
+
+    // Create a team's rotation
+    createRotation: (rotation: Partial<Rotation>) =>
+      apiClient.post('/api/v1/oncall/rotations', rotation),
+
+    // Update a rotation
+    updateRotation: (id: string, rotation: Partial<Rotation>) =>
+      apiClient.put(`/api/v1/oncall/rotations/${id}`, { ...rotation, id }),
+
+    // Delete a rotation
+    deleteRotation: (id: string) => apiClient.delete(`/api/v1/oncall/rotations/${id}`),
+
+    // Swap a shift or cover a vacation
+    createOverride: (rotationId: string, override: Partial<OnCallOverride>) =>
+      apiClient.post(`/api/v1/oncall/rotations/${rotationId}/overrides`, override),
+
+    // Delete an override
+    deleteOverride: (id: string) => apiClient.delete(`/api/v1/oncall/overrides/${id}`),
+
+    // Get who is on call for a team now
+    getCurrentOnCall: (teamId: string) =>
+      apiClient.get(`/api/v1/oncall/teams/${teamId}/current`),
+
+    // Get who is on call for every team now
+    listCurrentOnCall: () => apiClient.get('/api/v1/oncall/current'),
+
+    // List shifts of a team or a user
+    listShifts: (params: {
+      teamId?: string,
+      userId?: string,
+      startDate?: string,
+      endDate?: string,
+    }) =>
+      apiClient.get('/api/v1/oncall/shifts', {
+        params: {
+          team_id: params.teamId,
+          user_id: params.userId,
+          start_date: params.startDate,
+          end_date: params.endDate
+        }
+      }),
+
+    // URL of a user's shifts as an iCalendar feed
+    getShiftsCalendarUrl: (userId: string = 'me') =>
+      `${apiClient.defaults.baseURL}/api/v1/oncall/users/${userId}/shifts.ics`,
+  },
   
   // Assignments
   assignments: {
@@ -482,10 +570,14 @@ export const api = {
   
   // Notifications
   notifications: {
-    // Get notifications for current user
-    getNotifications: (params: { page?: number, pageSize?: number, unreadOnly?: boolean }) =>
+    // Get notifications for current user, newest first
+    getNotifications: (params: { pageSize?: number, pageToken?: string, unreadOnly?: boolean }) =>
       apiClient.get('/api/v1/notifications', { params }),
       
+    // Count unread notifications for current user
+    getUnreadCount: () =>
+      apiClient.get('/api/v1/notifications/unread-count'),
+      
     // Mark notification as read
     markAsRead: (id: string) =>
       apiClient.post(`/api/v1/notifications/${id}/read`),
@@ -503,6 +595,11 @@ export const api = {
       emailNotifications: boolean;
       slackNotifications: boolean;
       subscribedEvents: string[];
+      digestFrequencies?: Record<string, DigestFrequency>;
+      timeZone?: string;
+      quietHoursStart?: string;
+      quietHoursEnd?: string;
+      dailyDigestTime?: string;
     }) => apiClient.patch('/api/v1/notifications/preferences', data),
   },
   
@@ -512,11 +609,17 @@ export const api = {
     getWebhooks: () =>
       apiClient.get('/api/v1/webhooks'),
       
-    // Create a webhook
+    // Get a webhook
+    getWebhook: (id: string) =>
+      apiClient.get(`/api/v1/webhooks/${id}`),
+
+    // Create a webhook; the response holds its secret, which is not shown again
     createWebhook: (data: {
       url: string;
       description: string;
       eventTypes: string[];
+      secret?: string;
+      payloadFormat?: WebhookPayloadFormat;
     }) => apiClient.post('/api/v1/webhooks', data),
     
     // Update a webhook
@@ -530,6 +633,28 @@ export const api = {
     // Test a webhook
     testWebhook: (id: string) =>
       apiClient.post(`/api/v1/webhooks/${id}/test`),
+
+    // Replace a webhook's secret; the response holds the new one
+    rotateSecret: (id: string, secret?: string) =>
+      apiClient.post(`/api/v1/webhooks/${id}/rotate-secret`, { secret }),
+
+    // List the delivery attempts of a webhook, newest first
+    getDeliveries: (id: string, params: {
+      pageSize?: number,
+      pageToken?: string,
+      failedOnly?: boolean,
+    } = {}) =>
+      apiClient.get(`/api/v1/webhooks/${id}/deliveries`, {
+        params: {
+          page_size: params.pageSize,
+          page_token: params.pageToken,
+          failed_only: params.failedOnly
+        }
+      }),
+
+    // Send a past delivery again
+    redeliver: (deliveryId: string) =>
+      apiClient.post(`/api/v1/webhooks/deliveries/${deliveryId}/redeliver`, {}),
   },
   
   // API Tokens
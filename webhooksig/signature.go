@@ -0,0 +1,129 @@
+// webhooksig/signature.go
+
+// Package webhooksig signs and verifies Buganizer webhook deliveries.
+//
+// Each delivery carries the Unix time it was sent in X-Buganizer-Timestamp,
+// a unique ID in X-Buganizer-Delivery, and in X-Buganizer-Signature the
+// HMAC-SHA256 of "<timestamp>.<body>" keyed with the webhook's secret, as
+// "v1=<hex>". Signing the timestamp lets receivers reject old deliveries, and
+// remembering recent delivery IDs lets them reject replays within the
+// tolerance. Receivers can verify a request with:
+//
+//	body, err := webhooksig.VerifyRequest(r, secret, webhooksig.DefaultTolerance)
+//	if err != nil {
+//		http.Error(w, err.Error(), http.StatusUnauthorized)
+//		return
+//	}
+package webhooksig
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// SignatureHeader holds the signatures of a delivery
+	SignatureHeader = "X-Buganizer-Signature"
+
+	// TimestampHeader holds the Unix time a delivery was signed
+	TimestampHeader = "X-Buganizer-Timestamp"
+
+	// DeliveryHeader holds the unique ID of a delivery, for deduplication
+	DeliveryHeader = "X-Buganizer-Delivery"
+
+	// DefaultTolerance is how old a delivery may be before it is rejected
+	DefaultTolerance = 5 * time.Minute
+
+	// version prefixes signatures of the current scheme
+	version = "v1"
+)
+
+var (
+	// ErrMissingSignature is returned for deliveries without a timestamp or
+	// a signature of the current scheme
+	ErrMissingSignature = errors.New("webhooksig: missing timestamp or signature")
+
+	// ErrExpired is returned for deliveries signed outside the tolerance
+	ErrExpired = errors.New("webhooksig: timestamp outside the tolerance")
+
+	// ErrInvalidSignature is returned for deliveries whose signature does not
+	// match
+	ErrInvalidSignature = errors.New("webhooksig: signature does not match")
+)
+
+// Sign returns the signature header value of a body sent at a time
+func Sign(secret string, timestamp time.Time, body []byte) string {
+	return version + "=" + hex.EncodeToString(mac(secret, timestamp.Unix(), body))
+}
+
+// Verify checks the timestamp and signature headers of a delivery against its
+// body. Deliveries signed more than tolerance before or after now are
+// rejected; a tolerance of zero disables the check.
+func Verify(secret string, header http.Header, body []byte, tolerance time.Duration, now time.Time) error {
+	timestamp, err := strconv.ParseInt(header.Get(TimestampHeader), 10, 64)
+	if err != nil {
+		return ErrMissingSignature
+	}
+
+	if tolerance > 0 {
+		age := now.Sub(time.Unix(timestamp, 0))
+		if age > tolerance || age < -tolerance {
+			return ErrExpired
+		}
+	}
+
+	expected := mac(secret, timestamp, body)
+
+	// The header may list several signatures, e.g. while a secret rotates
+	found := false
+	for _, part := range strings.Split(header.Get(SignatureHeader), ",") {
+		v, sig, ok := strings.Cut(strings.TrimSpace(part), "=")
+		if !ok || v != version {
+			continue
+		}
+		found = true
+
+		decoded, err := hex.DecodeString(sig)
+		if err == nil && hmac.Equal(decoded, expected) {
+			return nil
+		}
+	}
+
+	if !found {
+		return ErrMissingSignature
+	}
+	return ErrInvalidSignature
+}
+
+// VerifyRequest reads the body of a delivery and verifies it, returning the
+// body. The request's body can be read again afterwards.
+func VerifyRequest(r *http.Request, secret string, tolerance time.Duration) ([]byte, error) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return nil, fmt.Errorf("webhooksig: failed to read body: %v", err)
+	}
+	r.Body = io.NopCloser(bytes.NewReader(body))
+
+	if err := Verify(secret, r.Header, body, tolerance, time.Now()); err != nil {
+		return nil, err
+	}
+	return body, nil
+}
+
+// mac computes the HMAC-SHA256 of "<timestamp>.<body>"
+func mac(secret string, timestamp int64, body []byte) []byte {
+	h := hmac.New(sha256.New, []byte(secret))
+	h.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	h.Write([]byte("."))
+	h.Write(body)
+	return h.Sum(nil)
+}